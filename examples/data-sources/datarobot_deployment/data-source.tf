@@ -0,0 +1,7 @@
+data "datarobot_deployment" "churn" {
+  label = "Churn Prediction"
+}
+
+output "churn_prediction_url" {
+  value = data.datarobot_deployment.churn.prediction_url
+}
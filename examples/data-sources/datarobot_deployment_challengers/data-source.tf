@@ -0,0 +1,14 @@
+data "datarobot_deployment_challengers" "example" {
+  deployment_id = datarobot_deployment.example.id
+
+  # Optional
+  start = "2026-01-01T00:00:00Z"
+  end   = "2026-02-01T00:00:00Z"
+}
+
+output "challenger_log_loss_change" {
+  value = {
+    for challenger in data.datarobot_deployment_challengers.example.challengers :
+    challenger.name => lookup(challenger.metrics_percent_change, "LogLoss", null)
+  }
+}
@@ -0,0 +1,10 @@
+data "datarobot_deployments" "critical" {
+  importance = "CRITICAL"
+  tags = {
+    team = "platform"
+  }
+}
+
+output "critical_deployment_ids" {
+  value = [for deployment in data.datarobot_deployments.critical.deployments : deployment.id]
+}
@@ -0,0 +1,14 @@
+data "datarobot_files_catalog" "app" {
+  id = "66a1b2c3d4e5f6a7b8c9d0e1"
+  # Optional:
+  # catalog_version_id = "66a1b2c3d4e5f6a7b8c9d0e2" # defaults to the latest version
+  # limit              = 10
+}
+
+output "latest_catalog_version_id" {
+  value = data.datarobot_files_catalog.app.versions[0].id
+}
+
+output "catalog_file_paths" {
+  value = [for f in data.datarobot_files_catalog.app.files : f.path]
+}
@@ -0,0 +1,18 @@
+# Download the code a production artifact was built from.
+data "datarobot_artifact" "production" {
+  artifact_id = var.artifact_id
+}
+
+locals {
+  code_ref = data.datarobot_artifact.production.spec.container_groups[0].containers[0].image_build_config.code_ref.datarobot
+}
+
+data "datarobot_files_catalog_download" "production" {
+  catalog_id         = local.code_ref.catalog_id
+  catalog_version_id = local.code_ref.catalog_version_id
+  dir                = "${path.module}/audit/production"
+}
+
+output "downloaded_files" {
+  value = keys(data.datarobot_files_catalog_download.production.file_manifest)
+}
@@ -0,0 +1,14 @@
+data "datarobot_workload_logs" "example" {
+  workload_id = datarobot_workload.example.id
+
+  # Optional
+  container  = "main"
+  tail_lines = 100
+}
+
+output "workload_warning_events" {
+  value = [
+    for event in data.datarobot_workload_logs.example.events :
+    "${event.reason}: ${event.message}" if event.type == "Warning"
+  ]
+}
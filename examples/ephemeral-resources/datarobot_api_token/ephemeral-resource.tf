@@ -0,0 +1,18 @@
+ephemeral "datarobot_api_token" "ci" {
+  name            = "ci-deploy"
+  expires_in      = "2h"
+  scope           = "user"
+  # keep the key after the run, the Kubernetes secret outlives it
+  revoke_on_close = false
+}
+
+resource "kubernetes_secret_v1" "datarobot" {
+  metadata {
+    name = "datarobot-api-token"
+  }
+
+  data_wo = {
+    DATAROBOT_API_TOKEN = ephemeral.datarobot_api_token.ci.token
+  }
+  data_wo_revision = 1
+}
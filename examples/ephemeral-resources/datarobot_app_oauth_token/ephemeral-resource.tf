@@ -0,0 +1,14 @@
+resource "datarobot_app_oauth" "example" {
+  name             = "example"
+  type             = "box"
+  client_id        = "example_client_id"
+  client_secret_wo = var.box_client_secret
+}
+
+ephemeral "datarobot_app_oauth_token" "example" {
+  provider_id = datarobot_app_oauth.example.id
+}
+
+provider "vault" {
+  token = ephemeral.datarobot_app_oauth_token.example.access_token
+}
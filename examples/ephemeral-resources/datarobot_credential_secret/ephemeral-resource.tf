@@ -0,0 +1,8 @@
+ephemeral "datarobot_credential_secret" "snowflake" {
+  id = datarobot_basic_credential.snowflake.id
+}
+
+provider "snowflake" {
+  user     = ephemeral.datarobot_credential_secret.snowflake.user
+  password = ephemeral.datarobot_credential_secret.snowflake.password
+}
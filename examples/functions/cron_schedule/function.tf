@@ -0,0 +1,7 @@
+resource "datarobot_custom_job" "example" {
+  name        = "Example Custom Job"
+  folder_path = "custom_job/"
+
+  # every 6 hours on weekdays
+  schedule = provider::datarobot::cron_schedule("0 */6 * * 1-5")
+}
@@ -0,0 +1,11 @@
+resource "terraform_data" "app_source" {
+  input = provider::datarobot::directory_hash("${path.module}/app")
+}
+
+resource "datarobot_custom_application" "example" {
+  source_version_id = datarobot_application_source.example.version_id
+
+  lifecycle {
+    replace_triggered_by = [terraform_data.app_source]
+  }
+}
@@ -0,0 +1,3 @@
+output "next_label" {
+  value = provider::datarobot::next_version_label("v10") # "v11"
+}
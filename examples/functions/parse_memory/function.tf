@@ -0,0 +1,3 @@
+output "memory_bytes" {
+  value = provider::datarobot::parse_memory("4Gi") # 4294967296
+}
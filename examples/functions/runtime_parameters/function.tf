@@ -0,0 +1,17 @@
+resource "datarobot_custom_model" "example" {
+  name                = "Example Custom Model"
+  folder_path         = "model/"
+  target_type         = "TextGeneration"
+  target_name         = "resultText"
+  base_environment_id = "65f9b27eab986d30d4c64268"
+
+  runtime_parameter_values = provider::datarobot::runtime_parameters({
+    MODEL       = "gpt-4o"
+    TEMPERATURE = 0.5
+    STREAMING   = true
+    OPENAI_API_KEY = {
+      type  = "credential"
+      value = datarobot_api_token_credential.openai.id
+    }
+  })
+}
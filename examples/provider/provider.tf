@@ -15,8 +15,58 @@ provider "datarobot" {
   # (Optional) The DataRobot trace context can be configured using the environment variable
   # export DATAROBOT_TRACE_CONTEXT="the trace context value here"
   #
+  # (Optional) The API Key, endpoint and trace context can also be read from the config file of the
+  # DataRobot Python SDK and CLI (~/.config/datarobot/drconfig.yaml, or the DATAROBOT_CONFIG_FILE
+  # environment variable). Named profiles are listed under `profiles`:
+  #
+  #   endpoint: https://app.datarobot.com/api/v2
+  #   token: the API Key value here
+  #   profiles:
+  #     staging:
+  #       endpoint: https://staging.datarobot.com/api/v2
+  #       token: the staging API Key value here
+  #
+  # config_path = "~/.config/datarobot/drconfig.yaml"
+  # profile     = "staging" # or export DATAROBOT_PROFILE="staging"
+  #
+  # Each setting is taken from the first of these sources that sets it:
+  #   1. the `apikey`, `endpoint` and `tracecontext` attributes of this block
+  #   2. the DATAROBOT_API_TOKEN, DATAROBOT_ENDPOINT and DATAROBOT_TRACE_CONTEXT environment variables
+  #   3. the `token`, `endpoint` and `trace_context` of the selected config file profile
+  # The source of each setting is logged at DEBUG level (TF_LOG_PROVIDER=DEBUG).
+  #
   # (Optional) The timeout for long-running operations can be configured using the environment variable
   # export DATAROBOT_TIMEOUT_MINUTES="60"
   # If not specified the default timeout is 30 minutes. Increase this for operations that may take longer,
   # such as deployments requiring GPU provisioning which can take several hours.
+  # A resource's `timeouts` block (create, read, update, delete) overrides this value for that resource.
+  #
+  # (Optional) Failed API requests are retried with exponential backoff, honoring the Retry-After header.
+  # Requests other than GET are only retried where the server guarantees nothing was changed.
+  # max_retries     = 4
+  # retry_wait_min  = "1s"
+  # retry_wait_max  = "30s"
+  # retry_on_status = [429, 500, 502, 503, 504]
+  #
+  # (Optional) On-premise installations behind a proxy, with an internal CA or requiring client certificates:
+  # proxy_url       = "http://proxy.example.com:3128"
+  # ca_cert_file    = "/etc/ssl/certs/internal-ca.pem"
+  # client_cert     = file("client.crt")
+  # client_key      = file("client.key")
+  # request_timeout = "2m"
+  #
+  # (Optional) Uploads of `source.dir` to the Files API, also configurable with the environment variables
+  # DATAROBOT_UPLOAD_CONCURRENCY, DATAROBOT_STAGE_UPLOAD_MAX_FILES, DATAROBOT_STAGE_UPLOAD_MAX_BYTES
+  # and DATAROBOT_ZIP_UPLOAD_TIMEOUT:
+  # upload_concurrency     = 32
+  # stage_upload_max_files = 20
+  # stage_upload_max_bytes = 52428800
+  # zip_upload_timeout     = "10m"
+  #
+  # (Optional) Use Cases and tags added to every resource that supports them, in addition to its own:
+  # default_use_case_ids = ["use-case-id"]
+  # default_tags {
+  #   name  = "team"
+  #   value = "ml-platform"
+  # }
 }
@@ -29,7 +29,8 @@ resource "datarobot_artifact" "from_source" {
   status      = "draft"
 
   source = {
-    dir = "${path.module}/app"
+    dir             = "${path.module}/app"
+    ignore_patterns = ["__pycache__/", "*.pyc"]
   }
 
   spec = {
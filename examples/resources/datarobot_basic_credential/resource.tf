@@ -3,4 +3,13 @@ resource "datarobot_basic_credential" "example" {
   description = "Description for the example basic credential"
   user        = "example_user"
   password    = "example_password"
-}
\ No newline at end of file
+}
+
+# With Terraform 1.11 or later, the password can be kept out of the state
+# with its write-only variant. Bump password_wo_version to rotate it.
+resource "datarobot_basic_credential" "write_only_example" {
+  name                = "An example write-only basic credential"
+  user                = "example_user"
+  password_wo         = "example_password"
+  password_wo_version = 1
+}
@@ -0,0 +1,22 @@
+resource "datarobot_deployment" "example" {
+  label                       = "An example deployment"
+  prediction_environment_id   = datarobot_prediction_environment.example.id
+  registered_model_version_id = datarobot_registered_model.example.version_id
+
+  # challengers require challenger models and replay to be enabled
+  challenger_models_settings = {}
+  challenger_replay_settings = {}
+}
+
+resource "datarobot_deployment_challenger" "example" {
+  deployment_id               = datarobot_deployment.example.id
+  registered_model_version_id = datarobot_registered_model.candidate.version_id
+  name                        = "Candidate model"
+
+  # Optional
+  prediction_environment_id = datarobot_prediction_environment.example.id
+}
+
+# To promote the challenger to champion, set on the deployment:
+#   promote_challenger_id = "<challenger ID>"
+# and remove the datarobot_deployment_challenger of the promoted model afterwards.
@@ -0,0 +1,32 @@
+# One catalog of application source shared by several artifacts.
+resource "datarobot_files_catalog" "app" {
+  dir             = "${path.module}/app"
+  ignore_patterns = ["__pycache__/", "*.pyc"]
+}
+
+resource "datarobot_artifact" "app" {
+  name   = "example-shared-catalog"
+  status = "draft"
+
+  spec = {
+    container_groups = [{
+      containers = [{
+        name    = "primary"
+        primary = true
+        port    = 8080
+
+        image_build_config = {
+          code_ref = {
+            catalog_id         = datarobot_files_catalog.app.id
+            catalog_version_id = datarobot_files_catalog.app.catalog_version_id
+          }
+        }
+      }]
+    }]
+  }
+}
+
+output "catalog_version_id" {
+  value       = datarobot_files_catalog.app.catalog_version_id
+  description = "Catalog version produced by the last upload of dir"
+}
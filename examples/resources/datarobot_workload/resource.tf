@@ -34,6 +34,17 @@ resource "datarobot_workload" "example" {
     replacement_policy = {
       warmup_minutes           = 5
       keep_old_version_minutes = 10
+
+      # optional: shift traffic to the new version progressively
+      steps = [
+        { traffic_percent = 10, pause_minutes = 5 },
+        { traffic_percent = 50, pause_minutes = 10 },
+        { traffic_percent = 100 },
+      ]
+      health_gate = {
+        max_unhealthy_minutes = 5
+      }
+      rollback_on_failure = true
     }
   }
 }
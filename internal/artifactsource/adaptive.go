@@ -0,0 +1,79 @@
+package artifactsource
+
+import (
+	"context"
+	"sync"
+)
+
+// adaptiveLimiter bounds the number of concurrent uploads. The bound starts at max,
+// is halved when the server throttles an upload and grows back by one after as many
+// successful uploads in a row as the current bound.
+type adaptiveLimiter struct {
+	mu        sync.Mutex
+	max       int
+	limit     int
+	active    int
+	successes int
+	// changed is closed and replaced whenever a slot is released or the limit changes.
+	changed chan struct{}
+}
+
+func newAdaptiveLimiter(concurrency int) *adaptiveLimiter {
+	return &adaptiveLimiter{
+		max:     concurrency,
+		limit:   concurrency,
+		changed: make(chan struct{}),
+	}
+}
+
+// acquire blocks until fewer uploads than the limit are active, ctx is done or stop is
+// closed. It reports whether a slot was acquired.
+func (l *adaptiveLimiter) acquire(ctx context.Context, stop <-chan struct{}) bool {
+	for {
+		l.mu.Lock()
+		if l.active < l.limit {
+			l.active++
+			l.mu.Unlock()
+			return true
+		}
+		changed := l.changed
+		l.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-stop:
+			return false
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// release frees a slot and adjusts the limit: throttled uploads halve it, successful
+// uploads grow it back towards max.
+func (l *adaptiveLimiter) release(throttled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.active--
+	if throttled {
+		l.limit = max(1, l.limit/2)
+		l.successes = 0
+	} else if l.limit < l.max {
+		l.successes++
+		if l.successes >= l.limit {
+			l.limit++
+			l.successes = 0
+		}
+	}
+
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// currentLimit returns the current bound on concurrent uploads.
+func (l *adaptiveLimiter) currentLimit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit
+}
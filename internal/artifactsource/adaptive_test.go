@@ -0,0 +1,77 @@
+package artifactsource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdaptiveLimiter_BacksOffAndRecovers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limiter := newAdaptiveLimiter(8)
+
+	for range 8 {
+		require.True(t, limiter.acquire(ctx, nil))
+	}
+	limiter.release(true)
+	assert.Equal(t, 4, limiter.currentLimit())
+	limiter.release(true)
+	assert.Equal(t, 2, limiter.currentLimit())
+
+	// 6 uploads are still active, above the limit of 2: releasing them does not grow it
+	// until 2 uploads in a row succeed.
+	limiter.release(false)
+	assert.Equal(t, 2, limiter.currentLimit())
+	limiter.release(false)
+	assert.Equal(t, 3, limiter.currentLimit())
+
+	for range 4 {
+		limiter.release(true)
+	}
+	assert.Equal(t, 1, limiter.currentLimit(), "the limit never drops below one upload")
+}
+
+func TestAdaptiveLimiter_AcquireWaitsForRelease(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limiter := newAdaptiveLimiter(1)
+	require.True(t, limiter.acquire(ctx, nil))
+
+	acquired := make(chan bool, 1)
+	go func() { acquired <- limiter.acquire(ctx, nil) }()
+
+	select {
+	case <-acquired:
+		t.Fatal("expected acquire to wait for a free slot")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	limiter.release(false)
+	select {
+	case ok := <-acquired:
+		assert.True(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for acquire")
+	}
+}
+
+func TestAdaptiveLimiter_AcquireStops(t *testing.T) {
+	t.Parallel()
+
+	limiter := newAdaptiveLimiter(1)
+	require.True(t, limiter.acquire(context.Background(), nil))
+
+	stop := make(chan struct{})
+	close(stop)
+	assert.False(t, limiter.acquire(context.Background(), stop))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.False(t, limiter.acquire(ctx, nil))
+}
@@ -1,6 +1,13 @@
 package artifactsource
 
-import "sort"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
+)
 
 // PushPlan is the incremental upload blueprint: only paths that changed since
 // the last successful push (BaseFiles from Terraform state).
@@ -40,3 +47,36 @@ func DiffPushOnly(base, local Manifest) *PushPlan {
 	plan.sort()
 	return plan
 }
+
+// RemoteDrift compares the manifest of the last push (Terraform state) to the
+// files of the catalog version it produced and returns the sorted paths that
+// were added, removed, or modified remotely. Remote checksums that are not
+// SHA-256 hex digests cannot be compared with local hashes, so those files are
+// only checked for presence.
+func RemoteDrift(base Manifest, remote map[string]filesapi.FileMeta) []string {
+	var drifted []string
+
+	for path, entry := range remote {
+		prev, ok := base[path]
+		if !ok || (isSHA256Hex(entry.Hash) && !strings.EqualFold(prev.Hash, entry.Hash)) {
+			drifted = append(drifted, path)
+		}
+	}
+
+	for path := range base {
+		if _, ok := remote[path]; !ok {
+			drifted = append(drifted, path)
+		}
+	}
+
+	sort.Strings(drifted)
+	return drifted
+}
+
+func isSHA256Hex(s string) bool {
+	if len(s) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
@@ -1,8 +1,10 @@
 package artifactsource
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -64,3 +66,42 @@ func TestDiffPushOnly_BaseOnlyFileSchedulesDeleteNotUpload(t *testing.T) {
 	assert.Empty(t, plan.Uploads)
 	assert.Equal(t, []string{"remote-only.txt"}, plan.Deletes)
 }
+
+func TestRemoteDrift_InSync(t *testing.T) {
+	t.Parallel()
+
+	hash := strings.Repeat("ab", 32)
+	base := Manifest{"a.txt": {Hash: hash}}
+	remote := map[string]filesapi.FileMeta{"a.txt": {Hash: strings.ToUpper(hash), Size: 3}}
+
+	assert.Empty(t, RemoteDrift(base, remote))
+}
+
+func TestRemoteDrift_AddedModifiedDeleted(t *testing.T) {
+	t.Parallel()
+
+	base := Manifest{
+		"keep.txt":    {Hash: strings.Repeat("a", 64)},
+		"changed.txt": {Hash: strings.Repeat("b", 64)},
+		"removed.txt": {Hash: strings.Repeat("c", 64)},
+	}
+	remote := map[string]filesapi.FileMeta{
+		"keep.txt":    {Hash: strings.Repeat("a", 64)},
+		"changed.txt": {Hash: strings.Repeat("d", 64)},
+		"added.txt":   {Hash: strings.Repeat("e", 64)},
+	}
+
+	assert.Equal(t, []string{"added.txt", "changed.txt", "removed.txt"}, RemoteDrift(base, remote))
+}
+
+func TestRemoteDrift_IncomparableChecksumOnlyChecksPresence(t *testing.T) {
+	t.Parallel()
+
+	base := Manifest{"a.txt": {Hash: strings.Repeat("a", 64)}}
+	remote := map[string]filesapi.FileMeta{
+		"a.txt": {Hash: "d41d8cd98f00b204e9800998ecf8427e"},
+		"b.txt": {Hash: ""},
+	}
+
+	assert.Equal(t, []string{"b.txt"}, RemoteDrift(base, remote))
+}
@@ -5,4 +5,8 @@
 // the stage path (small change sets) or zip/fromFile path (large change sets).
 // When CatalogID and BaseFiles (per-path hashes from Terraform state) are set,
 // only added, modified, and deleted files are synced incrementally.
+//
+// PullVersion does the reverse: it downloads the files of a catalog version to a
+// local directory and verifies each file against the catalog. PruneVersions
+// deletes old catalog versions that are no longer referenced.
 package artifactsource
@@ -0,0 +1,17 @@
+//go:build !windows
+
+package artifactsource
+
+import (
+	"os"
+	"syscall"
+)
+
+// fileInode returns the inode of a file, so that a file replaced by another one with
+// the same size and modification time is hashed again.
+func fileInode(info os.FileInfo) uint64 {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return uint64(stat.Ino) //nolint:unconvert // Ino is not uint64 on every platform
+	}
+	return 0
+}
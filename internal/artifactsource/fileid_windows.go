@@ -0,0 +1,11 @@
+//go:build windows
+
+package artifactsource
+
+import "os"
+
+// fileInode returns 0: os.Stat does not report a file index on Windows, so the hash
+// cache relies on the size and modification time only.
+func fileInode(os.FileInfo) uint64 {
+	return 0
+}
@@ -19,18 +19,33 @@ var ErrFileTooLarge = errors.New("file exceeds max size")
 
 // hashFile returns the SHA-256 hex digest and size of a regular file.
 func hashFile(path string) (string, int64, error) {
-	info, err := os.Stat(path)
+	return hashFileCached(path, nil)
+}
+
+// hashFileCached is hashFile reusing the hash in cache when the file is unchanged.
+// cache may be nil.
+func hashFileCached(path string, cache *HashCache) (string, int64, error) {
+	info, statTime, err := statFile(path)
 	if err != nil {
-		return "", 0, fmt.Errorf("stat %s: %w", path, err)
+		return "", 0, err
 	}
 
 	if info.Size() > maxFileSizeBytes {
 		return "", info.Size(), fmt.Errorf("%w: %s (%d bytes)", ErrFileTooLarge, path, info.Size())
 	}
 
+	hash, err := cache.hash(path, info, statTime)
+	if err != nil {
+		return "", info.Size(), err
+	}
+	return hash, info.Size(), nil
+}
+
+// sha256File returns the SHA-256 hex digest of the contents of a file.
+func sha256File(path string) (string, error) {
 	f, err := os.Open(path)
 	if err != nil {
-		return "", info.Size(), fmt.Errorf("open %s: %w", path, err)
+		return "", fmt.Errorf("open %s: %w", path, err)
 	}
 	defer func() { _ = f.Close() }()
 
@@ -38,8 +53,8 @@ func hashFile(path string) (string, int64, error) {
 	buf := make([]byte, hashChunkSizeBytes)
 
 	if _, err := io.CopyBuffer(h, f, buf); err != nil {
-		return "", info.Size(), fmt.Errorf("hash %s: %w", path, err)
+		return "", fmt.Errorf("hash %s: %w", path, err)
 	}
 
-	return hex.EncodeToString(h.Sum(nil)), info.Size(), nil
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
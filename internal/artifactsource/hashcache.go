@@ -0,0 +1,239 @@
+package artifactsource
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const hashCacheVersion = 1
+
+// racyWindow is how long a file must be unmodified before its hash is cached. A file
+// written within the mtime granularity of the hash could change again without
+// changing its mtime, so such hashes are recomputed until the file settles.
+const racyWindow = 2 * time.Second
+
+// HashCache remembers the SHA-256 hashes of local files by absolute path, so that
+// unchanged files are not read again by later plans and applies. An entry is reused
+// only while the size, modification time and inode of the file are unchanged.
+//
+// The cache only avoids reading files: a hash from the cache is the hash of the
+// contents, so fingerprints and manifests are the same with or without it.
+// A nil *HashCache hashes every file. It is safe for concurrent use.
+type HashCache struct {
+	path string
+
+	mu      sync.Mutex
+	entries map[string]hashCacheEntry
+	dirty   bool
+}
+
+type hashCacheEntry struct {
+	Size    int64  `json:"size"`
+	ModTime int64  `json:"mtime"`
+	Inode   uint64 `json:"inode,omitempty"`
+	Hash    string `json:"hash"`
+}
+
+type hashCacheFile struct {
+	Version int                       `json:"version"`
+	Entries map[string]hashCacheEntry `json:"entries"`
+}
+
+// OpenHashCache loads the cache stored at path. A missing file is an empty cache.
+// A file that cannot be read or parsed also yields an empty cache, along with the
+// error, so the caller may report it and continue without the previous entries.
+func OpenHashCache(path string) (*HashCache, error) {
+	cache := &HashCache{path: path, entries: make(map[string]hashCacheEntry)}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cache, nil
+	}
+	if err != nil {
+		return cache, fmt.Errorf("read hash cache %s: %w", path, err)
+	}
+
+	var file hashCacheFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return cache, fmt.Errorf("parse hash cache %s: %w", path, err)
+	}
+	if file.Version == hashCacheVersion && file.Entries != nil {
+		cache.entries = file.Entries
+	}
+	return cache, nil
+}
+
+// Path is the file the cache is stored in.
+func (c *HashCache) Path() string {
+	if c == nil {
+		return ""
+	}
+	return c.path
+}
+
+// HashFile returns the SHA-256 hex digest of the file at path, from the cache when
+// the file is unchanged.
+func (c *HashCache) HashFile(path string) (string, error) {
+	info, statTime, err := statFile(path)
+	if err != nil {
+		return "", err
+	}
+	return c.hash(path, info, statTime)
+}
+
+// HashFiles is HashFile for every path, hashing HashConcurrency files at a time.
+// The hashes are returned in the order of paths.
+func (c *HashCache) HashFiles(paths []string) ([]string, error) {
+	hashes := make([]string, len(paths))
+	err := forEachParallel(len(paths), HashConcurrency, func(i int) error {
+		hash, err := c.HashFile(paths[i])
+		hashes[i] = hash
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hashes, nil
+}
+
+// Save writes the cache when entries were added since it was opened or last saved.
+// Entries of files that no longer exist are dropped. The file is replaced atomically,
+// so concurrent Terraform runs sharing a cache lose entries at worst, never corrupt it.
+func (c *HashCache) Save() error {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.dirty {
+		return nil
+	}
+
+	for path := range c.entries {
+		if _, err := os.Lstat(path); errors.Is(err, os.ErrNotExist) {
+			delete(c.entries, path)
+		}
+	}
+
+	content, err := json.Marshal(hashCacheFile{Version: hashCacheVersion, Entries: c.entries})
+	if err != nil {
+		return fmt.Errorf("encode hash cache: %w", err)
+	}
+
+	dir := filepath.Dir(c.path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create hash cache directory %s: %w", dir, err)
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("write hash cache %s: %w", c.path, err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write hash cache %s: %w", c.path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write hash cache %s: %w", c.path, err)
+	}
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		return fmt.Errorf("write hash cache %s: %w", c.path, err)
+	}
+
+	c.dirty = false
+	return nil
+}
+
+// hash returns the hash of the file described by info, which was obtained at statTime.
+func (c *HashCache) hash(path string, info os.FileInfo, statTime time.Time) (string, error) {
+	if c == nil {
+		return sha256File(path)
+	}
+
+	key, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("abs %s: %w", path, err)
+	}
+	current := hashCacheEntry{
+		Size:    info.Size(),
+		ModTime: info.ModTime().UnixNano(),
+		Inode:   fileInode(info),
+	}
+
+	c.mu.Lock()
+	cached, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && cached.Size == current.Size && cached.ModTime == current.ModTime && cached.Inode == current.Inode {
+		return cached.Hash, nil
+	}
+
+	hash, err := sha256File(path)
+	if err != nil {
+		return "", err
+	}
+
+	if statTime.Sub(info.ModTime()) > racyWindow {
+		current.Hash = hash
+		c.mu.Lock()
+		c.entries[key] = current
+		c.dirty = true
+		c.mu.Unlock()
+	}
+	return hash, nil
+}
+
+func statFile(path string) (os.FileInfo, time.Time, error) {
+	statTime := time.Now()
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, statTime, fmt.Errorf("stat %s: %w", path, err)
+	}
+	return info, statTime, nil
+}
+
+// forEachParallel calls fn for 0..n-1 with at most concurrency calls at a time.
+// It returns the first error; the remaining calls are skipped once an error occurred.
+func forEachParallel(n, concurrency int, fn func(i int) error) error {
+	sem := make(chan struct{}, concurrency)
+	errCh := make(chan error, 1)
+	done := make(chan struct{})
+	var failOnce sync.Once
+
+	var wg sync.WaitGroup
+loop:
+	for i := 0; i < n; i++ {
+		select {
+		case <-done:
+			break loop
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(i); err != nil {
+				failOnce.Do(func() {
+					errCh <- err
+					close(done)
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	select {
+	case err := <-errCh:
+		return err
+	default:
+		return nil
+	}
+}
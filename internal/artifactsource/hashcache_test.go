@@ -0,0 +1,180 @@
+package artifactsource
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSettledFile writes content with a modification time outside the racy window,
+// so that its hash is cached.
+func writeSettledFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestHashCache_ReusesHashOfUnchangedFile(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	path := filepath.Join(root, "weights.bin")
+	modTime := time.Now().Add(-time.Hour)
+	writeSettledFile(t, path, "hello", modTime)
+
+	cache, err := OpenHashCache(filepath.Join(root, "cache.json"))
+	require.NoError(t, err)
+
+	hash, err := cache.HashFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", hash)
+
+	// Same size and modification time: the cached hash is returned without reading the file.
+	require.NoError(t, os.WriteFile(path, []byte("jello"), 0o644))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+	cached, err := cache.HashFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, hash, cached)
+
+	// A new modification time invalidates the entry.
+	newModTime := modTime.Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, newModTime, newModTime))
+	rehashed, err := cache.HashFile(path)
+	require.NoError(t, err)
+	want, err := sha256File(path)
+	require.NoError(t, err)
+	assert.Equal(t, want, rehashed)
+}
+
+func TestHashCache_SkipsRecentlyModifiedFiles(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	path := filepath.Join(root, "main.py")
+	require.NoError(t, os.WriteFile(path, []byte("v1"), 0o644))
+
+	cache, err := OpenHashCache(filepath.Join(root, "cache.json"))
+	require.NoError(t, err)
+	_, err = cache.HashFile(path)
+	require.NoError(t, err)
+
+	assert.Empty(t, cache.entries)
+	assert.False(t, cache.dirty)
+}
+
+func TestHashCache_SaveAndOpen(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	cachePath := filepath.Join(root, "data", "cache.json")
+	kept := filepath.Join(root, "kept.txt")
+	removed := filepath.Join(root, "removed.txt")
+	modTime := time.Now().Add(-time.Hour)
+	writeSettledFile(t, kept, "kept", modTime)
+	writeSettledFile(t, removed, "removed", modTime)
+
+	cache, err := OpenHashCache(cachePath)
+	require.NoError(t, err)
+	_, err = cache.HashFiles([]string{kept, removed})
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(removed))
+	require.NoError(t, cache.Save())
+
+	reopened, err := OpenHashCache(cachePath)
+	require.NoError(t, err)
+	assert.Len(t, reopened.entries, 1, "entries of removed files are dropped")
+	assert.Contains(t, reopened.entries, kept)
+
+	matches, err := filepath.Glob(filepath.Join(root, "data", "*.tmp"))
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+}
+
+func TestOpenHashCache_Corrupt(t *testing.T) {
+	t.Parallel()
+
+	cachePath := filepath.Join(t.TempDir(), "cache.json")
+	require.NoError(t, os.WriteFile(cachePath, []byte("{not json"), 0o644))
+
+	cache, err := OpenHashCache(cachePath)
+	require.Error(t, err)
+	require.NotNil(t, cache)
+	assert.Empty(t, cache.entries)
+}
+
+func TestHashCache_NilHashesFiles(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "hello.txt")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0o644))
+
+	var cache *HashCache
+	hashes, err := cache.HashFiles([]string{path})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"}, hashes)
+	assert.NoError(t, cache.Save())
+}
+
+func TestScanLocalFiles_CacheKeepsFingerprint(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "src")
+	modTime := time.Now().Add(-time.Hour)
+	for i, name := range []string{"a.py", "lib/b.py", "lib/c.py", "model/weights.bin"} {
+		writeSettledFile(t, filepath.Join(dir, filepath.FromSlash(name)), name+string(rune('0'+i)), modTime)
+	}
+
+	uncached, _, err := scanLocalFiles(dir, nil, nil)
+	require.NoError(t, err)
+
+	cache, err := OpenHashCache(filepath.Join(root, "cache.json"))
+	require.NoError(t, err)
+	first, _, err := scanLocalFiles(dir, nil, cache)
+	require.NoError(t, err)
+	second, _, err := scanLocalFiles(dir, nil, cache)
+	require.NoError(t, err)
+
+	assert.Equal(t, uncached, first)
+	assert.Equal(t, uncached, second)
+	assert.Equal(t, directoryFingerprint(uncached), directoryFingerprint(second))
+	assert.FileExists(t, filepath.Join(root, "cache.json"))
+}
+
+func TestForEachParallel(t *testing.T) {
+	t.Parallel()
+
+	var calls, active, maxActive atomic.Int32
+	err := forEachParallel(50, HashConcurrency, func(int) error {
+		calls.Add(1)
+		current := active.Add(1)
+		defer active.Add(-1)
+		for {
+			prev := maxActive.Load()
+			if current <= prev || maxActive.CompareAndSwap(prev, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(50), calls.Load())
+	assert.LessOrEqual(t, maxActive.Load(), int32(HashConcurrency))
+
+	wantErr := errors.New("boom")
+	err = forEachParallel(50, HashConcurrency, func(i int) error {
+		if i == 3 {
+			return wantErr
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, wantErr)
+}
@@ -0,0 +1,167 @@
+package artifactsource
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// ignoreRule is a single compiled gitignore pattern.
+type ignoreRule struct {
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// CompileIgnore compiles patterns in gitignore syntax into an IgnoreFunc:
+//   - blank lines and lines starting with # are skipped (\# and \! escape a leading # or !);
+//   - a leading ! re-includes paths excluded by an earlier pattern;
+//   - a trailing / matches directories only;
+//   - a pattern containing a / other than a trailing one is anchored to the root,
+//     any other pattern matches a file or directory name at any depth;
+//   - *, ? and [...] match within a path segment, ** matches across segments.
+//
+// As in git, the last matching pattern wins, and a path inside an excluded
+// directory cannot be re-included because the directory is pruned.
+// CompileIgnore returns nil when no pattern remains.
+func CompileIgnore(patterns []string) (IgnoreFunc, error) {
+	var rules []ignoreRule
+	for _, line := range patterns {
+		rule, ok, err := compileIgnoreRule(line)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	return func(relPath string, isDir bool) bool {
+		ignored := false
+		for _, rule := range rules {
+			if rule.dirOnly && !isDir {
+				continue
+			}
+			if rule.re.MatchString(relPath) {
+				ignored = !rule.negate
+			}
+		}
+		return ignored
+	}, nil
+}
+
+// ReadIgnoreFile returns the lines of a gitignore-style file, e.g. .drignore or .gitignore.
+func ReadIgnoreFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open ignore file %s: %w", path, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read ignore file %s: %w", path, err)
+	}
+	return lines, nil
+}
+
+func compileIgnoreRule(line string) (ignoreRule, bool, error) {
+	pattern := strings.TrimRight(line, " \t")
+	if pattern == "" || strings.HasPrefix(pattern, "#") {
+		return ignoreRule{}, false, nil
+	}
+
+	var rule ignoreRule
+	switch {
+	case strings.HasPrefix(pattern, "!"):
+		rule.negate = true
+		pattern = pattern[1:]
+	case strings.HasPrefix(pattern, `\!`), strings.HasPrefix(pattern, `\#`):
+		pattern = pattern[1:]
+	}
+
+	if strings.HasSuffix(pattern, "/") {
+		rule.dirOnly = true
+		pattern = strings.TrimRight(pattern, "/")
+	}
+	if pattern == "" {
+		return ignoreRule{}, false, nil
+	}
+
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	expr, err := globToRegexp(pattern)
+	if err != nil {
+		return ignoreRule{}, false, fmt.Errorf("invalid ignore pattern %q: %w", line, err)
+	}
+	if !anchored {
+		expr = "(?:.*/)?" + expr
+	}
+
+	rule.re, err = regexp.Compile("^" + expr + "$")
+	if err != nil {
+		return ignoreRule{}, false, fmt.Errorf("invalid ignore pattern %q: %w", line, err)
+	}
+	return rule, true, nil
+}
+
+// globToRegexp translates a gitignore glob to a regular expression over
+// slash-separated relative paths.
+func globToRegexp(glob string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch c {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				atSegmentStart := i == 0 || glob[i-1] == '/'
+				switch {
+				case atSegmentStart && i+2 < len(glob) && glob[i+2] == '/':
+					// "**/" matches zero or more leading directories.
+					b.WriteString("(?:.*/)?")
+					i += 2
+				case atSegmentStart && i+2 == len(glob):
+					// trailing "/**" matches everything inside.
+					b.WriteString(".*")
+					i++
+				default:
+					b.WriteString("[^/]*")
+					i++
+				}
+				continue
+			}
+			b.WriteString("[^/]*")
+		case '?':
+			b.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				return "", fmt.Errorf("unterminated character class")
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		case '\\':
+			if i+1 < len(glob) {
+				i++
+				b.WriteString(regexp.QuoteMeta(string(glob[i])))
+			}
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return b.String(), nil
+}
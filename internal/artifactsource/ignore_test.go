@@ -0,0 +1,105 @@
+package artifactsource
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileIgnore_Matching(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		patterns []string
+		relPath  string
+		isDir    bool
+		want     bool
+	}{
+		{name: "name at any depth", patterns: []string{"node_modules"}, relPath: "web/node_modules", isDir: true, want: true},
+		{name: "glob on file name", patterns: []string{"*.pyc"}, relPath: "app/__pycache__/main.pyc", want: true},
+		{name: "glob does not cross segments", patterns: []string{"app*"}, relPath: "app/main.py", want: false},
+		{name: "directory-only pattern skips files", patterns: []string{"build/"}, relPath: "build", want: false},
+		{name: "directory-only pattern matches directories", patterns: []string{"build/"}, relPath: "src/build", isDir: true, want: true},
+		{name: "leading slash anchors to root", patterns: []string{"/dist"}, relPath: "web/dist", isDir: true, want: false},
+		{name: "anchored pattern matches at root", patterns: []string{"/dist"}, relPath: "dist", isDir: true, want: true},
+		{name: "inner slash anchors to root", patterns: []string{"docs/*.md"}, relPath: "sub/docs/a.md", want: false},
+		{name: "leading double star", patterns: []string{"**/cache"}, relPath: "a/b/cache", isDir: true, want: true},
+		{name: "inner double star", patterns: []string{"a/**/z.txt"}, relPath: "a/z.txt", want: true},
+		{name: "trailing double star", patterns: []string{"logs/**"}, relPath: "logs/2024/app.log", want: true},
+		{name: "trailing double star keeps directory", patterns: []string{"logs/**"}, relPath: "logs", isDir: true, want: false},
+		{name: "question mark", patterns: []string{"file?.txt"}, relPath: "file1.txt", want: true},
+		{name: "character class", patterns: []string{"*.py[co]"}, relPath: "main.pyo", want: true},
+		{name: "negated character class", patterns: []string{"v[!0-9]"}, relPath: "v1", want: false},
+		{name: "negation re-includes", patterns: []string{"*.env", "!example.env"}, relPath: "example.env", want: false},
+		{name: "last match wins", patterns: []string{"!keep.txt", "*.txt"}, relPath: "keep.txt", want: true},
+		{name: "comments and blank lines", patterns: []string{"# comment", "", "   "}, relPath: "# comment", want: false},
+		{name: "escaped hash", patterns: []string{`\#notes`}, relPath: "#notes", want: true},
+		{name: "trailing spaces trimmed", patterns: []string{".venv  "}, relPath: ".venv", isDir: true, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ignore, err := CompileIgnore(tt.patterns)
+			require.NoError(t, err)
+			got := ignore != nil && ignore(tt.relPath, tt.isDir)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompileIgnore_NoPatterns(t *testing.T) {
+	t.Parallel()
+
+	ignore, err := CompileIgnore([]string{"", "# only comments"})
+	require.NoError(t, err)
+	assert.Nil(t, ignore)
+}
+
+func TestCompileIgnore_InvalidPattern(t *testing.T) {
+	t.Parallel()
+
+	_, err := CompileIgnore([]string{"[abc"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"[abc"`)
+}
+
+func TestCompileIgnore_PrunesWalk(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "node_modules", "pkg"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "src"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "node_modules", "pkg", "index.js"), []byte("x"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "src", "app.py"), []byte("y"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "src", "app.pyc"), []byte("z"), 0o644))
+
+	ignore, err := CompileIgnore([]string{"node_modules/", "*.pyc"})
+	require.NoError(t, err)
+
+	entries, err := walkDirectory(root, ignore)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "src/app.py", entries[0].RelPath)
+}
+
+func TestReadIgnoreFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), ".drignore")
+	require.NoError(t, os.WriteFile(path, []byte("node_modules/\r\n# comment\n.venv\n"), 0o644))
+
+	lines, err := ReadIgnoreFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"node_modules/", "# comment", ".venv"}, lines)
+
+	ignore, err := CompileIgnore(lines)
+	require.NoError(t, err)
+	assert.True(t, ignore("node_modules", true))
+	assert.True(t, ignore(".venv", true))
+}
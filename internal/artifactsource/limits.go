@@ -1,10 +1,73 @@
 package artifactsource
 
+import (
+	"time"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
+)
+
 // Upload orchestration tunables ported from cli/internal/workload/sync/limits.go.
+// UploadConcurrency and the StageVsZip thresholds are the defaults of Limits.
 const (
 	UploadConcurrency = 4
 
+	// DownloadConcurrency is the number of files PullVersion downloads at a time.
+	DownloadConcurrency = 4
+
+	// PruneConcurrency is the number of catalog versions PruneVersions deletes at a time.
+	PruneConcurrency = 4
+
+	// HashConcurrency is the number of files hashed at a time.
+	HashConcurrency = 8
+
 	// Stage path is used when file count and total bytes are both at or below these thresholds.
 	StageVsZipFileThreshold  = 20
 	StageVsZipBytesThreshold = 50 * 1024 * 1024
+
+	// Files of at least ChunkedUploadThreshold bytes are uploaded to the stage in parts
+	// of filesapi.ChunkedUploadPartSize, so a network failure only resends one part.
+	// A push with such a file always takes the stage path.
+	ChunkedUploadThreshold = 256 * 1024 * 1024
 )
+
+// Throttling of stage uploads (provider-only). When the Files API answers 429, the
+// number of concurrent uploads is halved and the file is uploaded again after a backoff.
+// The number grows back by one after as many successful uploads in a row, up to the
+// configured UploadConcurrency.
+const (
+	// UploadThrottleAttempts caps the attempts to upload a single file that is throttled.
+	UploadThrottleAttempts = 5
+
+	// UploadThrottleWaitMin and UploadThrottleWaitMax bound the exponential backoff
+	// between attempts of a throttled file.
+	UploadThrottleWaitMin = 1 * time.Second
+	UploadThrottleWaitMax = 30 * time.Second
+)
+
+// Limits tune the uploads of PushDirectory. Zero fields use the package defaults.
+type Limits struct {
+	// UploadConcurrency is the maximum number of files uploaded to a stage at a time.
+	UploadConcurrency int
+	// StageVsZipFileThreshold and StageVsZipBytesThreshold are the largest number of
+	// files and total bytes that are uploaded through a stage instead of a zip archive.
+	StageVsZipFileThreshold  int
+	StageVsZipBytesThreshold int64
+	// ZipPollTimeout caps the wait for the server to extract an uploaded zip archive.
+	ZipPollTimeout time.Duration
+}
+
+func (l Limits) withDefaults() Limits {
+	if l.UploadConcurrency <= 0 {
+		l.UploadConcurrency = UploadConcurrency
+	}
+	if l.StageVsZipFileThreshold <= 0 {
+		l.StageVsZipFileThreshold = StageVsZipFileThreshold
+	}
+	if l.StageVsZipBytesThreshold <= 0 {
+		l.StageVsZipBytesThreshold = StageVsZipBytesThreshold
+	}
+	if l.ZipPollTimeout <= 0 {
+		l.ZipPollTimeout = filesapi.ZipPollTimeout
+	}
+	return l
+}
@@ -10,6 +10,17 @@ type FileMeta struct {
 // Manifest maps normalized relative paths to per-file metadata.
 type Manifest map[string]FileMeta
 
+// ScanManifest hashes the files under dir that PushDirectory would upload,
+// without uploading them, e.g. to preview the changes of the next push.
+// cache may be nil.
+func ScanManifest(dir string, ignore IgnoreFunc, cache *HashCache) (Manifest, error) {
+	files, _, err := scanLocalFiles(dir, ignore, cache)
+	if err != nil {
+		return nil, err
+	}
+	return manifestFromFiles(files), nil
+}
+
 func manifestFromFiles(files []LocalFile) Manifest {
 	out := make(Manifest, len(files))
 	for _, f := range files {
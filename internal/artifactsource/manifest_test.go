@@ -1,9 +1,12 @@
 package artifactsource
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestManifestFromFiles(t *testing.T) {
@@ -18,3 +21,21 @@ func TestManifestFromFiles(t *testing.T) {
 	assert.Equal(t, FileMeta{Hash: "aaa", Size: 3}, m["a.txt"])
 	assert.Equal(t, FileMeta{Hash: "bbb", Size: 3}, m["b.txt"])
 }
+
+func TestScanManifest(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "src"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "src", "app.py"), []byte("print()"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "app.pyc"), []byte("x"), 0o644))
+
+	ignore, err := CompileIgnore([]string{"*.pyc"})
+	require.NoError(t, err)
+
+	m, err := ScanManifest(root, ignore, nil)
+	require.NoError(t, err)
+	require.Len(t, m, 1)
+	assert.Equal(t, int64(7), m["src/app.py"].Size)
+	assert.Len(t, m["src/app.py"].Hash, 64)
+}
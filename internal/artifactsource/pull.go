@@ -0,0 +1,153 @@
+package artifactsource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
+)
+
+// ErrDownloadMismatch is returned when a downloaded file differs in size or hash from
+// the catalog.
+var ErrDownloadMismatch = errors.New("downloaded file does not match the catalog")
+
+// PullVersion downloads the files of a catalog version into a local directory,
+// DownloadConcurrency files at a time. Each file is written to a temporary file
+// next to its target and renamed into place only after its size and SHA-256 hash
+// match the catalog, so an interrupted pull never leaves a partial file behind.
+//
+// Files that already exist with the size and hash of the catalog are not downloaded
+// again. Local files that are not in the catalog version are left untouched.
+func PullVersion(ctx context.Context, client filesapi.Client, opts PullOptions) (*PullResult, error) {
+	if client == nil {
+		return nil, errors.New("files API client is required")
+	}
+	if opts.Dir == "" {
+		return nil, errors.New("directory path is required")
+	}
+	if opts.CatalogID == "" || opts.CatalogVersionID == "" {
+		return nil, errors.New("catalog ID and catalog version ID are required")
+	}
+
+	remote, err := client.AllFiles(ctx, opts.CatalogID, opts.CatalogVersionID)
+	if err != nil {
+		return nil, fmt.Errorf("list files: %w", err)
+	}
+
+	paths := make([]string, 0, len(remote))
+	for path := range remote {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create directory %s: %w", opts.Dir, err)
+	}
+
+	hashes := make([]string, len(paths))
+	downloaded := make([]bool, len(paths))
+	err = forEachParallel(len(paths), DownloadConcurrency, func(i int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		hash, fetched, err := pullFile(ctx, client, opts, paths[i], remote[paths[i]])
+		hashes[i], downloaded[i] = hash, fetched
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PullResult{
+		CatalogVersionID: opts.CatalogVersionID,
+		FileHashes:       make(Manifest, len(paths)),
+		FileCount:        len(paths),
+	}
+	for i, path := range paths {
+		meta := remote[path]
+		result.FileHashes[path] = FileMeta{Hash: hashes[i], Size: meta.Size}
+		result.TotalBytes += meta.Size
+		if downloaded[i] {
+			result.Downloaded++
+		}
+		if !isSHA256Hex(meta.Hash) {
+			result.Unverified = append(result.Unverified, path)
+		}
+	}
+	return result, nil
+}
+
+// pullFile makes the file at relPath under opts.Dir match meta and returns its hash
+// and whether it was downloaded.
+func pullFile(ctx context.Context, client filesapi.Client, opts PullOptions, relPath string, meta filesapi.FileMeta) (string, bool, error) {
+	if err := filesapi.SafeRelPath(relPath); err != nil {
+		return "", false, fmt.Errorf("catalog file %q: %w", relPath, err)
+	}
+	target := filepath.Join(opts.Dir, filepath.FromSlash(relPath))
+
+	if hash, ok := upToDateHash(target, meta, opts.HashCache); ok {
+		return hash, false, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return "", false, fmt.Errorf("create directory for %s: %w", relPath, err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".*.tmp")
+	if err != nil {
+		return "", false, fmt.Errorf("create %s: %w", relPath, err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	h := sha256.New()
+	_, size, err := client.DownloadFile(ctx, opts.CatalogID, opts.CatalogVersionID, relPath, io.MultiWriter(tmp, h))
+	if closeErr := tmp.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("write %s: %w", relPath, closeErr)
+	}
+	if err != nil {
+		return "", false, err
+	}
+
+	hash := hex.EncodeToString(h.Sum(nil))
+	if size != meta.Size {
+		return "", false, fmt.Errorf("%w: %s is %d bytes, the catalog lists %d", ErrDownloadMismatch, relPath, size, meta.Size)
+	}
+	if isSHA256Hex(meta.Hash) && !strings.EqualFold(hash, meta.Hash) {
+		return "", false, fmt.Errorf("%w: %s has SHA-256 %s, the catalog lists %s", ErrDownloadMismatch, relPath, hash, meta.Hash)
+	}
+
+	// os.CreateTemp creates the file private to the owner; pulled files get the
+	// permissions of a regular file written by hand.
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return "", false, fmt.Errorf("write %s: %w", relPath, err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return "", false, fmt.Errorf("write %s: %w", relPath, err)
+	}
+	return hash, true, nil
+}
+
+// upToDateHash returns the hash of the file at path when it already matches meta.
+// Without a SHA-256 checksum in meta a local file can't be verified, so it is never
+// up to date.
+func upToDateHash(path string, meta filesapi.FileMeta, cache *HashCache) (string, bool) {
+	if !isSHA256Hex(meta.Hash) {
+		return "", false
+	}
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() || info.Size() != meta.Size {
+		return "", false
+	}
+	hash, err := cache.HashFile(path)
+	if err != nil || !strings.EqualFold(hash, meta.Hash) {
+		return "", false
+	}
+	return hash, true
+}
@@ -0,0 +1,141 @@
+package artifactsource_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func pullOptions(dir string) artifactsource.PullOptions {
+	return artifactsource.PullOptions{Dir: dir, CatalogID: "cat-1", CatalogVersionID: "ver-1"}
+}
+
+func TestPullVersion_DownloadsAllFiles(t *testing.T) {
+	t.Parallel()
+
+	client := &mockFilesAPI{remoteFiles: map[string]string{
+		"main.py":          "print('hi')",
+		"lib/util.py":      "def f(): pass",
+		"deep/a/b/data.md": "",
+	}}
+	dir := filepath.Join(t.TempDir(), "checkout")
+
+	result, err := artifactsource.PullVersion(context.Background(), client, pullOptions(dir))
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, result.FileCount)
+	assert.Equal(t, 3, result.Downloaded)
+	assert.Equal(t, int64(len("print('hi')")+len("def f(): pass")), result.TotalBytes)
+	assert.Empty(t, result.Unverified)
+
+	for path, content := range client.remoteFiles {
+		got, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(path)))
+		require.NoError(t, err)
+		assert.Equal(t, content, string(got))
+		assert.Equal(t, artifactsource.FileMeta{Hash: sha256Hex(content), Size: int64(len(content))}, result.FileHashes[path])
+	}
+}
+
+func TestPullVersion_DownloadedFilesAreWorldReadable(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("Windows doesn't have Unix file permissions")
+	}
+
+	client := &mockFilesAPI{remoteFiles: map[string]string{"main.py": "print('hi')"}}
+	dir := t.TempDir()
+
+	_, err := artifactsource.PullVersion(context.Background(), client, pullOptions(dir))
+	require.NoError(t, err)
+
+	info, err := os.Stat(filepath.Join(dir, "main.py"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o644), info.Mode().Perm())
+}
+
+func TestPullVersion_SkipsUpToDateFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "same.py"), []byte("same"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "stale.py"), []byte("old"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "local-only.py"), []byte("keep"), 0o644))
+
+	client := &mockFilesAPI{remoteFiles: map[string]string{"same.py": "same", "stale.py": "new"}}
+
+	result, err := artifactsource.PullVersion(context.Background(), client, pullOptions(dir))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"stale.py"}, client.downloadPaths)
+	assert.Equal(t, 1, result.Downloaded)
+
+	got, err := os.ReadFile(filepath.Join(dir, "stale.py"))
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(got))
+	assert.FileExists(t, filepath.Join(dir, "local-only.py"), "files outside the catalog are left untouched")
+}
+
+func TestPullVersion_RejectsChecksumMismatch(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.py"), []byte("local"), 0o644))
+
+	client := &mockFilesAPI{
+		remoteFiles:     map[string]string{"main.py": "corrupted"},
+		remoteChecksums: map[string]string{"main.py": sha256Hex("expected!")},
+	}
+
+	_, err := artifactsource.PullVersion(context.Background(), client, pullOptions(dir))
+	require.ErrorIs(t, err, artifactsource.ErrDownloadMismatch)
+	assert.Contains(t, err.Error(), "main.py")
+
+	got, err := os.ReadFile(filepath.Join(dir, "main.py"))
+	require.NoError(t, err)
+	assert.Equal(t, "local", string(got), "a file failing verification is not written")
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary files are removed")
+}
+
+func TestPullVersion_NonSHA256ChecksumIsUnverified(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.py"), []byte("v1"), 0o644))
+
+	client := &mockFilesAPI{
+		remoteFiles:     map[string]string{"main.py": "v1", "util.py": "u"},
+		remoteChecksums: map[string]string{"main.py": "d41d8cd98f00b204e9800998ecf8427e"},
+	}
+
+	result, err := artifactsource.PullVersion(context.Background(), client, pullOptions(dir))
+	require.NoError(t, err)
+
+	sort.Strings(client.downloadPaths)
+	assert.Equal(t, []string{"main.py", "util.py"}, client.downloadPaths, "files without a SHA-256 checksum are always downloaded")
+	assert.Equal(t, []string{"main.py"}, result.Unverified)
+	assert.Equal(t, sha256Hex("v1"), result.FileHashes["main.py"].Hash)
+}
+
+func TestPullVersion_RequiresVersion(t *testing.T) {
+	t.Parallel()
+
+	_, err := artifactsource.PullVersion(context.Background(), &mockFilesAPI{}, artifactsource.PullOptions{Dir: t.TempDir(), CatalogID: "cat-1"})
+	require.Error(t, err)
+}
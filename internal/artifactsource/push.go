@@ -25,7 +25,7 @@ func PushDirectory(ctx context.Context, client filesapi.Client, opts Options) (*
 		overwrite = filesapi.OverwriteReplace
 	}
 
-	files, totalBytes, err := scanLocalFiles(opts.Dir, opts.Ignore)
+	files, totalBytes, err := scanLocalFiles(opts.Dir, opts.Ignore, opts.HashCache)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +49,7 @@ func PushDirectory(ctx context.Context, client filesapi.Client, opts Options) (*
 			return result, nil
 		}
 
-		catalogID, versionID, err := applyIncrementalPush(ctx, client, opts.CatalogID, overwrite, plan, byPath)
+		catalogID, versionID, err := applyIncrementalPush(ctx, client, opts.CatalogID, overwrite, plan, byPath, opts.Limits)
 		if err != nil {
 			return nil, err
 		}
@@ -60,7 +60,7 @@ func PushDirectory(ctx context.Context, client filesapi.Client, opts Options) (*
 		return result, nil
 	}
 
-	up := chooseUploader(files)
+	up := chooseUploader(files, opts.Limits)
 	catalogID, versionID, err := up.upload(ctx, client, opts.CatalogID, overwrite, files)
 	if err != nil {
 		return nil, err
@@ -75,7 +75,9 @@ func canIncrementalPush(opts Options) bool {
 	return opts.CatalogID != "" && len(opts.BaseFiles) > 0
 }
 
-func scanLocalFiles(dir string, ignore IgnoreFunc) ([]LocalFile, int64, error) {
+// scanLocalFiles walks dir and hashes the files that are not ignored, HashConcurrency
+// at a time. Hashes come from cache when the files are unchanged; cache may be nil.
+func scanLocalFiles(dir string, ignore IgnoreFunc, cache *HashCache) ([]LocalFile, int64, error) {
 	entries, err := walkDirectory(dir, ignore)
 	if err != nil {
 		return nil, 0, err
@@ -84,26 +86,36 @@ func scanLocalFiles(dir string, ignore IgnoreFunc) ([]LocalFile, int64, error) {
 		return nil, 0, fmt.Errorf("directory %s contains no uploadable files", dir)
 	}
 
-	files := make([]LocalFile, 0, len(entries))
-	var totalBytes int64
-
 	for _, e := range entries {
 		if err := filesapi.SafeRelPath(e.RelPath); err != nil {
 			return nil, 0, fmt.Errorf("invalid path %q: %w", e.RelPath, err)
 		}
+	}
 
-		hash, size, err := hashFile(e.AbsPath)
+	files := make([]LocalFile, len(entries))
+	err = forEachParallel(len(entries), HashConcurrency, func(i int) error {
+		hash, size, err := hashFileCached(entries[i].AbsPath, cache)
 		if err != nil {
-			return nil, 0, err
+			return err
 		}
-
-		files = append(files, LocalFile{
-			RelPath: e.RelPath,
-			AbsPath: e.AbsPath,
+		files[i] = LocalFile{
+			RelPath: entries[i].RelPath,
+			AbsPath: entries[i].AbsPath,
 			Size:    size,
 			Hash:    hash,
-		})
-		totalBytes += size
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The cache only saves work on the next scan, so failing to persist it is not an error.
+	_ = cache.Save()
+
+	var totalBytes int64
+	for _, f := range files {
+		totalBytes += f.Size
 	}
 
 	return files, totalBytes, nil
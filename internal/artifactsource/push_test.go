@@ -27,6 +27,16 @@ type mockFilesAPI struct {
 	pollStatusCalls         int
 	deletePaths             []string
 	deleteCalls             int
+	downloadPaths           []string
+
+	// remoteFiles are the contents of the catalog version listed by AllFiles and
+	// served by DownloadFile. remoteChecksums overrides their SHA-256 checksums.
+	remoteFiles     map[string]string
+	remoteChecksums map[string]string
+
+	// versions are listed by ListVersions; DeleteVersion records deletedVersions.
+	versions        []filesapi.CatalogVersion
+	deletedVersions []string
 
 	catalogID string
 	stageID   string
@@ -55,6 +65,13 @@ func (m *mockFilesAPI) UploadToStage(_ context.Context, _, _, name string, _ int
 	return nil
 }
 
+func (m *mockFilesAPI) UploadToStageChunked(_ context.Context, _, _, name string, _ int64, _ io.ReaderAt, _ int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.uploadToStagePaths = append(m.uploadToStagePaths, name)
+	return nil
+}
+
 func (m *mockFilesAPI) ApplyStage(_ context.Context, catalogID, _, _ string) (*filesapi.ApplyStageResp, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -84,11 +101,27 @@ func (m *mockFilesAPI) PollStatus(_ context.Context, _ string) (*filesapi.Status
 }
 
 func (m *mockFilesAPI) AllFiles(context.Context, string, string) (map[string]filesapi.FileMeta, error) {
-	panic("not used")
+	out := make(map[string]filesapi.FileMeta, len(m.remoteFiles))
+	for path, content := range m.remoteFiles {
+		checksum, ok := m.remoteChecksums[path]
+		if !ok {
+			checksum = sha256Hex(content)
+		}
+		out[path] = filesapi.FileMeta{Hash: checksum, Size: int64(len(content))}
+	}
+	return out, nil
 }
 
-func (m *mockFilesAPI) DownloadFile(context.Context, string, string, string, io.Writer) (string, int64, error) {
-	panic("not used")
+func (m *mockFilesAPI) DownloadFile(_ context.Context, _, _, path string, w io.Writer) (string, int64, error) {
+	m.mu.Lock()
+	m.downloadPaths = append(m.downloadPaths, path)
+	content, ok := m.remoteFiles[path]
+	m.mu.Unlock()
+	if !ok {
+		return "", 0, fmt.Errorf("download %s: not found", path)
+	}
+	n, err := io.WriteString(w, content)
+	return "", int64(n), err
 }
 
 func (m *mockFilesAPI) DeleteFiles(_ context.Context, _ string, paths []string) (*filesapi.DeleteFilesResp, error) {
@@ -98,10 +131,24 @@ func (m *mockFilesAPI) DeleteFiles(_ context.Context, _ string, paths []string)
 	return &filesapi.DeleteFilesResp{CatalogVersionID: versionID(m.version)}, nil
 }
 
-func (m *mockFilesAPI) ListVersions(context.Context, string, int) ([]filesapi.CatalogVersion, error) {
+func (m *mockFilesAPI) ListVersions(_ context.Context, _ string, limit int) ([]filesapi.CatalogVersion, error) {
+	if limit > 0 && limit < len(m.versions) {
+		return m.versions[:limit], nil
+	}
+	return m.versions, nil
+}
+
+func (m *mockFilesAPI) DeleteCatalog(context.Context, string) error {
 	panic("not used")
 }
 
+func (m *mockFilesAPI) DeleteVersion(_ context.Context, _, versionID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deletedVersions = append(m.deletedVersions, versionID)
+	return nil
+}
+
 func versionID(n int) string {
 	return fmt.Sprintf("ver-%d", n)
 }
@@ -0,0 +1,67 @@
+package artifactsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
+)
+
+// PruneOptions configure a PruneVersions call.
+type PruneOptions struct {
+	CatalogID string
+	// Retain is the number of most recent versions that are kept.
+	Retain int
+	// Keep are versions that are kept regardless of their age, such as the versions
+	// referenced by a code_ref.
+	Keep map[string]bool
+	// DryRun only returns the versions that would be deleted.
+	DryRun bool
+}
+
+// PruneVersions deletes the versions of a catalog beyond the Retain most recent ones,
+// except the versions in Keep, PruneConcurrency at a time. It returns the IDs of the
+// deleted versions, newest first. A failed deletion stops the pruning; the versions
+// deleted until then are not returned.
+func PruneVersions(ctx context.Context, client filesapi.Client, opts PruneOptions) ([]string, error) {
+	if client == nil {
+		return nil, errors.New("files API client is required")
+	}
+	if opts.CatalogID == "" {
+		return nil, errors.New("catalog ID is required")
+	}
+	if opts.Retain < 1 {
+		return nil, fmt.Errorf("at least one version must be retained, got %d", opts.Retain)
+	}
+
+	// ListVersions returns the newest versions first.
+	versions, err := client.ListVersions(ctx, opts.CatalogID, 0)
+	if err != nil {
+		return nil, fmt.Errorf("list versions of catalog %s: %w", opts.CatalogID, err)
+	}
+	if len(versions) <= opts.Retain {
+		return nil, nil
+	}
+
+	var prune []string
+	for _, version := range versions[opts.Retain:] {
+		if !opts.Keep[version.ID] {
+			prune = append(prune, version.ID)
+		}
+	}
+	if opts.DryRun || len(prune) == 0 {
+		return prune, nil
+	}
+
+	err = forEachParallel(len(prune), PruneConcurrency, func(i int) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return client.DeleteVersion(ctx, opts.CatalogID, prune[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+	return prune, nil
+}
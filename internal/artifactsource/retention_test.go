@@ -0,0 +1,74 @@
+package artifactsource_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newestFirstVersions returns versions ver-n down to ver-1, as ListVersions orders them.
+func newestFirstVersions(n int) []filesapi.CatalogVersion {
+	versions := make([]filesapi.CatalogVersion, 0, n)
+	for i := n; i >= 1; i-- {
+		versions = append(versions, filesapi.CatalogVersion{ID: fmt.Sprintf("ver-%d", i)})
+	}
+	return versions
+}
+
+func TestPruneVersions_KeepsRecentAndReferenced(t *testing.T) {
+	t.Parallel()
+
+	client := &mockFilesAPI{versions: newestFirstVersions(6)}
+
+	pruned, err := artifactsource.PruneVersions(context.Background(), client, artifactsource.PruneOptions{
+		CatalogID: "cat-1",
+		Retain:    2,
+		Keep:      map[string]bool{"ver-3": true},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ver-4", "ver-2", "ver-1"}, pruned)
+
+	sort.Strings(client.deletedVersions)
+	assert.Equal(t, []string{"ver-1", "ver-2", "ver-4"}, client.deletedVersions)
+}
+
+func TestPruneVersions_DryRunDeletesNothing(t *testing.T) {
+	t.Parallel()
+
+	client := &mockFilesAPI{versions: newestFirstVersions(4)}
+
+	pruned, err := artifactsource.PruneVersions(context.Background(), client, artifactsource.PruneOptions{
+		CatalogID: "cat-1",
+		Retain:    1,
+		DryRun:    true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ver-3", "ver-2", "ver-1"}, pruned)
+	assert.Empty(t, client.deletedVersions)
+}
+
+func TestPruneVersions_NothingToPrune(t *testing.T) {
+	t.Parallel()
+
+	client := &mockFilesAPI{versions: newestFirstVersions(2)}
+
+	pruned, err := artifactsource.PruneVersions(context.Background(), client, artifactsource.PruneOptions{CatalogID: "cat-1", Retain: 5})
+	require.NoError(t, err)
+	assert.Empty(t, pruned)
+	assert.Empty(t, client.deletedVersions)
+}
+
+func TestPruneVersions_RequiresRetain(t *testing.T) {
+	t.Parallel()
+
+	_, err := artifactsource.PruneVersions(context.Background(), &mockFilesAPI{}, artifactsource.PruneOptions{CatalogID: "cat-1"})
+	require.Error(t, err)
+}
@@ -2,16 +2,23 @@ package artifactsource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-type stageUploader struct{}
+// stageUploader uploads files to a stage, at most concurrency at a time
+// (UploadConcurrency when zero), and applies it.
+type stageUploader struct {
+	concurrency int
+}
 
-func (stageUploader) upload(ctx context.Context, client filesapi.Client, catalogID, overwrite string, files []LocalFile) (string, string, error) {
+func (u stageUploader) upload(ctx context.Context, client filesapi.Client, catalogID, overwrite string, files []LocalFile) (string, string, error) {
 	if catalogID == "" {
 		cat, err := client.CreateCatalog(ctx)
 		if err != nil {
@@ -25,7 +32,7 @@ func (stageUploader) upload(ctx context.Context, client filesapi.Client, catalog
 		return "", "", fmt.Errorf("create stage: %w", err)
 	}
 
-	if err := uploadFilesParallel(ctx, client, catalogID, stage.StageID, files); err != nil {
+	if err := uploadFilesParallel(ctx, client, catalogID, stage.StageID, files, u.concurrency); err != nil {
 		return "", "", err
 	}
 
@@ -37,17 +44,23 @@ func (stageUploader) upload(ctx context.Context, client filesapi.Client, catalog
 	return catalogID, apply.CatalogVersionID, nil
 }
 
-func uploadFilesParallel(ctx context.Context, client filesapi.Client, catalogID, stageID string, files []LocalFile) error {
+// uploadFilesParallel uploads files to a stage, at most concurrency at a time
+// (UploadConcurrency when zero). Throttled uploads reduce the concurrency and are
+// retried, see adaptiveLimiter.
+func uploadFilesParallel(ctx context.Context, client filesapi.Client, catalogID, stageID string, files []LocalFile, concurrency int) error {
 	if len(files) == 0 {
 		return nil
 	}
+	if concurrency <= 0 {
+		concurrency = UploadConcurrency
+	}
 
 	done := make(chan struct{})
 	var cancelOnce sync.Once
 	cancel := func() { cancelOnce.Do(func() { close(done) }) }
 	defer cancel()
 
-	sem := make(chan struct{}, UploadConcurrency)
+	limiter := newAdaptiveLimiter(concurrency)
 	errCh := make(chan error, len(files))
 
 	var wg sync.WaitGroup
@@ -57,16 +70,7 @@ func uploadFilesParallel(ctx context.Context, client filesapi.Client, catalogID,
 		go func() {
 			defer wg.Done()
 
-			select {
-			case sem <- struct{}{}:
-			case <-done:
-				return
-			case <-ctx.Done():
-				return
-			}
-			defer func() { <-sem }()
-
-			if err := uploadOneToStage(ctx, client, catalogID, stageID, f); err != nil {
+			if err := uploadWithThrottleRetry(ctx, client, catalogID, stageID, f, limiter, done); err != nil {
 				select {
 				case errCh <- err:
 					cancel()
@@ -94,6 +98,46 @@ func uploadFilesParallel(ctx context.Context, client filesapi.Client, catalogID,
 	return nil
 }
 
+// uploadWithThrottleRetry uploads f within a slot of limiter. A throttled upload is
+// retried after a backoff, up to UploadThrottleAttempts times. It returns nil without
+// uploading when ctx is done or stop is closed.
+func uploadWithThrottleRetry(
+	ctx context.Context,
+	client filesapi.Client,
+	catalogID, stageID string,
+	f LocalFile,
+	limiter *adaptiveLimiter,
+	stop <-chan struct{},
+) error {
+	wait := UploadThrottleWaitMin
+	for attempt := 1; ; attempt++ {
+		if !limiter.acquire(ctx, stop) {
+			return nil
+		}
+		err := uploadOneToStage(ctx, client, catalogID, stageID, f)
+		throttled := errors.Is(err, filesapi.ErrThrottled)
+		limiter.release(throttled)
+		if !throttled || attempt >= UploadThrottleAttempts {
+			return err
+		}
+
+		tflog.Warn(ctx, "Files API throttled upload, reducing upload concurrency", map[string]any{
+			"file":               f.RelPath,
+			"attempt":            attempt,
+			"upload_concurrency": limiter.currentLimit(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-stop:
+			return nil
+		case <-time.After(wait):
+		}
+		wait = min(wait*2, UploadThrottleWaitMax)
+	}
+}
+
 func uploadOneToStage(ctx context.Context, client filesapi.Client, catalogID, stageID string, f LocalFile) error {
 	file, err := os.Open(f.AbsPath)
 	if err != nil {
@@ -101,6 +145,18 @@ func uploadOneToStage(ctx context.Context, client filesapi.Client, catalogID, st
 	}
 	defer func() { _ = file.Close() }()
 
+	if f.Size >= ChunkedUploadThreshold {
+		tflog.Info(ctx, "Uploading artifact source file in parts", map[string]any{
+			"file":        f.RelPath,
+			"total_bytes": f.Size,
+			"part_bytes":  filesapi.ChunkedUploadPartSize,
+		})
+		if err := client.UploadToStageChunked(ctx, catalogID, stageID, f.RelPath, f.Size, file, filesapi.ChunkedUploadPartSize); err != nil {
+			return fmt.Errorf("upload %s: %w", f.RelPath, err)
+		}
+		return nil
+	}
+
 	if err := client.UploadToStage(ctx, catalogID, stageID, f.RelPath, f.Size, file); err != nil {
 		return fmt.Errorf("upload %s: %w", f.RelPath, err)
 	}
@@ -24,6 +24,7 @@ type stageClientMock struct {
 	createStageCalls   int
 	applyStageCalls    int
 	uploadPaths        []string
+	chunkedPaths       []string
 
 	createCatalogErr error
 	createStageErr   error
@@ -93,6 +94,16 @@ func (m *stageClientMock) UploadToStage(_ context.Context, _, _, name string, _
 	return nil
 }
 
+func (m *stageClientMock) UploadToStageChunked(_ context.Context, _, _, name string, _ int64, _ io.ReaderAt, partSize int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if partSize != filesapi.ChunkedUploadPartSize {
+		return fmt.Errorf("unexpected part size %d", partSize)
+	}
+	m.chunkedPaths = append(m.chunkedPaths, name)
+	return nil
+}
+
 func (m *stageClientMock) ApplyStage(_ context.Context, catalogID, _, _ string) (*filesapi.ApplyStageResp, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -132,6 +143,14 @@ func (m *stageClientMock) ListVersions(context.Context, string, int) ([]filesapi
 	panic("not used in stage tests")
 }
 
+func (m *stageClientMock) DeleteCatalog(context.Context, string) error {
+	panic("not used in stage tests")
+}
+
+func (m *stageClientMock) DeleteVersion(context.Context, string, string) error {
+	panic("not used in stage tests")
+}
+
 func stageVersionID(n int) string {
 	return fmt.Sprintf("ver-%d", n)
 }
@@ -153,7 +172,7 @@ func TestUploadFilesParallel_Empty(t *testing.T) {
 	t.Parallel()
 
 	mock := &stageClientMock{}
-	err := uploadFilesParallel(context.Background(), mock, "cat", "stage", nil)
+	err := uploadFilesParallel(context.Background(), mock, "cat", "stage", nil, 0)
 	require.NoError(t, err)
 	assert.Empty(t, mock.uploadPaths)
 }
@@ -164,11 +183,24 @@ func TestUploadFilesParallel_AllSucceed(t *testing.T) {
 	files := writeStageTestFiles(t, "a.txt", "b.txt", "c.txt")
 	mock := &stageClientMock{}
 
-	err := uploadFilesParallel(context.Background(), mock, "cat", "stage", files)
+	err := uploadFilesParallel(context.Background(), mock, "cat", "stage", files, 0)
 	require.NoError(t, err)
 	assert.ElementsMatch(t, []string{"a.txt", "b.txt", "c.txt"}, mock.uploadPaths)
 }
 
+func TestUploadFilesParallel_ChunksLargeFiles(t *testing.T) {
+	t.Parallel()
+
+	files := writeStageTestFiles(t, "small.txt", "weights.bin")
+	files[1].Size = ChunkedUploadThreshold
+	mock := &stageClientMock{}
+
+	err := uploadFilesParallel(context.Background(), mock, "cat", "stage", files, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"small.txt"}, mock.uploadPaths)
+	assert.Equal(t, []string{"weights.bin"}, mock.chunkedPaths)
+}
+
 func TestUploadFilesParallel_OneUploadFails(t *testing.T) {
 	t.Parallel()
 
@@ -178,7 +210,7 @@ func TestUploadFilesParallel_OneUploadFails(t *testing.T) {
 		uploadErrForPath: map[string]error{"bad.txt": wantErr},
 	}
 
-	err := uploadFilesParallel(context.Background(), mock, "cat", "stage", files)
+	err := uploadFilesParallel(context.Background(), mock, "cat", "stage", files, 0)
 	require.Error(t, err)
 	assert.ErrorIs(t, err, wantErr)
 	assert.Contains(t, err.Error(), "bad.txt")
@@ -198,7 +230,7 @@ func TestUploadFilesParallel_ContextCancelled(t *testing.T) {
 		},
 	}
 
-	err := uploadFilesParallel(ctx, mock, "cat", "stage", files)
+	err := uploadFilesParallel(ctx, mock, "cat", "stage", files, 0)
 	require.Error(t, err)
 	assert.ErrorIs(t, err, context.Canceled)
 }
@@ -224,7 +256,7 @@ func TestUploadFilesParallel_RespectsConcurrencyLimit(t *testing.T) {
 
 	errCh := make(chan error, 1)
 	go func() {
-		errCh <- uploadFilesParallel(context.Background(), mock, "cat", "stage", files)
+		errCh <- uploadFilesParallel(context.Background(), mock, "cat", "stage", files, 0)
 	}()
 
 	for range UploadConcurrency {
@@ -246,6 +278,44 @@ func TestUploadFilesParallel_RespectsConcurrencyLimit(t *testing.T) {
 	assert.Len(t, mock.uploadPaths, len(files))
 }
 
+func TestUploadFilesParallel_RespectsConfiguredConcurrency(t *testing.T) {
+	t.Parallel()
+
+	names := make([]string, 10)
+	for i := range names {
+		names[i] = fmt.Sprintf("file-%02d.txt", i)
+	}
+	files := writeStageTestFiles(t, names...)
+	mock := &stageClientMock{
+		uploadHook: func(string) error {
+			time.Sleep(5 * time.Millisecond)
+			return nil
+		},
+	}
+
+	require.NoError(t, uploadFilesParallel(context.Background(), mock, "cat", "stage", files, 2))
+	assert.Len(t, mock.uploadPaths, len(files))
+	assert.LessOrEqual(t, mock.maxConcurrent.Load(), int32(2))
+}
+
+func TestUploadFilesParallel_RetriesThrottledUpload(t *testing.T) {
+	t.Parallel()
+
+	files := writeStageTestFiles(t, "a.txt", "b.txt")
+	var throttled atomic.Bool
+	mock := &stageClientMock{
+		uploadHook: func(name string) error {
+			if name == "a.txt" && throttled.CompareAndSwap(false, true) {
+				return fmt.Errorf("upload %s: %w", name, filesapi.ErrThrottled)
+			}
+			return nil
+		},
+	}
+
+	require.NoError(t, uploadFilesParallel(context.Background(), mock, "cat", "stage", files, 0))
+	assert.ElementsMatch(t, []string{"a.txt", "b.txt"}, mock.uploadPaths)
+}
+
 func TestStageUploader_CreatesCatalogAndAppliesStage(t *testing.T) {
 	t.Parallel()
 
@@ -340,3 +410,30 @@ func TestStageUploader_ApplyStageFailsAfterUploads(t *testing.T) {
 	assert.Equal(t, 1, mock.applyStageCalls)
 	assert.ElementsMatch(t, []string{"a.txt", "b.txt"}, mock.uploadPaths)
 }
+
+func TestChooseUploader_LargeFileUsesStage(t *testing.T) {
+	t.Parallel()
+
+	files := make([]LocalFile, StageVsZipFileThreshold+1)
+	for i := range files {
+		files[i] = LocalFile{RelPath: fmt.Sprintf("f%d.txt", i), Size: 1}
+	}
+	assert.IsType(t, zipUploader{}, chooseUploader(files, Limits{}))
+
+	files[0].Size = ChunkedUploadThreshold
+	assert.IsType(t, stageUploader{}, chooseUploader(files, Limits{}))
+}
+
+func TestChooseUploader_Limits(t *testing.T) {
+	t.Parallel()
+
+	files := make([]LocalFile, 5)
+	for i := range files {
+		files[i] = LocalFile{RelPath: fmt.Sprintf("f%d.txt", i), Size: 10}
+	}
+
+	assert.Equal(t, stageUploader{concurrency: UploadConcurrency}, chooseUploader(files, Limits{}))
+	assert.Equal(t, stageUploader{concurrency: 32}, chooseUploader(files, Limits{UploadConcurrency: 32}))
+	assert.Equal(t, zipUploader{pollTimeout: filesapi.ZipPollTimeout}, chooseUploader(files, Limits{StageVsZipFileThreshold: 4}))
+	assert.Equal(t, zipUploader{pollTimeout: time.Hour}, chooseUploader(files, Limits{StageVsZipBytesThreshold: 49, ZipPollTimeout: time.Hour}))
+}
@@ -13,6 +13,7 @@ func applyIncrementalPush(
 	catalogID, overwrite string,
 	plan *PushPlan,
 	byPath map[string]LocalFile,
+	limits Limits,
 ) (string, string, error) {
 	versionID := ""
 
@@ -31,7 +32,7 @@ func applyIncrementalPush(
 	}
 
 	uploadFiles := localFilesForPaths(byPath, plan.Uploads)
-	up := chooseUploader(uploadFiles)
+	up := chooseUploader(uploadFiles, limits)
 
 	catalogIDOut, versionIDOut, err := up.upload(ctx, client, catalogID, overwrite, uploadFiles)
 	if err != nil {
@@ -29,6 +29,10 @@ type Options struct {
 	Overwrite string
 	// Ignore optionally excludes paths; nil uploads all regular files.
 	Ignore IgnoreFunc
+	// HashCache optionally reuses the hashes of unchanged files; nil hashes every file.
+	HashCache *HashCache
+	// Limits tune the concurrency and the choice between stage and zip uploads.
+	Limits Limits
 }
 
 // Result is returned after a successful PushDirectory.
@@ -43,3 +47,30 @@ type Result struct {
 	// BaseFiles rather than uploading the full tree.
 	Incremental bool
 }
+
+// PullOptions configure a PullVersion call.
+type PullOptions struct {
+	// Dir is the local directory the files are written to. It is created when missing.
+	Dir string
+	// CatalogID and CatalogVersionID identify the catalog version to download.
+	CatalogID        string
+	CatalogVersionID string
+	// HashCache optionally reuses the hashes of unchanged local files when checking
+	// whether a file is already up to date; nil hashes every existing file.
+	HashCache *HashCache
+}
+
+// PullResult is returned after a successful PullVersion.
+type PullResult struct {
+	CatalogVersionID string
+	// FileHashes are the SHA-256 hashes of the files written to Dir.
+	FileHashes Manifest
+	FileCount  int
+	TotalBytes int64
+	// Downloaded is the number of files that were downloaded; the other files
+	// already matched the catalog.
+	Downloaded int
+	// Unverified lists the files whose catalog checksum is not a SHA-256 hash.
+	// Only their size is checked against the catalog.
+	Unverified []string
+}
@@ -10,19 +10,24 @@ type uploader interface {
 	upload(ctx context.Context, client filesapi.Client, catalogID, overwrite string, files []LocalFile) (catalogIDOut, versionID string, err error)
 }
 
-func chooseUploader(files []LocalFile) uploader {
+func chooseUploader(files []LocalFile, limits Limits) uploader {
+	limits = limits.withDefaults()
+	stage := stageUploader{concurrency: limits.UploadConcurrency}
 	if len(files) == 0 {
-		return stageUploader{}
+		return stage
 	}
 
 	var totalBytes int64
 	for _, f := range files {
+		if f.Size >= ChunkedUploadThreshold {
+			return stage
+		}
 		totalBytes += f.Size
 	}
 
-	if len(files) <= StageVsZipFileThreshold && totalBytes <= StageVsZipBytesThreshold {
-		return stageUploader{}
+	if len(files) <= limits.StageVsZipFileThreshold && totalBytes <= limits.StageVsZipBytesThreshold {
+		return stage
 	}
 
-	return zipUploader{}
+	return zipUploader{pollTimeout: limits.ZipPollTimeout}
 }
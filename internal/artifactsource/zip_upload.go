@@ -10,9 +10,13 @@ import (
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
 )
 
-type zipUploader struct{}
+// zipUploader uploads files as a zip archive and waits up to pollTimeout
+// (filesapi.ZipPollTimeout when zero) for the server to extract it.
+type zipUploader struct {
+	pollTimeout time.Duration
+}
 
-func (zipUploader) upload(ctx context.Context, client filesapi.Client, catalogID, overwrite string, files []LocalFile) (string, string, error) {
+func (u zipUploader) upload(ctx context.Context, client filesapi.Client, catalogID, overwrite string, files []LocalFile) (string, string, error) {
 	zipPath, err := buildZip(files)
 	if err != nil {
 		return "", "", err
@@ -42,7 +46,7 @@ func (zipUploader) upload(ctx context.Context, client filesapi.Client, catalogID
 	}
 
 	if resp.StatusID != "" {
-		if err := waitForCompletion(ctx, client, resp.StatusID); err != nil {
+		if err := waitForCompletion(ctx, client, resp.StatusID, u.pollTimeout); err != nil {
 			return "", "", err
 		}
 	}
@@ -50,8 +54,11 @@ func (zipUploader) upload(ctx context.Context, client filesapi.Client, catalogID
 	return resp.CatalogID, resp.CatalogVersionID, nil
 }
 
-func waitForCompletion(ctx context.Context, client filesapi.Client, statusID string) error {
-	deadline := time.Now().Add(filesapi.ZipPollTimeout)
+func waitForCompletion(ctx context.Context, client filesapi.Client, statusID string, timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = filesapi.ZipPollTimeout
+	}
+	deadline := time.Now().Add(timeout)
 	ticker := time.NewTicker(filesapi.ZipPollInterval)
 	defer ticker.Stop()
 
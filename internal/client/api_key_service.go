@@ -0,0 +1,16 @@
+package client
+
+type CreateAPIKeyRequest struct {
+	Name     string     `json:"name"`
+	ExpireAt string     `json:"expireAt,omitempty"`
+	Scope    ScopeLevel `json:"scope,omitempty"`
+}
+
+type APIKey struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Key       string     `json:"key"`
+	Scope     ScopeLevel `json:"scope,omitempty"`
+	ExpireAt  string     `json:"expireAt,omitempty"`
+	CreatedAt string     `json:"createdAt,omitempty"`
+}
@@ -100,6 +100,9 @@ func (s *ServiceImpl) WaitForArtifactBuild(
 ) (*ArtifactBuild, error) {
 	pollInterval := artifactBuildPollInterval()
 	timeout := artifactBuildPollTimeout()
+	if d, ok := OperationTimeout(ctx); ok {
+		timeout = d
+	}
 	if opts != nil {
 		if opts.PollInterval > 0 {
 			pollInterval = opts.PollInterval
@@ -35,6 +35,24 @@ func ExtractCodeRef(artifact *Artifact) *ArtifactDataRobotCodeRef {
 	return codeRefFromContainer(artifact.Spec.ContainerGroups[0].Containers[0])
 }
 
+// CodeRefs returns the DataRobot catalog references of all containers of the artifact,
+// for example to find the catalog versions that are still in use. Provider-only.
+func CodeRefs(artifact *Artifact) []ArtifactDataRobotCodeRef {
+	if artifact == nil {
+		return nil
+	}
+
+	var refs []ArtifactDataRobotCodeRef
+	for _, group := range artifact.Spec.ContainerGroups {
+		for _, container := range group.Containers {
+			if ref := codeRefFromContainer(container); ref != nil {
+				refs = append(refs, *ref)
+			}
+		}
+	}
+	return refs
+}
+
 func codeRefFromContainer(container ArtifactContainer) *ArtifactDataRobotCodeRef {
 	if container.ImageBuildConfig == nil || container.ImageBuildConfig.CodeRef == nil {
 		return nil
@@ -59,6 +59,33 @@ func TestExtractCodeRef_PrefersPrimaryWhenSidecarFirst(t *testing.T) {
 	}
 }
 
+func TestCodeRefs_AllContainers(t *testing.T) {
+	codeRef := func(catalogID, versionID string) *ArtifactImageBuildConfig {
+		return &ArtifactImageBuildConfig{
+			CodeRef: &ArtifactCodeRef{
+				DataRobot: ArtifactDataRobotCodeRef{CatalogID: catalogID, CatalogVersionID: versionID},
+			},
+		}
+	}
+
+	artifact := &Artifact{
+		Spec: ArtifactSpec{
+			ContainerGroups: []ArtifactContainerGroup{
+				{Containers: []ArtifactContainer{{ImageBuildConfig: codeRef("cat-1", "ver-1")}, {}}},
+				{Containers: []ArtifactContainer{{ImageBuildConfig: codeRef("cat-2", "ver-2")}}},
+			},
+		},
+	}
+
+	refs := CodeRefs(artifact)
+	if len(refs) != 2 || refs[0].CatalogVersionID != "ver-1" || refs[1].CatalogVersionID != "ver-2" {
+		t.Fatalf("CodeRefs() = %+v, want ver-1 and ver-2", refs)
+	}
+	if CodeRefs(nil) != nil {
+		t.Fatal("CodeRefs(nil) should be nil")
+	}
+}
+
 func TestSetPrimaryCodeRefInRawArtifact(t *testing.T) {
 	t.Run("OverwritesPrimaryCodeRef_LeavesSidecarsAlone", func(t *testing.T) {
 		raw := map[string]any{
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -115,7 +116,7 @@ func doRequestWithResponseHeaders[T any](c *Client, ctx context.Context, method,
 	defer resp.Body.Close()
 
 	if req.URL.String() != resp.Request.URL.String() {
-		return result, nil, NewGenericError("request was redirected")
+		return result, nil, &RedirectedError{URL: req.URL.String(), Location: resp.Request.URL.String()}
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -136,11 +137,14 @@ func doRequestWithResponseHeaders[T any](c *Client, ctx context.Context, method,
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		errorMessage := fmt.Sprintf("%s request %s : response %s %s", req.Method, req.URL.String(), resp.Status, string(respBody))
+		err = NewAPIError(resp, respBody)
 		if c.cfg.Debug {
-			errorMessage += getCurlCommand(req, jsonBody)
+			var apiErr *APIError
+			if errors.As(err, &apiErr) {
+				apiErr.Details = getCurlCommand(req, jsonBody)
+			}
 		}
-		return result, &resp.Header, NewGenericError(errorMessage)
+		return result, &resp.Header, err
 	}
 
 	if (req.Method == http.MethodDelete || req.Method == http.MethodPatch || req.Method == http.MethodPut) &&
@@ -148,7 +152,7 @@ func doRequestWithResponseHeaders[T any](c *Client, ctx context.Context, method,
 		return result, &resp.Header, nil
 	}
 
-	if req.Method == http.MethodPatch && resp.StatusCode == http.StatusAccepted && len(respBody) == 0 {
+	if (req.Method == http.MethodPatch || req.Method == http.MethodPost) && resp.StatusCode == http.StatusAccepted && len(respBody) == 0 {
 		return result, &resp.Header, nil
 	}
 
@@ -298,6 +302,23 @@ func uploadFilesFromBinaries[T any](
 ) (
 	result *T,
 	err error,
+) {
+	return uploadFilesWithListFields[T](c, ctx, apiPath, httpMethod, files, otherFields, nil)
+}
+
+// uploadFilesWithListFields is uploadFilesFromBinaries with form fields that repeat once
+// per value, such as filesToDelete.
+func uploadFilesWithListFields[T any](
+	c *Client,
+	ctx context.Context,
+	apiPath string,
+	httpMethod string,
+	files []FileInfo,
+	otherFields map[string]string,
+	listFields map[string][]string,
+) (
+	result *T,
+	err error,
 ) {
 	var requestBody bytes.Buffer
 	writer := multipart.NewWriter(&requestBody)
@@ -309,6 +330,13 @@ func uploadFilesFromBinaries[T any](
 			return result, WrapGenericError("could not write field", err)
 		}
 	}
+	for key, values := range listFields {
+		for _, value := range values {
+			if err := writer.WriteField(key, value); err != nil {
+				return result, WrapGenericError("could not write field", err)
+			}
+		}
+	}
 
 	for _, file := range files {
 		// Create the form file field
@@ -373,8 +401,7 @@ func uploadFilesFromBinaries[T any](
 		fmt.Printf("Request %s %s - Response %s %s\n\n", req.Method, req.URL.String(), resp.Status, string(respBody))
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		errorMessage := fmt.Sprintf("%s request %s : response %s %s", req.Method, req.URL.String(), resp.Status, string(respBody))
-		return result, NewGenericError(errorMessage)
+		return result, NewAPIError(resp, respBody)
 	}
 
 	// Deserialize the response into the provided result type
@@ -54,6 +54,22 @@ type Credential struct {
 	ConfigID       string `json:"configId,omitempty"`
 }
 
+// CredentialSecret is a stored credential together with its secret values.
+type CredentialSecret struct {
+	ID                    string  `json:"credentialId"`
+	Name                  string  `json:"name"`
+	CredentialType        string  `json:"credentialType,omitempty"`
+	User                  string  `json:"user,omitempty"`
+	Password              string  `json:"password,omitempty"`
+	Token                 string  `json:"token,omitempty"`
+	ApiToken              string  `json:"apiToken,omitempty"`
+	AWSAccessKeyID        string  `json:"awsAccessKeyId,omitempty"`
+	AWSSecretAccessKey    string  `json:"awsSecretAccessKey,omitempty"`
+	AWSSessionToken       string  `json:"awsSessionToken,omitempty"`
+	AzureConnectionString string  `json:"azureConnectionString,omitempty"`
+	GCPKey                *GCPKey `json:"gcpKey,omitempty"`
+}
+
 type CredentialAssociation struct {
 	ObjectID   string `json:"objectId"`
 	ObjectType string `json:"objectType"`
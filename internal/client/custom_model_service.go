@@ -112,6 +112,7 @@ type UpdateCustomModelRequest struct {
 	Language                                    string   `json:"language,omitempty"`
 	ClassLabels                                 []string `json:"classLabels,omitempty"`
 	IsTrainingDataForVersionsPermanentlyEnabled bool     `json:"isTrainingDataForVersionsPermanentlyEnabled,omitempty"`
+	Tags                                        *[]Tag   `json:"tags,omitempty"`
 }
 
 type RuntimeParameter struct {
@@ -160,6 +161,7 @@ type CustomModelHoldoutData struct {
 type CreateCustomModelVersionFromFilesRequest struct {
 	BaseEnvironmentID string     `json:"baseEnvironmentId"`
 	Files             []FileInfo `json:"files"`
+	FilesToDelete     []string   `json:"filesToDelete,omitempty"`
 }
 
 type CreateCustomModelVersionFromRemoteRepositoryRequest struct {
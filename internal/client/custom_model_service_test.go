@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCreateCustomModelVersionFromFilesSendsFilesToDelete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/customModels/model-1/versions/" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("ParseMultipartForm returned error: %v", err)
+		}
+		if got := r.MultipartForm.Value["filesToDelete"]; !reflect.DeepEqual(got, []string{"item-1", "item-2"}) {
+			t.Errorf("expected filesToDelete=[item-1 item-2], got %v", got)
+		}
+		if got := r.MultipartForm.Value["filePath"]; !reflect.DeepEqual(got, []string{"src/main.py"}) {
+			t.Errorf("expected filePath=[src/main.py], got %v", got)
+		}
+		files := r.MultipartForm.File["file"]
+		if len(files) != 1 {
+			t.Fatalf("expected one file, got %d", len(files))
+		}
+		f, err := files[0].Open()
+		if err != nil {
+			t.Fatalf("open uploaded file: %v", err)
+		}
+		defer f.Close()
+		if content, _ := io.ReadAll(f); string(content) != "print('hi')" {
+			t.Errorf("unexpected file content %q", content)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "version-2"})
+	}))
+	defer server.Close()
+
+	cfg := NewConfiguration("fake-token")
+	cfg.Endpoint = server.URL
+	svc := NewService(NewClient(cfg))
+
+	version, err := svc.CreateCustomModelVersionFromFiles(context.Background(), "model-1", &CreateCustomModelVersionFromFilesRequest{
+		BaseEnvironmentID: "env-1",
+		Files:             []FileInfo{{Name: "main.py", Path: "src/main.py", Content: []byte("print('hi')")}},
+		FilesToDelete:     []string{"item-1", "item-2"},
+	})
+	if err != nil {
+		t.Fatalf("CreateCustomModelVersionFromFiles returned error: %v", err)
+	}
+	if version.ID != "version-2" {
+		t.Errorf("expected version-2, got %s", version.ID)
+	}
+}
@@ -1,5 +1,10 @@
 package client
 
+import (
+	"fmt"
+	"strings"
+)
+
 type CreateDeploymentFromModelPackageRequest struct {
 	ModelPackageID          string `json:"modelPackageId"`
 	PredictionEnvironmentID string `json:"predictionEnvironmentId"`
@@ -20,6 +25,34 @@ type Deployment struct {
 	ModelPackage          ModelPackage          `json:"modelPackage"`
 	PredictionEnvironment PredictionEnvironment `json:"predictionEnvironment"`
 	Importance            string                `json:"importance"`
+	Tags                  []Tag                 `json:"tags,omitempty"`
+	// DefaultPredictionServer is unset for serverless deployments.
+	DefaultPredictionServer *PredictionServer `json:"defaultPredictionServer,omitempty"`
+}
+
+type PredictionServer struct {
+	ID           string `json:"id"`
+	URL          string `json:"url"`
+	DataRobotKey string `json:"datarobot-key"`
+}
+
+// ListDeploymentsRequest filters the deployments listed by ListDeployments.
+// TagKeys and TagValues are matched pairwise.
+type ListDeploymentsRequest struct {
+	Search     string   `url:"search,omitempty"`
+	Importance []string `url:"importance,omitempty"`
+	TagKeys    []string `url:"tagKeys,omitempty"`
+	TagValues  []string `url:"tagValues,omitempty"`
+}
+
+// PredictionURLForDeployment returns the URL to request predictions from a deployment:
+// its prediction server for dedicated deployments, the API itself for serverless ones.
+func PredictionURLForDeployment(deployment *Deployment, baseURL string) string {
+	if deployment.DefaultPredictionServer != nil && deployment.DefaultPredictionServer.URL != "" {
+		return fmt.Sprintf("%s/predApi/v1.0/deployments/%s/predictions",
+			strings.TrimSuffix(deployment.DefaultPredictionServer.URL, "/"), deployment.ID)
+	}
+	return fmt.Sprintf("%s/api/v2/deployments/%s/predictions", baseURL, deployment.ID)
 }
 
 type OtelLogEntry struct {
@@ -193,6 +226,15 @@ type ValidateDeployemntModelReplacementRequest struct {
 type ValidateDeployemntModelReplacementResponse struct {
 	Status  string `json:"status"`
 	Message string `json:"message"`
+	// Checks are the results of the individual compatibility checks, keyed by check name
+	// such as targetType, features or runtime.
+	Checks map[string]ModelReplacementCheck `json:"checks,omitempty"`
+}
+
+// ModelReplacementCheck is the result of one compatibility check of a model replacement.
+type ModelReplacementCheck struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
 }
 
 type UpdateDeploymentRuntimeParametersRequest struct {
@@ -382,3 +424,43 @@ type RetrainingSettingsRetrieve struct {
 	PredictionEnvironment RetrainingSettingsItem `json:"predictionEnvironment"`
 	RetrainingUser        RetrainingUser         `json:"retrainingUser"`
 }
+
+type CreateDeploymentChallengerRequest struct {
+	ModelPackageID          string `json:"modelPackageId"`
+	PredictionEnvironmentID string `json:"predictionEnvironmentId"`
+	Name                    string `json:"name"`
+}
+
+type UpdateDeploymentChallengerRequest struct {
+	Name                    string `json:"name,omitempty"`
+	PredictionEnvironmentID string `json:"predictionEnvironmentId,omitempty"`
+}
+
+// DeploymentChallenger is a model that receives the predictions of a Deployment alongside
+// its champion model, so that the two can be compared.
+type DeploymentChallenger struct {
+	ID                    string                `json:"id"`
+	Name                  string                `json:"name"`
+	Model                 Model                 `json:"model"`
+	ModelPackage          ModelPackage          `json:"modelPackage"`
+	PredictionEnvironment PredictionEnvironment `json:"predictionEnvironment"`
+}
+
+type DeploymentAccuracyRequest struct {
+	ModelID string `url:"modelId,omitempty"`
+	Start   string `url:"start,omitempty"`
+	End     string `url:"end,omitempty"`
+}
+
+// DeploymentAccuracy holds the accuracy metrics of one model of a Deployment, keyed by metric name.
+type DeploymentAccuracy struct {
+	Metrics map[string]DeploymentAccuracyMetric `json:"metrics"`
+}
+
+// DeploymentAccuracyMetric is an accuracy metric of a Deployment model. Value is nil when
+// there is not enough actuals data to compute it.
+type DeploymentAccuracyMetric struct {
+	Value         *float64 `json:"value"`
+	BaselineValue *float64 `json:"baselineValue"`
+	PercentChange *float64 `json:"percentChange"`
+}
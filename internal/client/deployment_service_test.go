@@ -144,3 +144,143 @@ func TestDeploymentLogsTailLines(t *testing.T) {
 		}
 	})
 }
+
+func TestListDeploymentsSendsFiltersAndFollowsPages(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/deployments/" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if got := query["importance"]; len(got) != 1 || got[0] != "HIGH" {
+			t.Fatalf("expected importance=HIGH, got %v", got)
+		}
+		if got := query["tagKeys"]; len(got) != 2 || got[0] != "env" || got[1] != "team" {
+			t.Fatalf("expected tagKeys=[env team], got %v", got)
+		}
+		if got := query["tagValues"]; len(got) != 2 || got[0] != "prod" || got[1] != "platform" {
+			t.Fatalf("expected tagValues=[prod platform], got %v", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if query.Get("offset") == "" {
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"data": []map[string]any{{"id": "dep-1"}},
+				"next": server.URL + "/deployments/?" + r.URL.RawQuery + "&offset=1",
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": []map[string]any{{"id": "dep-2"}},
+			"next": "",
+		})
+	}))
+	defer server.Close()
+
+	cfg := NewConfiguration("fake-token")
+	cfg.Endpoint = server.URL
+	svc := NewService(NewClient(cfg))
+
+	deployments, err := svc.ListDeployments(context.Background(), &ListDeploymentsRequest{
+		Importance: []string{"HIGH"},
+		TagKeys:    []string{"env", "team"},
+		TagValues:  []string{"prod", "platform"},
+	})
+	if err != nil {
+		t.Fatalf("ListDeployments returned error: %v", err)
+	}
+	if len(deployments) != 2 || deployments[0].ID != "dep-1" || deployments[1].ID != "dep-2" {
+		t.Errorf("expected deployments dep-1 and dep-2, got %+v", deployments)
+	}
+}
+
+func TestPredictionURLForDeployment(t *testing.T) {
+	t.Run("uses the prediction server of dedicated deployments", func(t *testing.T) {
+		deployment := &Deployment{
+			ID:                      "dep-1",
+			DefaultPredictionServer: &PredictionServer{URL: "https://example.orm.datarobot.com/"},
+		}
+		want := "https://example.orm.datarobot.com/predApi/v1.0/deployments/dep-1/predictions"
+		if got := PredictionURLForDeployment(deployment, "https://app.datarobot.com"); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+
+	t.Run("uses the API for serverless deployments", func(t *testing.T) {
+		deployment := &Deployment{ID: "dep-1"}
+		want := "https://app.datarobot.com/api/v2/deployments/dep-1/predictions"
+		if got := PredictionURLForDeployment(deployment, "https://app.datarobot.com"); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
+}
+
+func TestCreateDeploymentChallengerReturnsStatusID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/deployments/dep-1/challengers/" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body CreateDeploymentChallengerRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if body != (CreateDeploymentChallengerRequest{ModelPackageID: "mp-1", PredictionEnvironmentID: "env-1", Name: "challenger"}) {
+			t.Fatalf("unexpected body: %+v", body)
+		}
+
+		w.Header().Set("Location", "https://app.datarobot.com/api/v2/status/status-1/")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	cfg := NewConfiguration("fake-token")
+	cfg.Endpoint = server.URL
+	svc := NewService(NewClient(cfg))
+
+	statusID, err := svc.CreateDeploymentChallenger(context.Background(), "dep-1", &CreateDeploymentChallengerRequest{
+		ModelPackageID:          "mp-1",
+		PredictionEnvironmentID: "env-1",
+		Name:                    "challenger",
+	})
+	if err != nil {
+		t.Fatalf("CreateDeploymentChallenger returned error: %v", err)
+	}
+	if statusID != "status-1" {
+		t.Errorf("expected status ID status-1, got %q", statusID)
+	}
+}
+
+func TestGetDeploymentAccuracySendsModelID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/deployments/dep-1/accuracy/" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("modelId"); got != "model-1" {
+			t.Fatalf("expected modelId=model-1, got %q", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"metrics": map[string]any{
+				"LogLoss": map[string]any{"value": 0.25, "baselineValue": 0.5, "percentChange": -50.0},
+				"AUC":     map[string]any{"value": nil},
+			},
+		})
+	}))
+	defer server.Close()
+
+	cfg := NewConfiguration("fake-token")
+	cfg.Endpoint = server.URL
+	svc := NewService(NewClient(cfg))
+
+	accuracy, err := svc.GetDeploymentAccuracy(context.Background(), "dep-1", &DeploymentAccuracyRequest{ModelID: "model-1"})
+	if err != nil {
+		t.Fatalf("GetDeploymentAccuracy returned error: %v", err)
+	}
+	if value := accuracy.Metrics["LogLoss"].Value; value == nil || *value != 0.25 {
+		t.Errorf("expected LogLoss 0.25, got %v", value)
+	}
+	if value := accuracy.Metrics["AUC"].Value; value != nil {
+		t.Errorf("expected no AUC value, got %v", *value)
+	}
+}
@@ -24,3 +24,9 @@ type AppOAuthProviderResponse struct {
 	CreatedAt      string                 `json:"createdAt"`
 	UpdatedAt      string                 `json:"updatedAt"`
 }
+
+type AppOAuthToken struct {
+	AccessToken string `json:"accessToken"`
+	TokenType   string `json:"tokenType,omitempty"`
+	ExpiresAt   string `json:"expiresAt,omitempty"`
+}
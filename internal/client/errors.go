@@ -1,6 +1,14 @@
 package client
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// RequestIDHeader is the response header that identifies a request in the DataRobot logs.
+const RequestIDHeader = "X-Request-Id"
 
 // NotFoundError represents a custom error type for not found errors.
 type NotFoundError struct {
@@ -106,3 +114,168 @@ func NewGenericError(message string) *GenericError {
 func WrapGenericError(message string, err error) *GenericError {
 	return &GenericError{Message: message, InnerError: err}
 }
+
+// APIError represents an unsuccessful response from the DataRobot API.
+type APIError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Status     string
+	// Message is the "message" field of the JSON response body, if any.
+	Message string
+	// FieldErrors maps the request fields named in the "errors" field of the JSON
+	// response body to their error messages. Nested fields are joined with dots.
+	FieldErrors map[string]string
+	RequestID   string
+	Body        string
+	// Details is appended to the error message, e.g. the curl command of the request in debug mode.
+	Details string
+}
+
+// Error implements the error interface for APIError.
+func (e *APIError) Error() string {
+	message := fmt.Sprintf("%s request %s : response %s %s", e.Method, e.URL, e.Status, e.Body)
+	if e.RequestID != "" {
+		message += fmt.Sprintf(" (request ID %s)", e.RequestID)
+	}
+	return message + e.Details
+}
+
+// Is implements errors.Is support for APIError, matching any *APIError target.
+func (e *APIError) Is(target error) bool {
+	_, ok := target.(*APIError)
+	return ok
+}
+
+// NewAPIError creates the error for an unsuccessful API response, using the most
+// specific error type for its status code.
+func NewAPIError(resp *http.Response, body []byte) error {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       string(body),
+		RequestID:  resp.Header.Get(RequestIDHeader),
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.URL = resp.Request.URL.String()
+	}
+
+	var errorBody struct {
+		Message string `json:"message"`
+		Errors  any    `json:"errors"`
+	}
+	if json.Unmarshal(body, &errorBody) == nil {
+		apiErr.Message = errorBody.Message
+		if fields, ok := errorBody.Errors.(map[string]any); ok {
+			apiErr.FieldErrors = make(map[string]string)
+			flattenFieldErrors(apiErr.FieldErrors, "", fields)
+		}
+	}
+
+	switch resp.StatusCode {
+	case http.StatusConflict:
+		return &ConflictError{APIError: apiErr}
+	case http.StatusTooManyRequests:
+		return &RateLimitedError{APIError: apiErr}
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return &ValidationError{APIError: apiErr}
+	}
+	return apiErr
+}
+
+// flattenFieldErrors collects the messages of a (possibly nested) "errors" object,
+// keyed by the dotted path of the field they belong to.
+func flattenFieldErrors(fieldErrors map[string]string, prefix string, value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			if prefix != "" {
+				key = prefix + "." + key
+			}
+			flattenFieldErrors(fieldErrors, key, nested)
+		}
+	case []any:
+		messages := make([]string, 0, len(v))
+		for i, nested := range v {
+			if message, ok := nested.(string); ok {
+				messages = append(messages, message)
+				continue
+			}
+			flattenFieldErrors(fieldErrors, fmt.Sprintf("%s.%d", prefix, i), nested)
+		}
+		if len(messages) > 0 {
+			fieldErrors[prefix] = strings.Join(messages, "; ")
+		}
+	default:
+		fieldErrors[prefix] = fmt.Sprint(v)
+	}
+}
+
+// ConflictError represents a 409 Conflict response, e.g. a name that is already in use
+// or an entity that is still referenced by another one.
+type ConflictError struct {
+	*APIError
+}
+
+// Unwrap returns the APIError of the response.
+func (e *ConflictError) Unwrap() error {
+	return e.APIError
+}
+
+// Is implements errors.Is support for ConflictError, matching any *ConflictError target.
+func (e *ConflictError) Is(target error) bool {
+	_, ok := target.(*ConflictError)
+	return ok
+}
+
+// RateLimitedError represents a 429 Too Many Requests response.
+type RateLimitedError struct {
+	*APIError
+}
+
+// Unwrap returns the APIError of the response.
+func (e *RateLimitedError) Unwrap() error {
+	return e.APIError
+}
+
+// Is implements errors.Is support for RateLimitedError, matching any *RateLimitedError target.
+func (e *RateLimitedError) Is(target error) bool {
+	_, ok := target.(*RateLimitedError)
+	return ok
+}
+
+// ValidationError represents a 400 Bad Request or 422 Unprocessable Entity response
+// rejecting the request body. FieldErrors names the offending fields.
+type ValidationError struct {
+	*APIError
+}
+
+// Unwrap returns the APIError of the response.
+func (e *ValidationError) Unwrap() error {
+	return e.APIError
+}
+
+// Is implements errors.Is support for ValidationError, matching any *ValidationError target.
+func (e *ValidationError) Is(target error) bool {
+	_, ok := target.(*ValidationError)
+	return ok
+}
+
+// RedirectedError represents a request that the API redirected to another URL,
+// e.g. a status request for a task that has already completed.
+type RedirectedError struct {
+	URL      string
+	Location string
+}
+
+// Error implements the error interface for RedirectedError.
+func (e *RedirectedError) Error() string {
+	return "request was redirected"
+}
+
+// Is implements errors.Is support for RedirectedError, matching any *RedirectedError target.
+func (e *RedirectedError) Is(target error) bool {
+	_, ok := target.(*RedirectedError)
+	return ok
+}
@@ -3,6 +3,8 @@ package client
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -30,3 +32,94 @@ func TestUnauthorizedErrorIs(t *testing.T) {
 		t.Error("errors.Is should return false for wrong type")
 	}
 }
+
+func newErrorResponse(statusCode int, body string) (*http.Response, []byte) {
+	req, _ := http.NewRequest(http.MethodPost, "https://app.datarobot.com/api/v2/credentials/", nil)
+	resp := &http.Response{
+		StatusCode: statusCode,
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		Header:     http.Header{RequestIDHeader: []string{"request-id"}},
+		Request:    req,
+	}
+	return resp, []byte(body)
+}
+
+func TestNewAPIErrorTypes(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		target     error
+	}{
+		{http.StatusConflict, &ConflictError{}},
+		{http.StatusTooManyRequests, &RateLimitedError{}},
+		{http.StatusBadRequest, &ValidationError{}},
+		{http.StatusUnprocessableEntity, &ValidationError{}},
+		{http.StatusInternalServerError, &APIError{}},
+	}
+	for _, tt := range tests {
+		err := NewAPIError(newErrorResponse(tt.statusCode, `{"message": "failed"}`))
+		if !errors.Is(err, tt.target) {
+			t.Errorf("%d: expected %T, got %T", tt.statusCode, tt.target, err)
+		}
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("%d: expected errors.As to find *APIError", tt.statusCode)
+		}
+		if apiErr.StatusCode != tt.statusCode || apiErr.Message != "failed" || apiErr.RequestID != "request-id" {
+			t.Errorf("%d: unexpected APIError %+v", tt.statusCode, apiErr)
+		}
+	}
+
+	if errors.Is(NewAPIError(newErrorResponse(http.StatusConflict, "")), &ValidationError{}) {
+		t.Error("errors.Is should return false for wrong type")
+	}
+}
+
+func TestNewAPIErrorFieldErrors(t *testing.T) {
+	err := NewAPIError(newErrorResponse(http.StatusUnprocessableEntity, `{
+		"message": "Invalid field data",
+		"errors": {
+			"name": "name is required",
+			"runtimeParameterValues": [{"value": "value is not a string"}],
+			"tags": ["too many tags", "duplicate tag"]
+		}
+	}`))
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	expected := map[string]string{
+		"name":                           "name is required",
+		"runtimeParameterValues.0.value": "value is not a string",
+		"tags":                           "too many tags; duplicate tag",
+	}
+	if len(validationErr.FieldErrors) != len(expected) {
+		t.Fatalf("expected field errors %v, got %v", expected, validationErr.FieldErrors)
+	}
+	for field, message := range expected {
+		if validationErr.FieldErrors[field] != message {
+			t.Errorf("field %s: expected %q, got %q", field, message, validationErr.FieldErrors[field])
+		}
+	}
+
+	message := err.Error()
+	if !strings.HasPrefix(message, "POST request https://app.datarobot.com/api/v2/credentials/ : response 422 Unprocessable Entity") {
+		t.Errorf("unexpected error message %q", message)
+	}
+	if !strings.Contains(message, "(request ID request-id)") {
+		t.Errorf("expected error message to contain the request ID, got %q", message)
+	}
+}
+
+func TestNewAPIErrorNonJSONBody(t *testing.T) {
+	err := NewAPIError(newErrorResponse(http.StatusBadGateway, "<html>Bad Gateway</html>"))
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+	if apiErr.Message != "" || apiErr.FieldErrors != nil || apiErr.Body != "<html>Bad Gateway</html>" {
+		t.Errorf("unexpected APIError %+v", apiErr)
+	}
+}
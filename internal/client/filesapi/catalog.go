@@ -0,0 +1,33 @@
+// Provider-only file (no CLI equivalent).
+//
+// The CLI never deletes catalogs or catalog versions. The provider deletes the
+// catalog of a datarobot_files_catalog resource when the resource is destroyed, and
+// the catalog versions that source.retain_versions of datarobot_artifact prunes.
+package filesapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// DeleteCatalog deletes the catalog with all of its versions. A catalog that does not
+// exist is not an error.
+func (c *httpClient) DeleteCatalog(ctx context.Context, catalogID string) error {
+	requestURL := c.endpointURL("/files/"+url.PathEscape(catalogID)+"/", nil)
+	if err := c.deleteJSON(ctx, requestURL, struct{}{}, nil); err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("delete catalog: %w", err)
+	}
+	return nil
+}
+
+// DeleteVersion deletes one version of the catalog. A version that does not exist is
+// not an error.
+func (c *httpClient) DeleteVersion(ctx context.Context, catalogID, versionID string) error {
+	requestURL := c.endpointURL("/files/"+url.PathEscape(catalogID)+"/versions/"+url.PathEscape(versionID)+"/", nil)
+	if err := c.deleteJSON(ctx, requestURL, struct{}{}, nil); err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("delete catalog version %s: %w", versionID, err)
+	}
+	return nil
+}
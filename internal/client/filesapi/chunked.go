@@ -0,0 +1,208 @@
+// Provider-only file (no CLI equivalent).
+//
+// The CLI uploads every stage file in a single request. The provider uploads large
+// files in parts through a multipart upload session of the stage, so that a network
+// failure only resends the part in flight instead of the whole file.
+package filesapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// UploadToStageChunked uploads body to the stage in parts of partSize bytes.
+//
+// Each part is retried up to ChunkedUploadPartAttempts times on network errors and
+// on 408, 429 and 5xx responses. Before a part is resent, the session is queried for
+// the parts the server acknowledged, so a part whose response was lost is not sent
+// twice. The upload resumes from the last acknowledged part; earlier parts are never
+// resent. A failed upload aborts its session.
+func (c *httpClient) UploadToStageChunked(
+	ctx context.Context,
+	catalogID, stageID, name string,
+	size int64,
+	body io.ReaderAt,
+	partSize int64,
+) error {
+	if partSize <= 0 {
+		return fmt.Errorf("chunked upload %s: part size must be positive", name)
+	}
+
+	sessionPath := "/files/" + url.PathEscape(catalogID) + "/stages/" + url.PathEscape(stageID) + "/multipartUploads/"
+	var session MultipartUploadResp
+	if err := c.transport.Post(ctx, sessionPath, MultipartUploadReq{FileName: name, FileSize: size, PartSize: partSize}, &session); err != nil {
+		return fmt.Errorf("start chunked upload %s: %w", name, err)
+	}
+	uploadPath := sessionPath + url.PathEscape(session.UploadID) + "/"
+
+	if err := c.uploadParts(ctx, uploadPath, name, size, body, partSize); err != nil {
+		c.abortMultipartUpload(ctx, uploadPath, name)
+		return err
+	}
+
+	var completed MultipartUploadResp
+	if err := c.transport.Post(ctx, uploadPath+"complete/", struct{}{}, &completed); err != nil {
+		return fmt.Errorf("complete chunked upload %s: %w", name, err)
+	}
+	return nil
+}
+
+func (c *httpClient) uploadParts(ctx context.Context, uploadPath, name string, size int64, body io.ReaderAt, partSize int64) error {
+	parts := int((size + partSize - 1) / partSize)
+	if parts == 0 {
+		// An empty file is a single empty part.
+		parts = 1
+	}
+
+	var uploaded int64
+	for part := 1; part <= parts; part++ {
+		offset := int64(part-1) * partSize
+		length := min(partSize, size-offset)
+
+		if err := c.uploadPartWithRetry(ctx, uploadPath, name, part, parts, io.NewSectionReader(body, offset, length), length); err != nil {
+			return err
+		}
+
+		uploaded += length
+		tflog.Info(ctx, "Uploaded part of file to Files API stage", map[string]any{
+			"file":           name,
+			"part":           part,
+			"parts":          parts,
+			"uploaded_bytes": uploaded,
+			"total_bytes":    size,
+			"percent":        uploadPercent(uploaded, size),
+		})
+	}
+	return nil
+}
+
+func (c *httpClient) uploadPartWithRetry(ctx context.Context, uploadPath, name string, part, parts int, section *io.SectionReader, length int64) error {
+	wait := ChunkedUploadRetryWaitMin
+	for attempt := 1; ; attempt++ {
+		if _, err := section.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("rewind part %d of %s: %w", part, name, err)
+		}
+
+		err := c.uploadPart(ctx, uploadPath, part, section, length)
+		if err == nil {
+			return nil
+		}
+		if !isRetryableUploadError(ctx, err) || attempt >= ChunkedUploadPartAttempts {
+			return fmt.Errorf("upload part %d of %d of %s: %w", part, parts, name, err)
+		}
+
+		tflog.Warn(ctx, "Retrying part of chunked Files API upload", map[string]any{
+			"file":    name,
+			"part":    part,
+			"attempt": attempt,
+			"error":   err.Error(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		wait = min(wait*2, ChunkedUploadRetryWaitMax)
+
+		if c.partAcknowledged(ctx, uploadPath, part, length) {
+			return nil
+		}
+	}
+}
+
+func (c *httpClient) uploadPart(ctx context.Context, uploadPath string, part int, body io.Reader, length int64) error {
+	requestURL := c.endpointURL(uploadPath+"parts/"+strconv.Itoa(part)+"/", nil)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, requestURL, io.NopCloser(body))
+	if err != nil {
+		return fmt.Errorf("build part request: %w", err)
+	}
+	req.ContentLength = length
+
+	c.transport.PrepareAPIRequest(req)
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := c.httpClientWithTimeout(UploadHTTPTimeout).Do(req)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return &partStatusError{statusCode: resp.StatusCode, err: errFromResp(resp, requestURL)}
+	}
+
+	_ = resp.Body.Close()
+	return nil
+}
+
+// partAcknowledged reports whether the server holds the part, for example when the
+// part was received but its response was lost. Errors are treated as not acknowledged.
+func (c *httpClient) partAcknowledged(ctx context.Context, uploadPath string, part int, length int64) bool {
+	var session MultipartUploadResp
+	if err := c.getJSON(ctx, c.endpointURL(uploadPath, nil), &session); err != nil {
+		return false
+	}
+	for _, acknowledged := range session.Parts {
+		if acknowledged.PartNumber == part && acknowledged.Size == length {
+			return true
+		}
+	}
+	return false
+}
+
+// abortMultipartUpload discards the parts of a failed upload. It is best effort: the
+// stage is never applied, so a session that is not aborted only holds storage until
+// the stage expires.
+func (c *httpClient) abortMultipartUpload(ctx context.Context, uploadPath, name string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), StatusPollHTTPTimeout)
+	defer cancel()
+
+	if err := c.deleteJSON(ctx, c.endpointURL(uploadPath, nil), struct{}{}, nil); err != nil {
+		tflog.Debug(ctx, "Unable to abort chunked Files API upload", map[string]any{
+			"file":  name,
+			"error": err.Error(),
+		})
+	}
+}
+
+// partStatusError is an unsuccessful response to a part upload.
+type partStatusError struct {
+	statusCode int
+	err        error
+}
+
+func (e *partStatusError) Error() string { return e.err.Error() }
+
+func (e *partStatusError) Unwrap() error { return e.err }
+
+// isRetryableUploadError reports whether a part upload may succeed when resent: network
+// errors, timeouts, throttling and server errors are; other responses and a cancelled
+// context are not.
+func isRetryableUploadError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var statusErr *partStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.statusCode == http.StatusRequestTimeout ||
+			statusErr.statusCode == http.StatusTooManyRequests ||
+			statusErr.statusCode >= http.StatusInternalServerError
+	}
+	return true
+}
+
+func uploadPercent(uploaded, total int64) int64 {
+	if total <= 0 {
+		return 100
+	}
+	return uploaded * 100 / total
+}
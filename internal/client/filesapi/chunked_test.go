@@ -0,0 +1,177 @@
+// Provider-only tests (no CLI equivalent) for UploadToStageChunked (chunked.go).
+package filesapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const chunkedUploadPath = "/api/v2/files/cid-1/stages/st-1/multipartUploads/"
+
+// chunkedUploadServer records a multipart upload session. failPart, when set, decides
+// the response status of each part request before the part is stored.
+type chunkedUploadServer struct {
+	mu sync.Mutex
+
+	started   filesapi.MultipartUploadReq
+	parts     map[int]string
+	requests  []int
+	completed bool
+	aborted   bool
+
+	failPart func(part, attempt int) (status int, store bool)
+}
+
+func (s *chunkedUploadServer) handler(t *testing.T) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+chunkedUploadPath, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&s.started))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"uploadId":"up-1"}`))
+	})
+	mux.HandleFunc("PUT "+chunkedUploadPath+"up-1/parts/{part}/", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		part, err := strconv.Atoi(r.PathValue("part"))
+		assert.NoError(t, err)
+		assert.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+
+		attempt := 1
+		for _, requested := range s.requests {
+			if requested == part {
+				attempt++
+			}
+		}
+		s.requests = append(s.requests, part)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		status, store := http.StatusOK, true
+		if s.failPart != nil {
+			status, store = s.failPart(part, attempt)
+		}
+		if store {
+			s.parts[part] = string(body)
+		}
+		w.WriteHeader(status)
+	})
+	mux.HandleFunc("GET "+chunkedUploadPath+"up-1/", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		resp := filesapi.MultipartUploadResp{UploadID: "up-1"}
+		for part, body := range s.parts {
+			resp.Parts = append(resp.Parts, filesapi.MultipartUploadPart{PartNumber: part, Size: int64(len(body))})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("POST "+chunkedUploadPath+"up-1/complete/", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.completed = true
+		_, _ = w.Write([]byte(`{"uploadId":"up-1"}`))
+	})
+	mux.HandleFunc("DELETE "+chunkedUploadPath+"up-1/", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.aborted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return mux
+}
+
+func TestUploadToStageChunked_SplitsIntoParts(t *testing.T) {
+	t.Parallel()
+
+	srv := &chunkedUploadServer{parts: map[int]string{}}
+	c := newTestClient(t, srv.handler(t))
+
+	const payload = "0123456789abcdefghij-"
+	err := c.UploadToStageChunked(context.Background(), "cid-1", "st-1", "weights.bin", int64(len(payload)), strings.NewReader(payload), 8)
+	require.NoError(t, err)
+
+	assert.Equal(t, filesapi.MultipartUploadReq{FileName: "weights.bin", FileSize: int64(len(payload)), PartSize: 8}, srv.started)
+	assert.Equal(t, map[int]string{1: "01234567", 2: "89abcdef", 3: "ghij-"}, srv.parts)
+	assert.Equal(t, []int{1, 2, 3}, srv.requests)
+	assert.True(t, srv.completed)
+	assert.False(t, srv.aborted)
+}
+
+func TestUploadToStageChunked_ResumesFailedPart(t *testing.T) {
+	t.Parallel()
+
+	srv := &chunkedUploadServer{
+		parts: map[int]string{},
+		failPart: func(part, attempt int) (int, bool) {
+			if part == 2 && attempt == 1 {
+				return http.StatusBadGateway, false
+			}
+			return http.StatusOK, true
+		},
+	}
+	c := newTestClient(t, srv.handler(t))
+
+	const payload = "aaaabbbbcc"
+	err := c.UploadToStageChunked(context.Background(), "cid-1", "st-1", "weights.bin", int64(len(payload)), strings.NewReader(payload), 4)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{1, 2, 2, 3}, srv.requests, "only the failed part is resent")
+	assert.Equal(t, map[int]string{1: "aaaa", 2: "bbbb", 3: "cc"}, srv.parts)
+	assert.True(t, srv.completed)
+}
+
+func TestUploadToStageChunked_SkipsAcknowledgedPart(t *testing.T) {
+	t.Parallel()
+
+	srv := &chunkedUploadServer{
+		parts: map[int]string{},
+		failPart: func(part, attempt int) (int, bool) {
+			if part == 1 && attempt == 1 {
+				// The part is stored, but the response is an error.
+				return http.StatusGatewayTimeout, true
+			}
+			return http.StatusOK, true
+		},
+	}
+	c := newTestClient(t, srv.handler(t))
+
+	const payload = "aaaabb"
+	err := c.UploadToStageChunked(context.Background(), "cid-1", "st-1", "weights.bin", int64(len(payload)), strings.NewReader(payload), 4)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{1, 2}, srv.requests)
+	assert.True(t, srv.completed)
+}
+
+func TestUploadToStageChunked_AbortsOnClientError(t *testing.T) {
+	t.Parallel()
+
+	srv := &chunkedUploadServer{
+		parts: map[int]string{},
+		failPart: func(part, attempt int) (int, bool) {
+			return http.StatusBadRequest, false
+		},
+	}
+	c := newTestClient(t, srv.handler(t))
+
+	err := c.UploadToStageChunked(context.Background(), "cid-1", "st-1", "weights.bin", 4, strings.NewReader("aaaa"), 4)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "upload part 1 of 1 of weights.bin")
+
+	assert.Equal(t, []int{1}, srv.requests, "client errors are not retried")
+	assert.True(t, srv.aborted)
+	assert.False(t, srv.completed)
+}
@@ -5,6 +5,9 @@
 //   - New(transport HTTPTransport) injects HTTP/auth instead of CLI's New() returning
 //     a stateless httpClient backed by global drapi.
 //   - httpClient holds a transport field; CLI's httpClient is an empty struct.
+//   - UploadToStageChunked is provider-only (chunked.go).
+//   - DeleteCatalog and DeleteVersion are provider-only (catalog.go).
+//   - errFromResp marks 404 responses with ErrNotFound and 429 responses with ErrThrottled.
 //   - endpointURL, assertNextOnSameHost, errFromResp, getJSON, deleteJSON, and
 //     httpClientWithTimeout live here; CLI delegates equivalents to drapi.EndpointURL,
 //     drapi.AssertNextOnSameHost, drapi.ErrFromResp, drapi.GetJSON, drapi.DeleteJSON,
@@ -15,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -27,6 +31,7 @@ type Client interface {
 	CreateCatalog(ctx context.Context) (*CatalogResp, error)
 	CreateStage(ctx context.Context, catalogID string) (*StageResp, error)
 	UploadToStage(ctx context.Context, catalogID, stageID, name string, size int64, body io.Reader) error
+	UploadToStageChunked(ctx context.Context, catalogID, stageID, name string, size int64, body io.ReaderAt, partSize int64) error
 	ApplyStage(ctx context.Context, catalogID, stageID, overwrite string) (*ApplyStageResp, error)
 	UploadFromZipNew(ctx context.Context, name string, size int64, body io.Reader) (*FromFileResp, error)
 	UploadFromZipExisting(ctx context.Context, catalogID, name, overwrite string, size int64, body io.Reader) (*FromFileResp, error)
@@ -35,8 +40,32 @@ type Client interface {
 	DownloadFile(ctx context.Context, catalogID, versionID, path string, w io.Writer) (string, int64, error)
 	DeleteFiles(ctx context.Context, catalogID string, paths []string) (*DeleteFilesResp, error)
 	ListVersions(ctx context.Context, catalogID string, limit int) ([]CatalogVersion, error)
+	DeleteCatalog(ctx context.Context, catalogID string) error
+	DeleteVersion(ctx context.Context, catalogID, versionID string) error
 }
 
+// ErrNotFound matches, with errors.Is, the error of a request that received a 404 response,
+// for example for a catalog that was deleted.
+var ErrNotFound = errors.New("not found")
+
+// notFoundError is the error of a 404 response. Its message is that of any other response.
+type notFoundError struct{ error }
+
+func (e notFoundError) Is(target error) bool { return target == ErrNotFound }
+
+func (e notFoundError) Unwrap() error { return e.error }
+
+// ErrThrottled matches, with errors.Is, the error of a request that received a 429 response
+// after the retries of the HTTP client, so that callers can reduce their request rate.
+var ErrThrottled = errors.New("throttled")
+
+// throttledError is the error of a 429 response. Its message is that of any other response.
+type throttledError struct{ error }
+
+func (e throttledError) Is(target error) bool { return target == ErrThrottled }
+
+func (e throttledError) Unwrap() error { return e.error }
+
 // New returns a Files API client backed by transport.
 func New(transport HTTPTransport) Client {
 	if transport == nil {
@@ -90,11 +119,20 @@ func errFromResp(resp *http.Response, requestURL string) error {
 	if resp.Request != nil {
 		method = resp.Request.Method
 	}
+	var err error
 	if len(body) > 0 {
-		return fmt.Errorf("%s request %s : response %s %s", method, requestURL, resp.Status, string(body))
+		err = fmt.Errorf("%s request %s : response %s %s", method, requestURL, resp.Status, string(body))
+	} else {
+		err = fmt.Errorf("%s request %s : response %s", method, requestURL, resp.Status)
 	}
 
-	return fmt.Errorf("%s request %s : response %s", method, requestURL, resp.Status)
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return notFoundError{err}
+	case http.StatusTooManyRequests:
+		return throttledError{err}
+	}
+	return err
 }
 
 // CLI: drapi.GetJSON(pageURL, label, v) — provider uses context-aware GET with transport auth.
@@ -162,12 +200,11 @@ func (c *httpClient) deleteJSON(ctx context.Context, requestURL string, body any
 }
 
 // CLI: each call site builds &http.Client{Timeout: ...}; provider reuses transport.Transport with per-call timeout.
-//
-//nolint:unparam // UploadHTTPTimeout and DownloadHTTPTimeout share the same duration today.
+// The provider's request timeout applies when it is greater than timeout.
 func (c *httpClient) httpClientWithTimeout(timeout time.Duration) *http.Client {
 	base := c.transport.HTTPClient()
 	return &http.Client{
 		Transport: base.Transport,
-		Timeout:   timeout,
+		Timeout:   max(timeout, base.Timeout),
 	}
 }
@@ -73,6 +73,13 @@ func (t *testTransport) Post(ctx context.Context, path string, body any, result
 		}
 		*out = *resp
 		return nil
+	case *filesapi.MultipartUploadResp:
+		resp, err := drclient.Post[filesapi.MultipartUploadResp](t.c, ctx, path, body)
+		if err != nil {
+			return err
+		}
+		*out = *resp
+		return nil
 	default:
 		return fmt.Errorf("unsupported POST result type %T", result)
 	}
@@ -406,3 +413,64 @@ func TestUploadFromZipNew_HitsFromFileEndpoint(t *testing.T) {
 }
 
 var _ = multipart.ErrMessageTooLarge
+
+func TestDeleteCatalog(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/api/v2/files/cid-1/", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	require.NoError(t, c.DeleteCatalog(context.Background(), "cid-1"))
+}
+
+func TestDeleteCatalog_NotFoundIsNoop(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	require.NoError(t, c.DeleteCatalog(context.Background(), "cid-1"))
+}
+
+func TestDeleteVersion(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/api/v2/files/cid-1/versions/v1/", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	require.NoError(t, c.DeleteVersion(context.Background(), "cid-1", "v1"))
+}
+
+func TestDeleteVersion_Error(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+	}))
+
+	err := c.DeleteVersion(context.Background(), "cid-1", "v1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "delete catalog version v1")
+}
+
+func TestErrFromResp_NotFound(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Catalog not found"}`))
+	}))
+
+	_, err := c.AllFiles(context.Background(), "cid-1", "")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, filesapi.ErrNotFound)
+	assert.Contains(t, err.Error(), "Catalog not found")
+}
+
+func TestErrFromResp_Throttled(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+
+	err := c.UploadToStage(context.Background(), "cid-1", "sid-1", "main.py", 1, strings.NewReader("x"))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, filesapi.ErrThrottled)
+	assert.NotErrorIs(t, err, filesapi.ErrNotFound)
+}
@@ -12,6 +12,11 @@
 // (CreateStage → UploadToStage → ApplyStage) for small change sets, and an
 // async zip workflow (UploadFromZip* → PollStatus) for larger ones.
 //
+// The provider adds UploadToStageChunked, which uploads a single large stage file
+// in parts and resends only the failed part after a network error, and
+// DeleteCatalog and DeleteVersion, which delete a catalog with all of its
+// versions and a single catalog version.
+//
 // Construct a client with New, passing the provider's shared HTTP client so
 // auth and base URL match the Workload API:
 //
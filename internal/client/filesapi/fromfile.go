@@ -81,12 +81,9 @@ func (c *httpClient) PollStatus(ctx context.Context, statusID string) (*StatusRe
 
 	c.transport.PrepareAPIRequest(req)
 
-	client := &http.Client{
-		Transport: c.transport.HTTPClient().Transport,
-		Timeout:   StatusPollHTTPTimeout,
-		CheckRedirect: func(*http.Request, []*http.Request) error {
-			return http.ErrUseLastResponse
-		},
+	client := c.httpClientWithTimeout(StatusPollHTTPTimeout)
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
 	}
 
 	resp, err := client.Do(req)
@@ -7,6 +7,7 @@
 //     (uploadHTTPTimeout, downloadHTTPTimeout, statusPollHTTPTimeout).
 //   - ZipPollInterval/ZipPollTimeout are co-located here for upcoming sync code; CLI keeps
 //     them in internal/workload/sync/limits.go.
+//   - Chunked upload tunables are provider-only (see chunked.go).
 package filesapi
 
 import "time"
@@ -25,3 +26,17 @@ const (
 	ZipPollInterval = 500 * time.Millisecond
 	ZipPollTimeout  = 600 * time.Second
 )
+
+// Chunked upload tunables (provider-only).
+const (
+	// ChunkedUploadPartSize is the size of each part of UploadToStageChunked.
+	ChunkedUploadPartSize = 32 * 1024 * 1024
+
+	// ChunkedUploadPartAttempts caps the attempts to upload a single part.
+	ChunkedUploadPartAttempts = 5
+
+	// ChunkedUploadRetryWaitMin and ChunkedUploadRetryWaitMax bound the exponential
+	// backoff between attempts of a part.
+	ChunkedUploadRetryWaitMin = 1 * time.Second
+	ChunkedUploadRetryWaitMax = 30 * time.Second
+)
@@ -46,6 +46,25 @@ type FromFileResp struct {
 	StatusID         string `json:"statusId"`
 }
 
+// MultipartUploadReq starts a chunked upload of a single file to a stage (provider-only).
+type MultipartUploadReq struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+	PartSize int64  `json:"partSize"`
+}
+
+// MultipartUploadResp is a chunked upload session with the parts received so far.
+type MultipartUploadResp struct {
+	UploadID string                `json:"uploadId"`
+	Parts    []MultipartUploadPart `json:"parts,omitempty"`
+}
+
+// MultipartUploadPart is a part acknowledged by the server; part numbers start at 1.
+type MultipartUploadPart struct {
+	PartNumber int   `json:"partNumber"`
+	Size       int64 `json:"size"`
+}
+
 type StatusResp struct {
 	Status   string `json:"status"`
 	Message  string `json:"message,omitempty"`
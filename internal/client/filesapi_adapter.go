@@ -55,6 +55,13 @@ func (t *filesapiTransport) Post(ctx context.Context, path string, body any, res
 		}
 		*out = *resp
 		return nil
+	case *filesapi.MultipartUploadResp:
+		resp, err := Post[filesapi.MultipartUploadResp](t.c, ctx, path, body)
+		if err != nil {
+			return err
+		}
+		*out = *resp
+		return nil
 	default:
 		return fmt.Errorf("filesapi transport: unsupported POST result type %T", result)
 	}
@@ -88,7 +88,7 @@ type Service interface {
 	CreateCustomJob(ctx context.Context, req *CreateCustomJobRequest) (*CustomJob, error)
 	GetCustomJob(ctx context.Context, id string) (*CustomJob, error)
 	UpdateCustomJob(ctx context.Context, id string, req *UpdateCustomJobRequest) (*CustomJob, error)
-	UpdateCustomJobFiles(ctx context.Context, id string, files []FileInfo) (*CustomJob, error)
+	UpdateCustomJobFiles(ctx context.Context, id string, files []FileInfo, filesToDelete []string) (*CustomJob, error)
 	ListCustomJobMetrics(ctx context.Context, id string) ([]CustomJobMetric, error)
 	DeleteCustomJob(ctx context.Context, id string) error
 
@@ -151,6 +151,8 @@ type Service interface {
 	// Deployment
 	CreateDeploymentFromModelPackage(ctx context.Context, req *CreateDeploymentFromModelPackageRequest) (*DeploymentCreateResponse, string, error)
 	GetDeployment(ctx context.Context, id string) (*Deployment, error)
+	ListDeployments(ctx context.Context, req *ListDeploymentsRequest) ([]Deployment, error)
+	ListUseCaseDeployments(ctx context.Context, useCaseID string) ([]Deployment, error)
 	GetDeploymentLogs(ctx context.Context, id string) (string, error)
 	UpdateDeployment(ctx context.Context, id string, req *UpdateDeploymentRequest) (*Deployment, error)
 	DeleteDeployment(ctx context.Context, id string) error
@@ -176,6 +178,13 @@ type Service interface {
 	GetRetrainingPolicy(ctx context.Context, deploymentID, id string) (*RetrainingPolicy, error)
 	UpdateRetrainingPolicy(ctx context.Context, deploymentID, id string, req *RetrainingPolicyRequest) (*RetrainingPolicy, error)
 	DeleteRetrainingPolicy(ctx context.Context, deploymentID, id string) error
+	// Deployment: Challengers
+	CreateDeploymentChallenger(ctx context.Context, deploymentID string, req *CreateDeploymentChallengerRequest) (string, error)
+	GetDeploymentChallenger(ctx context.Context, deploymentID, id string) (*DeploymentChallenger, error)
+	ListDeploymentChallengers(ctx context.Context, deploymentID string) ([]DeploymentChallenger, error)
+	UpdateDeploymentChallenger(ctx context.Context, deploymentID, id string, req *UpdateDeploymentChallengerRequest) (*DeploymentChallenger, error)
+	DeleteDeploymentChallenger(ctx context.Context, deploymentID, id string) error
+	GetDeploymentAccuracy(ctx context.Context, deploymentID string, req *DeploymentAccuracyRequest) (*DeploymentAccuracy, error)
 
 	// Notification Channel
 	CreateNotificationChannel(ctx context.Context, req *CreateNotificationChannelRequest) (*NotificationChannel, error)
@@ -230,6 +239,11 @@ type Service interface {
 	UpdateCredential(ctx context.Context, id string, req *CredentialRequest) (*Credential, error)
 	DeleteCredential(ctx context.Context, id string) error
 	ListCredentials(ctx context.Context) ([]Credential, error)
+	GetCredentialSecret(ctx context.Context, id string) (*CredentialSecret, error)
+
+	// API Key
+	CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest) (*APIKey, error)
+	DeleteAPIKey(ctx context.Context, id string) error
 
 	// Execution Environment
 	CreateExecutionEnvironment(ctx context.Context, req *CreateExecutionEnvironmentRequest) (*ExecutionEnvironment, error)
@@ -272,6 +286,7 @@ type Service interface {
 	GetAppOAuthProvider(ctx context.Context, id string) (*AppOAuthProviderResponse, error)
 	UpdateAppOAuthProvider(ctx context.Context, id string, req *UpdateAppOAuthProviderRequest) (*AppOAuthProviderResponse, error)
 	DeleteAppOAuthProvider(ctx context.Context, id string) error
+	GetAppOAuthProviderToken(ctx context.Context, id string) (*AppOAuthToken, error)
 
 	// Artifact (Workload API)
 	CreateArtifact(ctx context.Context, req *CreateArtifactRequest) (*Artifact, error)
@@ -292,6 +307,10 @@ type Service interface {
 	StartWorkloadReplacement(ctx context.Context, workloadID string, req *StartReplacementRequest) (*WorkloadReplacement, error)
 	GetWorkloadReplacement(ctx context.Context, workloadID string) (*WorkloadReplacement, error)
 	UpdateWorkloadSettings(ctx context.Context, workloadID string, req *UpdateWorkloadSettingsRequest) (*WorkloadReplacement, error)
+	CancelWorkloadReplacement(ctx context.Context, workloadID string) error
+	ListWorkloadLogs(ctx context.Context, workloadID string, req *ListWorkloadLogsRequest) ([]WorkloadLogEntry, error)
+	ListWorkloadEvents(ctx context.Context, workloadID string, req *ListWorkloadEventsRequest) ([]WorkloadEvent, error)
+	GetWorkloadLogs(ctx context.Context, workloadID string) (string, error)
 	WaitForWorkloadReplacement(ctx context.Context, workloadID string, opts *WaitForWorkloadReplacementOptions) (*WorkloadReplacement, error)
 
 	// Quota
@@ -321,6 +340,7 @@ func NewService(c *Client) Service {
 	if c == nil {
 		panic("client is required")
 	}
+	// the API gateway client shares the HTTP client, and with it the proxy, TLS and timeout settings
 	apiGWConfig := *c.cfg
 	apiGWConfig.Endpoint = apiGWConfig.BaseURL() + "/api-gw"
 	apiGWClient := NewClient(&apiGWConfig)
@@ -547,8 +567,8 @@ func (s *ServiceImpl) UpdateCustomJob(ctx context.Context, id string, req *Updat
 	return Patch[CustomJob](s.client, ctx, "/customJobs/"+id+"/", req)
 }
 
-func (s *ServiceImpl) UpdateCustomJobFiles(ctx context.Context, id string, files []FileInfo) (*CustomJob, error) {
-	return uploadFilesFromBinaries[CustomJob](s.client, ctx, "/customJobs/"+id+"/", http.MethodPatch, files, map[string]string{})
+func (s *ServiceImpl) UpdateCustomJobFiles(ctx context.Context, id string, files []FileInfo, filesToDelete []string) (*CustomJob, error) {
+	return uploadFilesWithListFields[CustomJob](s.client, ctx, "/customJobs/"+id+"/", http.MethodPatch, files, map[string]string{}, map[string][]string{"filesToDelete": filesToDelete})
 }
 
 func (s *ServiceImpl) ListCustomJobMetrics(ctx context.Context, id string) ([]CustomJobMetric, error) {
@@ -599,7 +619,7 @@ func (s *ServiceImpl) CreateCustomModelVersionCreateFromLatest(ctx context.Conte
 }
 
 func (s *ServiceImpl) CreateCustomModelVersionFromFiles(ctx context.Context, id string, req *CreateCustomModelVersionFromFilesRequest) (*CustomModelVersion, error) {
-	return uploadFilesFromBinaries[CustomModelVersion](s.client, ctx, "/customModels/"+id+"/versions/", http.MethodPatch, req.Files, map[string]string{"baseEnvironmentId": req.BaseEnvironmentID, "isMajorUpdate": "false"})
+	return uploadFilesWithListFields[CustomModelVersion](s.client, ctx, "/customModels/"+id+"/versions/", http.MethodPatch, req.Files, map[string]string{"baseEnvironmentId": req.BaseEnvironmentID, "isMajorUpdate": "false"}, map[string][]string{"filesToDelete": req.FilesToDelete})
 }
 
 func (s *ServiceImpl) CreateCustomModelVersionFromRemoteRepository(ctx context.Context, id string, req *CreateCustomModelVersionFromRemoteRepositoryRequest) (*CustomModelVersion, string, error) {
@@ -775,6 +795,14 @@ func (s *ServiceImpl) GetDeployment(ctx context.Context, id string) (*Deployment
 	return Get[Deployment](s.client, ctx, "/deployments/"+id+"/")
 }
 
+func (s *ServiceImpl) ListDeployments(ctx context.Context, req *ListDeploymentsRequest) ([]Deployment, error) {
+	return GetAllPages[Deployment](s.client, ctx, "/deployments/", req)
+}
+
+func (s *ServiceImpl) ListUseCaseDeployments(ctx context.Context, useCaseID string) ([]Deployment, error) {
+	return GetAllPages[Deployment](s.client, ctx, "/useCases/"+useCaseID+"/deployments/", nil)
+}
+
 func deploymentLogsTailLines() int {
 	tailLines, err := strconv.Atoi(os.Getenv(DeploymentLogsTailLinesEnvVar))
 	if err != nil || tailLines <= 0 {
@@ -868,6 +896,34 @@ func (s *ServiceImpl) DeleteRetrainingPolicy(ctx context.Context, deploymentID,
 	return Delete(s.client, ctx, "/deployments/"+deploymentID+"/retrainingPolicies/"+id+"/")
 }
 
+// CreateDeploymentChallenger starts adding a challenger to a Deployment and returns the ID
+// of the status of the task. The challenger ID is only known once the task completes.
+func (s *ServiceImpl) CreateDeploymentChallenger(ctx context.Context, deploymentID string, req *CreateDeploymentChallengerRequest) (string, error) {
+	_, statusID, err := ExecuteAndExpectStatus[CreateVoidResponse](s.client, ctx, http.MethodPost, "/deployments/"+deploymentID+"/challengers/", req)
+	return statusID, err
+}
+
+func (s *ServiceImpl) GetDeploymentChallenger(ctx context.Context, deploymentID, id string) (*DeploymentChallenger, error) {
+	return Get[DeploymentChallenger](s.client, ctx, "/deployments/"+deploymentID+"/challengers/"+id+"/")
+}
+
+func (s *ServiceImpl) ListDeploymentChallengers(ctx context.Context, deploymentID string) ([]DeploymentChallenger, error) {
+	return GetAllPages[DeploymentChallenger](s.client, ctx, "/deployments/"+deploymentID+"/challengers/", nil)
+}
+
+func (s *ServiceImpl) UpdateDeploymentChallenger(ctx context.Context, deploymentID, id string, req *UpdateDeploymentChallengerRequest) (*DeploymentChallenger, error) {
+	return Patch[DeploymentChallenger](s.client, ctx, "/deployments/"+deploymentID+"/challengers/"+id+"/", req)
+}
+
+func (s *ServiceImpl) DeleteDeploymentChallenger(ctx context.Context, deploymentID, id string) error {
+	return Delete(s.client, ctx, "/deployments/"+deploymentID+"/challengers/"+id+"/")
+}
+
+func (s *ServiceImpl) GetDeploymentAccuracy(ctx context.Context, deploymentID string, req *DeploymentAccuracyRequest) (*DeploymentAccuracy, error) {
+	pathValues, _ := query.Values(req)
+	return Get[DeploymentAccuracy](s.client, ctx, "/deployments/"+deploymentID+"/accuracy/?"+pathValues.Encode())
+}
+
 func (s *ServiceImpl) CreateNotificationChannel(ctx context.Context, req *CreateNotificationChannelRequest) (*NotificationChannel, error) {
 	return Post[NotificationChannel](s.client, ctx, "/entityNotificationChannels/", req)
 }
@@ -1051,6 +1107,19 @@ func (s *ServiceImpl) ListCredentials(ctx context.Context) ([]Credential, error)
 	return GetAllPages[Credential](s.client, ctx, "/credentials/", nil)
 }
 
+func (s *ServiceImpl) GetCredentialSecret(ctx context.Context, id string) (*CredentialSecret, error) {
+	return Get[CredentialSecret](s.client, ctx, "/credentials/"+id+"/secret/")
+}
+
+// API Key Service Implementation.
+func (s *ServiceImpl) CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest) (*APIKey, error) {
+	return Post[APIKey](s.client, ctx, "/account/apiKeys/", req)
+}
+
+func (s *ServiceImpl) DeleteAPIKey(ctx context.Context, id string) error {
+	return Delete(s.client, ctx, "/account/apiKeys/"+id+"/")
+}
+
 // Execution Environment Service Implementation.
 func (s *ServiceImpl) CreateExecutionEnvironment(ctx context.Context, req *CreateExecutionEnvironmentRequest) (*ExecutionEnvironment, error) {
 	return Post[ExecutionEnvironment](s.client, ctx, "/executionEnvironments/", req)
@@ -1195,3 +1264,7 @@ func (s *ServiceImpl) UpdateAppOAuthProvider(ctx context.Context, id string, req
 func (s *ServiceImpl) DeleteAppOAuthProvider(ctx context.Context, id string) error {
 	return Delete(s.client, ctx, "/externalOAuth/providers/"+id+"/")
 }
+
+func (s *ServiceImpl) GetAppOAuthProviderToken(ctx context.Context, id string) (*AppOAuthToken, error) {
+	return Post[AppOAuthToken](s.client, ctx, "/externalOAuth/providers/"+id+"/token/", nil)
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+type operationTimeoutKey struct{}
+
+// WithOperationTimeout returns a copy of ctx that carries d as the time budget
+// for long-running waits (task polling, artifact builds, workload replacements).
+// Non-positive durations leave ctx unchanged so callers fall back to the
+// environment-driven defaults.
+func WithOperationTimeout(ctx context.Context, d time.Duration) context.Context {
+	if d <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, operationTimeoutKey{}, d)
+}
+
+// OperationTimeout returns the wait budget stored by WithOperationTimeout, if any.
+func OperationTimeout(ctx context.Context) (time.Duration, bool) {
+	d, ok := ctx.Value(operationTimeoutKey{}).(time.Duration)
+	return d, ok && d > 0
+}
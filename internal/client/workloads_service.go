@@ -2,8 +2,10 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -114,6 +116,7 @@ type ReplacementStatus string
 
 const (
 	ReplacementStrategyRolling ReplacementStrategy = "rolling"
+	ReplacementStrategyCanary  ReplacementStrategy = "canary"
 
 	ReplacementStatusUnknown      ReplacementStatus = "unknown"
 	ReplacementStatusSubmitted    ReplacementStatus = "submitted"
@@ -134,6 +137,11 @@ const (
 	defaultReplacementPollTimeout  = 30 * time.Minute
 )
 
+const (
+	WorkloadLogsTailLinesEnvVar  = "DATAROBOT_WORKLOAD_LOGS_TAIL_LINES"
+	defaultWorkloadLogsTailLines = 30
+)
+
 func workloadReplacementPollInterval() time.Duration {
 	return durationFromEnv(WorkloadReplacementPollIntervalEnvVar, defaultReplacementPollInterval)
 }
@@ -156,9 +164,17 @@ func durationFromEnv(envVar string, fallback time.Duration) time.Duration {
 	return d
 }
 
+// ReplacementStep is a step of a canary replacement: the candidate receives TrafficPercent
+// of the traffic for PauseMinutes before the next step.
+type ReplacementStep struct {
+	TrafficPercent int64 `json:"trafficPercent"`
+	PauseMinutes   int64 `json:"pauseMinutes,omitempty"`
+}
+
 type ReplacementConfig struct {
-	WarmupDurationMinutes int64 `json:"warmupDurationMinutes,omitempty"`
-	KeepOldVersionMinutes int64 `json:"keepOldVersionMinutes,omitempty"`
+	WarmupDurationMinutes int64             `json:"warmupDurationMinutes,omitempty"`
+	KeepOldVersionMinutes int64             `json:"keepOldVersionMinutes,omitempty"`
+	Steps                 []ReplacementStep `json:"steps,omitempty"`
 }
 
 type StartReplacementRequest struct {
@@ -186,10 +202,25 @@ type WorkloadReplacement struct {
 type WaitForWorkloadReplacementOptions struct {
 	PollInterval time.Duration
 	Timeout      time.Duration
+	// HealthGate is called with the workload on every poll once the replacement is
+	// active. An error trips the gate and fails the replacement.
+	HealthGate func(workload *Workload) error
+	// Rollback cancels the replacement when it fails or a health gate trips, and
+	// waits until the workload runs its previous version again.
+	Rollback bool
 }
 
 type ReplacementFailedError struct {
 	Message string
+	// Logs is the tail of the workload events and container logs at the time of the
+	// failure, and LogsErr the error retrieving them.
+	Logs    string
+	LogsErr error
+	// HealthGate is set when a health gate tripped rather than the replacement erroring.
+	HealthGate bool
+	// RolledBack is set when the replacement was canceled and the workload runs its
+	// previous version again.
+	RolledBack bool
 }
 
 func (e *ReplacementFailedError) Error() string {
@@ -212,6 +243,12 @@ func (s *ServiceImpl) GetWorkloadReplacement(ctx context.Context, workloadID str
 	return Get[WorkloadReplacement](s.client, ctx, "/workloads/"+workloadID+"/replacement")
 }
 
+// CancelWorkloadReplacement cancels the in-flight replacement of the workload, which
+// shifts all traffic back to the current version.
+func (s *ServiceImpl) CancelWorkloadReplacement(ctx context.Context, workloadID string) error {
+	return Delete(s.client, ctx, "/workloads/"+workloadID+"/replacement")
+}
+
 func (s *ServiceImpl) UpdateWorkloadSettings(ctx context.Context, workloadID string, req *UpdateWorkloadSettingsRequest) (*WorkloadReplacement, error) {
 	return Patch[WorkloadReplacement](s.client, ctx, "/workloads/"+workloadID+"/settings", req)
 }
@@ -224,6 +261,8 @@ func (s *ServiceImpl) UpdateWorkloadSettings(ctx context.Context, workloadID str
 // can't be a masked failure, and the proton switch lands before nil appears).
 // A nil is only "done" after an active replacement was seen (seenActive) —
 // otherwise it's the brief gap before the API creates the record, so keep polling.
+// The ReplacementFailedError carries the tail of the workload logs, and with
+// opts.Rollback the failed replacement or tripped health gate is canceled first.
 func (s *ServiceImpl) WaitForWorkloadReplacement(
 	ctx context.Context,
 	workloadID string,
@@ -231,6 +270,9 @@ func (s *ServiceImpl) WaitForWorkloadReplacement(
 ) (*WorkloadReplacement, error) {
 	pollInterval := workloadReplacementPollInterval()
 	timeout := workloadReplacementPollTimeout()
+	if d, ok := OperationTimeout(ctx); ok {
+		timeout = d
+	}
 	if opts != nil {
 		if opts.PollInterval > 0 {
 			pollInterval = opts.PollInterval
@@ -244,6 +286,21 @@ func (s *ServiceImpl) WaitForWorkloadReplacement(
 	seenActive := false
 	var lastReplacement *WorkloadReplacement
 
+	fail := func(replacement *WorkloadReplacement, failure *ReplacementFailedError) (*WorkloadReplacement, error) {
+		// fetch the logs before a rollback stops the containers of the candidate
+		failure.Logs, failure.LogsErr = s.GetWorkloadLogs(ctx, workloadID)
+		if opts == nil || !opts.Rollback {
+			return replacement, failure
+		}
+		// the rollback gets its own budget, a gate may trip just before the deadline
+		if err := s.rollBackWorkloadReplacement(ctx, workloadID, pollInterval, time.Now().Add(timeout)); err != nil {
+			failure.Message += "; rollback failed: " + err.Error()
+			return replacement, failure
+		}
+		failure.RolledBack = true
+		return replacement, failure
+	}
+
 	for {
 		if err := ctx.Err(); err != nil {
 			return nil, err
@@ -261,7 +318,7 @@ func (s *ServiceImpl) WaitForWorkloadReplacement(
 			if replacement.Message != nil && *replacement.Message != "" {
 				message = *replacement.Message
 			}
-			return replacement, &ReplacementFailedError{Message: message}
+			return fail(replacement, &ReplacementFailedError{Message: message})
 
 		case replacement != nil:
 			lastReplacement = replacement
@@ -277,6 +334,15 @@ func (s *ServiceImpl) WaitForWorkloadReplacement(
 			}
 		}
 
+		if seenActive && opts != nil && opts.HealthGate != nil {
+			if err := opts.HealthGate(workload); err != nil {
+				return fail(lastReplacement, &ReplacementFailedError{
+					Message:    "health gate tripped: " + err.Error(),
+					HealthGate: true,
+				})
+			}
+		}
+
 		if time.Now().After(deadline) {
 			return lastReplacement, fmt.Errorf(
 				"timeout waiting for workload %s replacement after %s (workload status: %s)",
@@ -296,6 +362,139 @@ func (s *ServiceImpl) WaitForWorkloadReplacement(
 	}
 }
 
+// rollBackWorkloadReplacement cancels the replacement of the workload and polls until
+// its record is gone and the workload runs its previous version again.
+func (s *ServiceImpl) rollBackWorkloadReplacement(
+	ctx context.Context,
+	workloadID string,
+	pollInterval time.Duration,
+	deadline time.Time,
+) error {
+	if err := s.CancelWorkloadReplacement(ctx, workloadID); err != nil && !errors.Is(err, &NotFoundError{}) {
+		return err
+	}
+
+	for {
+		workload, err := s.GetWorkload(ctx, workloadID)
+		if err != nil {
+			return err
+		}
+		if workload.Replacement == nil && workload.Status == ProtonStatusRunning {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout waiting for workload %s to roll back (workload status: %s)", workloadID, workload.Status)
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+type WorkloadLogEntry struct {
+	Timestamp string `json:"timestamp"`
+	Container string `json:"container"`
+	Level     string `json:"level"`
+	Message   string `json:"message"`
+}
+
+type WorkloadEvent struct {
+	Timestamp string `json:"timestamp"`
+	Type      string `json:"type"`
+	Reason    string `json:"reason"`
+	Message   string `json:"message"`
+}
+
+type ListWorkloadLogsRequest struct {
+	Container string `url:"container,omitempty"`
+	Limit     int    `url:"limit,omitempty"`
+}
+
+type ListWorkloadEventsRequest struct {
+	Limit int `url:"limit,omitempty"`
+}
+
+func workloadLogsTailLines() int {
+	tailLines, err := strconv.Atoi(os.Getenv(WorkloadLogsTailLinesEnvVar))
+	if err != nil || tailLines <= 0 {
+		return defaultWorkloadLogsTailLines
+	}
+	return tailLines
+}
+
+// ListWorkloadLogs returns the latest container logs of the workload, of all its
+// containers unless req.Container is set. req.Limit defaults to the tail lines of
+// DATAROBOT_WORKLOAD_LOGS_TAIL_LINES.
+func (s *ServiceImpl) ListWorkloadLogs(ctx context.Context, workloadID string, req *ListWorkloadLogsRequest) ([]WorkloadLogEntry, error) {
+	queryReq := ListWorkloadLogsRequest{Limit: workloadLogsTailLines()}
+	if req != nil {
+		queryReq.Container = req.Container
+		if req.Limit > 0 {
+			queryReq.Limit = req.Limit
+		}
+	}
+	pathValues, _ := query.Values(queryReq)
+
+	resp, err := Get[PaginatedResponse[WorkloadLogEntry]](s.client, ctx, "/workloads/"+workloadID+"/logs/?"+pathValues.Encode())
+	if err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// ListWorkloadEvents returns the latest lifecycle events of the workload, e.g. image
+// pulls, restarts and failed health checks. req.Limit defaults like ListWorkloadLogs.
+func (s *ServiceImpl) ListWorkloadEvents(ctx context.Context, workloadID string, req *ListWorkloadEventsRequest) ([]WorkloadEvent, error) {
+	queryReq := ListWorkloadEventsRequest{Limit: workloadLogsTailLines()}
+	if req != nil && req.Limit > 0 {
+		queryReq.Limit = req.Limit
+	}
+	pathValues, _ := query.Values(queryReq)
+
+	resp, err := Get[PaginatedResponse[WorkloadEvent]](s.client, ctx, "/workloads/"+workloadID+"/events/?"+pathValues.Encode())
+	if err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// GetWorkloadLogs returns the tail of the events and container logs of the workload,
+// formatted for diagnostics. Events and logs are retrieved independently: when one of
+// them fails, the other is still returned along with the error.
+func (s *ServiceImpl) GetWorkloadLogs(ctx context.Context, workloadID string) (string, error) {
+	var errs []error
+	events, err := s.ListWorkloadEvents(ctx, workloadID, nil)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("events: %w", err))
+	}
+	logs, err := s.ListWorkloadLogs(ctx, workloadID, nil)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("logs: %w", err))
+	}
+
+	lines := make([]string, 0, len(events)+len(logs)+2)
+	if len(events) > 0 {
+		lines = append(lines, "Events:")
+		for _, event := range events {
+			lines = append(lines, fmt.Sprintf("[%s] %s %s: %s", event.Timestamp, event.Type, event.Reason, event.Message))
+		}
+	}
+	if len(logs) > 0 {
+		lines = append(lines, "Logs:")
+		for _, entry := range logs {
+			lines = append(lines, fmt.Sprintf("[%s] %s %s: %s", entry.Timestamp, entry.Container, strings.ToUpper(entry.Level), entry.Message))
+		}
+	}
+
+	return strings.Join(lines, "\n"), errors.Join(errs...)
+}
+
 type ArtifactStatus string
 type ArtifactType string
 
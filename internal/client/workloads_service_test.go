@@ -307,6 +307,168 @@ func TestWaitForWorkloadReplacementReturnsReplacementFailedError(t *testing.T) {
 	}
 }
 
+func TestWaitForWorkloadReplacementRollsBackFailedReplacement(t *testing.T) {
+	// With Rollback, an errored replacement is canceled and the waiter returns once
+	// the record is gone and the previous version runs again.
+	canceled := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			if r.URL.Path != "/workloads/wl-1/replacement" {
+				t.Errorf("unexpected cancel path %s", r.URL.Path)
+			}
+			canceled = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if canceled {
+			_ = json.NewEncoder(w).Encode(workloadJSON(ProtonStatusRunning, nil))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(workloadJSON(ProtonStatusRunning, replacementJSON(ReplacementStatusErrored, "candidate crashed")))
+	}))
+	defer server.Close()
+
+	cfg := NewConfiguration("fake-token")
+	cfg.Endpoint = server.URL
+	svc := NewService(NewClient(cfg))
+
+	_, err := svc.WaitForWorkloadReplacement(context.Background(), "wl-1", &WaitForWorkloadReplacementOptions{
+		PollInterval: 5 * time.Millisecond,
+		Timeout:      time.Second,
+		Rollback:     true,
+	})
+
+	var failedErr *ReplacementFailedError
+	if !errors.As(err, &failedErr) {
+		t.Fatalf("expected ReplacementFailedError, got %T: %v", err, err)
+	}
+	if !canceled || !failedErr.RolledBack || failedErr.HealthGate {
+		t.Fatalf("expected a rolled back replacement failure, got %+v (canceled: %t)", failedErr, canceled)
+	}
+}
+
+func TestWaitForWorkloadReplacementHealthGate(t *testing.T) {
+	// A tripped health gate fails an otherwise healthy replacement, and without
+	// Rollback the replacement is left alone.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			t.Error("replacement canceled without Rollback")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(workloadJSON(ProtonStatusErrored, replacementJSON(ReplacementStatusPromoting, "")))
+	}))
+	defer server.Close()
+
+	cfg := NewConfiguration("fake-token")
+	cfg.Endpoint = server.URL
+	svc := NewService(NewClient(cfg))
+
+	_, err := svc.WaitForWorkloadReplacement(context.Background(), "wl-1", &WaitForWorkloadReplacementOptions{
+		PollInterval: 5 * time.Millisecond,
+		Timeout:      time.Second,
+		HealthGate: func(workload *Workload) error {
+			if workload.Status == ProtonStatusErrored {
+				return errors.New("workload errored")
+			}
+			return nil
+		},
+	})
+
+	var failedErr *ReplacementFailedError
+	if !errors.As(err, &failedErr) {
+		t.Fatalf("expected ReplacementFailedError, got %T: %v", err, err)
+	}
+	if !failedErr.HealthGate || failedErr.RolledBack {
+		t.Fatalf("expected a health gate failure without rollback, got %+v", failedErr)
+	}
+	if !strings.Contains(failedErr.Message, "workload errored") {
+		t.Fatalf("unexpected error message: %q", failedErr.Message)
+	}
+}
+
+func TestStartWorkloadReplacementSendsSteps(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(replacementJSON(ReplacementStatusSubmitted, ""))
+	}))
+	defer server.Close()
+
+	cfg := NewConfiguration("fake-token")
+	cfg.Endpoint = server.URL
+	svc := NewService(NewClient(cfg))
+
+	_, err := svc.StartWorkloadReplacement(context.Background(), "wl-1", &StartReplacementRequest{
+		ArtifactID: "art-2",
+		Strategy:   ReplacementStrategyCanary,
+		Config: ReplacementConfig{Steps: []ReplacementStep{
+			{TrafficPercent: 10, PauseMinutes: 5},
+			{TrafficPercent: 100},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("StartWorkloadReplacement returned error: %v", err)
+	}
+
+	if body["strategy"] != "canary" {
+		t.Fatalf("expected canary strategy, got %v", body["strategy"])
+	}
+	steps, _ := body["config"].(map[string]any)["steps"].([]any)
+	if len(steps) != 2 {
+		t.Fatalf("expected 2 steps, got %v", body["config"])
+	}
+	first := steps[0].(map[string]any)
+	if first["trafficPercent"] != float64(10) || first["pauseMinutes"] != float64(5) {
+		t.Fatalf("unexpected first step: %v", first)
+	}
+}
+
+func TestWaitForWorkloadReplacementAttachesLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/workloads/wl-1/events/":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				workloadEventJSON("Warning", "BackOff", "back-off restarting failed container"),
+			}})
+		case "/workloads/wl-1/logs/":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+				workloadLogEntryJSON("main", "error", "address already in use"),
+			}})
+		default:
+			_ = json.NewEncoder(w).Encode(workloadJSON(ProtonStatusRunning, replacementJSON(ReplacementStatusErrored, "candidate crashed")))
+		}
+	}))
+	defer server.Close()
+
+	cfg := NewConfiguration("fake-token")
+	cfg.Endpoint = server.URL
+	svc := NewService(NewClient(cfg))
+
+	_, err := svc.WaitForWorkloadReplacement(context.Background(), "wl-1", &WaitForWorkloadReplacementOptions{
+		PollInterval: 5 * time.Millisecond,
+		Timeout:      time.Second,
+	})
+
+	var failedErr *ReplacementFailedError
+	if !errors.As(err, &failedErr) {
+		t.Fatalf("expected ReplacementFailedError, got %T: %v", err, err)
+	}
+	if failedErr.LogsErr != nil {
+		t.Fatalf("unexpected logs error: %v", failedErr.LogsErr)
+	}
+	for _, want := range []string{
+		"[2026-07-09T16:14:50Z] Warning BackOff: back-off restarting failed container",
+		"[2026-07-09T16:14:50Z] main ERROR: address already in use",
+	} {
+		if !strings.Contains(failedErr.Logs, want) {
+			t.Errorf("expected logs to contain %q, got:\n%s", want, failedErr.Logs)
+		}
+	}
+}
+
 func TestWaitForWorkloadReplacementTimesOut(t *testing.T) {
 	// A replacement that never settles (stuck non-terminal) must time out.
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -331,6 +493,31 @@ func TestWaitForWorkloadReplacementTimesOut(t *testing.T) {
 	}
 }
 
+func TestWaitForWorkloadReplacementUsesContextTimeout(t *testing.T) {
+	// A resource timeouts block reaches the waiter through the context and
+	// replaces the env-driven default budget.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(workloadJSON(ProtonStatusInitializing, replacementJSON(ReplacementStatusFinalizing, "")))
+	}))
+	defer server.Close()
+
+	cfg := NewConfiguration("fake-token")
+	cfg.Endpoint = server.URL
+	svc := NewService(NewClient(cfg))
+
+	ctx := WithOperationTimeout(context.Background(), 20*time.Millisecond)
+	_, err := svc.WaitForWorkloadReplacement(ctx, "wl-1", &WaitForWorkloadReplacementOptions{
+		PollInterval: 5 * time.Millisecond,
+	})
+	if err == nil {
+		t.Fatal("expected timeout error")
+	}
+	if !strings.Contains(err.Error(), "replacement after 20ms") {
+		t.Fatalf("expected the context timeout in the error, got: %v", err)
+	}
+}
+
 func TestWorkloadReplacementPollSettings(t *testing.T) {
 	t.Run("uses env var override for interval", func(t *testing.T) {
 		t.Setenv(WorkloadReplacementPollIntervalEnvVar, "7s")
@@ -487,3 +674,98 @@ func TestWorkloadTypeJSONRoundTrip(t *testing.T) {
 		t.Fatalf("encoded type = %v, want %q", payload["type"], "agent")
 	}
 }
+
+func workloadEventJSON(eventType, reason, message string) map[string]any {
+	return map[string]any{
+		"timestamp": "2026-07-09T16:14:50Z",
+		"type":      eventType,
+		"reason":    reason,
+		"message":   message,
+	}
+}
+
+func workloadLogEntryJSON(container, level, message string) map[string]any {
+	return map[string]any{
+		"timestamp": "2026-07-09T16:14:50Z",
+		"container": container,
+		"level":     level,
+		"message":   message,
+	}
+}
+
+func TestListWorkloadLogsRequestsContainerAndLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/workloads/wl-1/logs/" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("container"); got != "main" {
+			t.Fatalf("expected container=main query param, got %q", got)
+		}
+		if got := r.URL.Query().Get("limit"); got != "100" {
+			t.Fatalf("expected limit=100 query param, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+			workloadLogEntryJSON("main", "info", "listening on :8080"),
+		}})
+	}))
+	defer server.Close()
+
+	cfg := NewConfiguration("fake-token")
+	cfg.Endpoint = server.URL
+	svc := NewService(NewClient(cfg))
+
+	logs, err := svc.ListWorkloadLogs(context.Background(), "wl-1", &ListWorkloadLogsRequest{Container: "main", Limit: 100})
+	if err != nil {
+		t.Fatalf("ListWorkloadLogs returned error: %v", err)
+	}
+	if len(logs) != 1 || logs[0].Message != "listening on :8080" {
+		t.Fatalf("unexpected logs: %+v", logs)
+	}
+}
+
+func TestListWorkloadEventsTailLinesEnvVar(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("limit"); got != "5" {
+			t.Fatalf("expected limit=5 query param, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{}})
+	}))
+	defer server.Close()
+
+	cfg := NewConfiguration("fake-token")
+	cfg.Endpoint = server.URL
+	svc := NewService(NewClient(cfg))
+
+	t.Setenv(WorkloadLogsTailLinesEnvVar, "5")
+	if _, err := svc.ListWorkloadEvents(context.Background(), "wl-1", nil); err != nil {
+		t.Fatalf("ListWorkloadEvents returned error: %v", err)
+	}
+}
+
+func TestGetWorkloadLogsKeepsLogsWhenEventsFail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/workloads/wl-1/events/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{
+			workloadLogEntryJSON("main", "error", "address already in use"),
+		}})
+	}))
+	defer server.Close()
+
+	cfg := NewConfiguration("fake-token")
+	cfg.Endpoint = server.URL
+	svc := NewService(NewClient(cfg))
+
+	logs, err := svc.GetWorkloadLogs(context.Background(), "wl-1")
+	if err == nil || !strings.Contains(err.Error(), "events:") || strings.Contains(err.Error(), "logs:") {
+		t.Fatalf("expected only the events to fail, got %v", err)
+	}
+	if !strings.Contains(logs, "main ERROR: address already in use") {
+		t.Fatalf("expected the container logs despite the events error, got:\n%s", logs)
+	}
+}
@@ -86,6 +86,35 @@ func (mr *MockServiceMockRecorder) BaseURL() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseURL", reflect.TypeOf((*MockService)(nil).BaseURL))
 }
 
+// CancelWorkloadReplacement mocks base method.
+func (m *MockService) CancelWorkloadReplacement(ctx context.Context, workloadID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelWorkloadReplacement", ctx, workloadID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelWorkloadReplacement indicates an expected call of CancelWorkloadReplacement.
+func (mr *MockServiceMockRecorder) CancelWorkloadReplacement(ctx, workloadID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelWorkloadReplacement", reflect.TypeOf((*MockService)(nil).CancelWorkloadReplacement), ctx, workloadID)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockService) CreateAPIKey(ctx context.Context, req *client.CreateAPIKeyRequest) (*client.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, req)
+	ret0, _ := ret[0].(*client.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockServiceMockRecorder) CreateAPIKey(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockService)(nil).CreateAPIKey), ctx, req)
+}
+
 // CreateAppOAuthProvider mocks base method.
 func (m *MockService) CreateAppOAuthProvider(ctx context.Context, req *client.CreateAppOAuthProviderRequest) (*client.AppOAuthProviderResponse, error) {
 	m.ctrl.T.Helper()
@@ -494,6 +523,21 @@ func (mr *MockServiceMockRecorder) CreateDependencyBuild(ctx, id, versionID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDependencyBuild", reflect.TypeOf((*MockService)(nil).CreateDependencyBuild), ctx, id, versionID)
 }
 
+// CreateDeploymentChallenger mocks base method.
+func (m *MockService) CreateDeploymentChallenger(ctx context.Context, deploymentID string, req *client.CreateDeploymentChallengerRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeploymentChallenger", ctx, deploymentID, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeploymentChallenger indicates an expected call of CreateDeploymentChallenger.
+func (mr *MockServiceMockRecorder) CreateDeploymentChallenger(ctx, deploymentID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeploymentChallenger", reflect.TypeOf((*MockService)(nil).CreateDeploymentChallenger), ctx, deploymentID, req)
+}
+
 // CreateDeploymentFromModelPackage mocks base method.
 func (m *MockService) CreateDeploymentFromModelPackage(ctx context.Context, req *client.CreateDeploymentFromModelPackageRequest) (*client.DeploymentCreateResponse, string, error) {
 	m.ctrl.T.Helper()
@@ -841,6 +885,20 @@ func (mr *MockServiceMockRecorder) DeactivateDeployment(ctx, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateDeployment", reflect.TypeOf((*MockService)(nil).DeactivateDeployment), ctx, id)
 }
 
+// DeleteAPIKey mocks base method.
+func (m *MockService) DeleteAPIKey(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIKey", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAPIKey indicates an expected call of DeleteAPIKey.
+func (mr *MockServiceMockRecorder) DeleteAPIKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIKey", reflect.TypeOf((*MockService)(nil).DeleteAPIKey), ctx, id)
+}
+
 // DeleteAppOAuthProvider mocks base method.
 func (m *MockService) DeleteAppOAuthProvider(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -1051,6 +1109,20 @@ func (mr *MockServiceMockRecorder) DeleteDeployment(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeployment", reflect.TypeOf((*MockService)(nil).DeleteDeployment), ctx, id)
 }
 
+// DeleteDeploymentChallenger mocks base method.
+func (m *MockService) DeleteDeploymentChallenger(ctx context.Context, deploymentID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeploymentChallenger", ctx, deploymentID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeploymentChallenger indicates an expected call of DeleteDeploymentChallenger.
+func (mr *MockServiceMockRecorder) DeleteDeploymentChallenger(ctx, deploymentID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeploymentChallenger", reflect.TypeOf((*MockService)(nil).DeleteDeploymentChallenger), ctx, deploymentID, id)
+}
+
 // DeleteExecutionEnvironment mocks base method.
 func (m *MockService) DeleteExecutionEnvironment(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -1290,6 +1362,21 @@ func (mr *MockServiceMockRecorder) GetAppOAuthProvider(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppOAuthProvider", reflect.TypeOf((*MockService)(nil).GetAppOAuthProvider), ctx, id)
 }
 
+// GetAppOAuthProviderToken mocks base method.
+func (m *MockService) GetAppOAuthProviderToken(ctx context.Context, id string) (*client.AppOAuthToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppOAuthProviderToken", ctx, id)
+	ret0, _ := ret[0].(*client.AppOAuthToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppOAuthProviderToken indicates an expected call of GetAppOAuthProviderToken.
+func (mr *MockServiceMockRecorder) GetAppOAuthProviderToken(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppOAuthProviderToken", reflect.TypeOf((*MockService)(nil).GetAppOAuthProviderToken), ctx, id)
+}
+
 // GetApplication mocks base method.
 func (m *MockService) GetApplication(ctx context.Context, id string) (*client.Application, error) {
 	m.ctrl.T.Helper()
@@ -1395,6 +1482,21 @@ func (mr *MockServiceMockRecorder) GetCredential(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredential", reflect.TypeOf((*MockService)(nil).GetCredential), ctx, id)
 }
 
+// GetCredentialSecret mocks base method.
+func (m *MockService) GetCredentialSecret(ctx context.Context, id string) (*client.CredentialSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCredentialSecret", ctx, id)
+	ret0, _ := ret[0].(*client.CredentialSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCredentialSecret indicates an expected call of GetCredentialSecret.
+func (mr *MockServiceMockRecorder) GetCredentialSecret(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredentialSecret", reflect.TypeOf((*MockService)(nil).GetCredentialSecret), ctx, id)
+}
+
 // GetCustomJob mocks base method.
 func (m *MockService) GetCustomJob(ctx context.Context, id string) (*client.CustomJob, error) {
 	m.ctrl.T.Helper()
@@ -1560,6 +1662,36 @@ func (mr *MockServiceMockRecorder) GetDeployment(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployment", reflect.TypeOf((*MockService)(nil).GetDeployment), ctx, id)
 }
 
+// GetDeploymentAccuracy mocks base method.
+func (m *MockService) GetDeploymentAccuracy(ctx context.Context, deploymentID string, req *client.DeploymentAccuracyRequest) (*client.DeploymentAccuracy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeploymentAccuracy", ctx, deploymentID, req)
+	ret0, _ := ret[0].(*client.DeploymentAccuracy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeploymentAccuracy indicates an expected call of GetDeploymentAccuracy.
+func (mr *MockServiceMockRecorder) GetDeploymentAccuracy(ctx, deploymentID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeploymentAccuracy", reflect.TypeOf((*MockService)(nil).GetDeploymentAccuracy), ctx, deploymentID, req)
+}
+
+// GetDeploymentChallenger mocks base method.
+func (m *MockService) GetDeploymentChallenger(ctx context.Context, deploymentID, id string) (*client.DeploymentChallenger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeploymentChallenger", ctx, deploymentID, id)
+	ret0, _ := ret[0].(*client.DeploymentChallenger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeploymentChallenger indicates an expected call of GetDeploymentChallenger.
+func (mr *MockServiceMockRecorder) GetDeploymentChallenger(ctx, deploymentID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeploymentChallenger", reflect.TypeOf((*MockService)(nil).GetDeploymentChallenger), ctx, deploymentID, id)
+}
+
 // GetDeploymentChallengerReplaySettings mocks base method.
 func (m *MockService) GetDeploymentChallengerReplaySettings(ctx context.Context, id string) (*client.DeploymentChallengerReplaySettings, error) {
 	m.ctrl.T.Helper()
@@ -2010,6 +2142,21 @@ func (mr *MockServiceMockRecorder) GetWorkload(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkload", reflect.TypeOf((*MockService)(nil).GetWorkload), ctx, id)
 }
 
+// GetWorkloadLogs mocks base method.
+func (m *MockService) GetWorkloadLogs(ctx context.Context, workloadID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkloadLogs", ctx, workloadID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkloadLogs indicates an expected call of GetWorkloadLogs.
+func (mr *MockServiceMockRecorder) GetWorkloadLogs(ctx, workloadID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkloadLogs", reflect.TypeOf((*MockService)(nil).GetWorkloadLogs), ctx, workloadID)
+}
+
 // GetWorkloadReplacement mocks base method.
 func (m *MockService) GetWorkloadReplacement(ctx context.Context, workloadID string) (*client.WorkloadReplacement, error) {
 	m.ctrl.T.Helper()
@@ -2220,6 +2367,21 @@ func (mr *MockServiceMockRecorder) ListDatastoreCredentials(ctx, id any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDatastoreCredentials", reflect.TypeOf((*MockService)(nil).ListDatastoreCredentials), ctx, id)
 }
 
+// ListDeploymentChallengers mocks base method.
+func (m *MockService) ListDeploymentChallengers(ctx context.Context, deploymentID string) ([]client.DeploymentChallenger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeploymentChallengers", ctx, deploymentID)
+	ret0, _ := ret[0].([]client.DeploymentChallenger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeploymentChallengers indicates an expected call of ListDeploymentChallengers.
+func (mr *MockServiceMockRecorder) ListDeploymentChallengers(ctx, deploymentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeploymentChallengers", reflect.TypeOf((*MockService)(nil).ListDeploymentChallengers), ctx, deploymentID)
+}
+
 // ListDeploymentRuntimeParameters mocks base method.
 func (m *MockService) ListDeploymentRuntimeParameters(ctx context.Context, id string) ([]client.RuntimeParameter, error) {
 	m.ctrl.T.Helper()
@@ -2235,6 +2397,21 @@ func (mr *MockServiceMockRecorder) ListDeploymentRuntimeParameters(ctx, id any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeploymentRuntimeParameters", reflect.TypeOf((*MockService)(nil).ListDeploymentRuntimeParameters), ctx, id)
 }
 
+// ListDeployments mocks base method.
+func (m *MockService) ListDeployments(ctx context.Context, req *client.ListDeploymentsRequest) ([]client.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployments", ctx, req)
+	ret0, _ := ret[0].([]client.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployments indicates an expected call of ListDeployments.
+func (mr *MockServiceMockRecorder) ListDeployments(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployments", reflect.TypeOf((*MockService)(nil).ListDeployments), ctx, req)
+}
+
 // ListExecutionEnvironments mocks base method.
 func (m *MockService) ListExecutionEnvironments(ctx context.Context) ([]client.ExecutionEnvironment, error) {
 	m.ctrl.T.Helper()
@@ -2325,6 +2502,51 @@ func (mr *MockServiceMockRecorder) ListRegisteredModels(ctx, req any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegisteredModels", reflect.TypeOf((*MockService)(nil).ListRegisteredModels), ctx, req)
 }
 
+// ListUseCaseDeployments mocks base method.
+func (m *MockService) ListUseCaseDeployments(ctx context.Context, useCaseID string) ([]client.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUseCaseDeployments", ctx, useCaseID)
+	ret0, _ := ret[0].([]client.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUseCaseDeployments indicates an expected call of ListUseCaseDeployments.
+func (mr *MockServiceMockRecorder) ListUseCaseDeployments(ctx, useCaseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUseCaseDeployments", reflect.TypeOf((*MockService)(nil).ListUseCaseDeployments), ctx, useCaseID)
+}
+
+// ListWorkloadEvents mocks base method.
+func (m *MockService) ListWorkloadEvents(ctx context.Context, workloadID string, req *client.ListWorkloadEventsRequest) ([]client.WorkloadEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloadEvents", ctx, workloadID, req)
+	ret0, _ := ret[0].([]client.WorkloadEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloadEvents indicates an expected call of ListWorkloadEvents.
+func (mr *MockServiceMockRecorder) ListWorkloadEvents(ctx, workloadID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloadEvents", reflect.TypeOf((*MockService)(nil).ListWorkloadEvents), ctx, workloadID, req)
+}
+
+// ListWorkloadLogs mocks base method.
+func (m *MockService) ListWorkloadLogs(ctx context.Context, workloadID string, req *client.ListWorkloadLogsRequest) ([]client.WorkloadLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloadLogs", ctx, workloadID, req)
+	ret0, _ := ret[0].([]client.WorkloadLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloadLogs indicates an expected call of ListWorkloadLogs.
+func (mr *MockServiceMockRecorder) ListWorkloadLogs(ctx, workloadID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloadLogs", reflect.TypeOf((*MockService)(nil).ListWorkloadLogs), ctx, workloadID, req)
+}
+
 // PatchArtifact mocks base method.
 func (m *MockService) PatchArtifact(ctx context.Context, id string, req *client.PatchArtifactRequest) (*client.Artifact, error) {
 	m.ctrl.T.Helper()
@@ -2535,18 +2757,18 @@ func (mr *MockServiceMockRecorder) UpdateCustomJob(ctx, id, req any) *gomock.Cal
 }
 
 // UpdateCustomJobFiles mocks base method.
-func (m *MockService) UpdateCustomJobFiles(ctx context.Context, id string, files []client.FileInfo) (*client.CustomJob, error) {
+func (m *MockService) UpdateCustomJobFiles(ctx context.Context, id string, files []client.FileInfo, filesToDelete []string) (*client.CustomJob, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCustomJobFiles", ctx, id, files)
+	ret := m.ctrl.Call(m, "UpdateCustomJobFiles", ctx, id, files, filesToDelete)
 	ret0, _ := ret[0].(*client.CustomJob)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateCustomJobFiles indicates an expected call of UpdateCustomJobFiles.
-func (mr *MockServiceMockRecorder) UpdateCustomJobFiles(ctx, id, files any) *gomock.Call {
+func (mr *MockServiceMockRecorder) UpdateCustomJobFiles(ctx, id, files, filesToDelete any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomJobFiles", reflect.TypeOf((*MockService)(nil).UpdateCustomJobFiles), ctx, id, files)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomJobFiles", reflect.TypeOf((*MockService)(nil).UpdateCustomJobFiles), ctx, id, files, filesToDelete)
 }
 
 // UpdateCustomJobSchedule mocks base method.
@@ -2669,6 +2891,21 @@ func (mr *MockServiceMockRecorder) UpdateDeployment(ctx, id, req any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeployment", reflect.TypeOf((*MockService)(nil).UpdateDeployment), ctx, id, req)
 }
 
+// UpdateDeploymentChallenger mocks base method.
+func (m *MockService) UpdateDeploymentChallenger(ctx context.Context, deploymentID, id string, req *client.UpdateDeploymentChallengerRequest) (*client.DeploymentChallenger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeploymentChallenger", ctx, deploymentID, id, req)
+	ret0, _ := ret[0].(*client.DeploymentChallenger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDeploymentChallenger indicates an expected call of UpdateDeploymentChallenger.
+func (mr *MockServiceMockRecorder) UpdateDeploymentChallenger(ctx, deploymentID, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeploymentChallenger", reflect.TypeOf((*MockService)(nil).UpdateDeploymentChallenger), ctx, deploymentID, id, req)
+}
+
 // UpdateDeploymentChallengerReplaySettings mocks base method.
 func (m *MockService) UpdateDeploymentChallengerReplaySettings(ctx context.Context, id string, req *client.DeploymentChallengerReplaySettings) (*client.DeploymentChallengerReplaySettings, error) {
 	m.ctrl.T.Helper()
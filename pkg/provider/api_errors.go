@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// addAPIErrorDiagnostic adds err to diags. Each field error of an API validation error
+// is reported on the attribute matching the field, so Terraform points at the offending
+// value in the configuration.
+func addAPIErrorDiagnostic(diags *diag.Diagnostics, summary string, err error) {
+	var validationErr *client.ValidationError
+	if !errors.As(err, &validationErr) || len(validationErr.FieldErrors) == 0 {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	fields := make([]string, 0, len(validationErr.FieldErrors))
+	for field := range validationErr.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		detail := fmt.Sprintf("%s: %s", field, validationErr.FieldErrors[field])
+		if validationErr.Message != "" {
+			detail = fmt.Sprintf("%s\n\n%s", validationErr.Message, detail)
+		}
+		if validationErr.RequestID != "" {
+			detail += fmt.Sprintf("\n\nRequest ID: %s", validationErr.RequestID)
+		}
+		diags.AddAttributeError(apiFieldPath(field), summary, detail)
+	}
+}
+
+// addNameAPIErrorDiagnostic adds err to diags like addAPIErrorDiagnostic, and reports
+// a name that is already in use on the name attribute.
+func addNameAPIErrorDiagnostic(diags *diag.Diagnostics, summary string, err error, name, resourceType string) {
+	if isNameAlreadyExistsError(err) {
+		diags.AddAttributeError(
+			path.Root("name"),
+			summary,
+			fmt.Sprintf("%s name must be unique, and name '%s' is already in use", resourceType, name))
+		return
+	}
+	addAPIErrorDiagnostic(diags, summary, err)
+}
+
+func isNameAlreadyExistsError(err error) bool {
+	message := err.Error()
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) && (apiErr.Message != "" || len(apiErr.FieldErrors) > 0) {
+		message = apiErr.Message + " " + apiErr.FieldErrors["name"]
+	}
+	return strings.Contains(message, "already in use") ||
+		strings.Contains(message, "already exist") ||
+		strings.Contains(message, "is already used")
+}
+
+// apiFieldPath converts the dotted path of a field in an API request body, e.g.
+// "runtimeParameterValues.0.value", to the matching Terraform attribute path.
+func apiFieldPath(field string) path.Path {
+	parts := strings.Split(field, ".")
+	attributePath := path.Root(toSnakeCase(parts[0]))
+	for _, part := range parts[1:] {
+		if index, err := strconv.Atoi(part); err == nil {
+			attributePath = attributePath.AtListIndex(index)
+			continue
+		}
+		attributePath = attributePath.AtName(toSnakeCase(part))
+	}
+	return attributePath
+}
+
+// toSnakeCase converts a camelCase API field name to a snake_case attribute name.
+// Acronyms stay together, e.g. "llmBaseURL" becomes "llm_base_url".
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			startsWord := i > 0 && (unicode.IsLower(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1])))
+			if startsWord {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestApiFieldPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		field    string
+		expected path.Path
+	}{
+		{"name", path.Root("name")},
+		{"baseEnvironmentId", path.Root("base_environment_id")},
+		{"llmBaseURL", path.Root("llm_base_url")},
+		{"runtimeParameterValues.0.value", path.Root("runtime_parameter_values").AtListIndex(0).AtName("value")},
+		{"settings.predictionWarning.enabled", path.Root("settings").AtName("prediction_warning").AtName("enabled")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			if got := apiFieldPath(tt.field); !got.Equal(tt.expected) {
+				t.Errorf("apiFieldPath(%q) = %s, want %s", tt.field, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAddAPIErrorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+	addAPIErrorDiagnostic(&diags, "Error creating Custom Model", &client.ValidationError{APIError: &client.APIError{
+		StatusCode: http.StatusUnprocessableEntity,
+		Message:    "Invalid field data",
+		FieldErrors: map[string]string{
+			"targetName": "targetName is required",
+			"language":   "language is not a valid value",
+		},
+	}})
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("expected 2 errors, got %+v", diags)
+	}
+	for i, expected := range []path.Path{path.Root("language"), path.Root("target_name")} {
+		withPath, ok := diags[i].(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(expected) {
+			t.Errorf("diagnostic %d: expected path %s, got %+v", i, expected, diags[i])
+		}
+	}
+
+	diags = nil
+	addAPIErrorDiagnostic(&diags, "Error creating Custom Model", errors.New("connection refused"))
+	if diags.ErrorsCount() != 1 || diags[0].Detail() != "connection refused" {
+		t.Errorf("expected the error message as detail, got %+v", diags)
+	}
+	if _, ok := diags[0].(diag.DiagnosticWithPath); ok {
+		t.Errorf("expected a diagnostic without attribute path, got %+v", diags[0])
+	}
+}
+
+func TestAddNameAPIErrorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		err          error
+		expectedPath bool
+	}{
+		{
+			name: "conflict message",
+			err: &client.ConflictError{APIError: &client.APIError{
+				StatusCode: http.StatusConflict,
+				Message:    "Credential name is already in use",
+			}},
+			expectedPath: true,
+		},
+		{
+			name: "name field error",
+			err: &client.ValidationError{APIError: &client.APIError{
+				StatusCode:  http.StatusUnprocessableEntity,
+				FieldErrors: map[string]string{"name": "An application with this name already exists"},
+			}},
+			expectedPath: true,
+		},
+		{
+			name:         "plain error",
+			err:          errors.New("registered model name is already used"),
+			expectedPath: true,
+		},
+		{
+			name: "unrelated error",
+			err: &client.APIError{
+				StatusCode: http.StatusInternalServerError,
+				Body:       "internal server error",
+			},
+			expectedPath: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addNameAPIErrorDiagnostic(&diags, "Error creating Credential", tt.err, "example", "Credential")
+			if diags.ErrorsCount() != 1 {
+				t.Fatalf("expected 1 error, got %+v", diags)
+			}
+			withPath, ok := diags[0].(diag.DiagnosticWithPath)
+			if got := ok && withPath.Path().Equal(path.Root("name")); got != tt.expectedPath {
+				t.Errorf("expected name attribute error %v, got %+v", tt.expectedPath, diags[0])
+			}
+			if tt.expectedPath && diags[0].Detail() != "Credential name must be unique, and name 'example' is already in use" {
+				t.Errorf("unexpected detail %q", diags[0].Detail())
+			}
+		})
+	}
+}
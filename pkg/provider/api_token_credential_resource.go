@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ApiTokenCredentialResource{}
 var _ resource.ResourceWithImportState = &ApiTokenCredentialResource{}
+var _ resource.ResourceWithConfigValidators = &ApiTokenCredentialResource{}
 
 func NewApiTokenCredentialResource() resource.Resource {
 	return &ApiTokenCredentialResource{}
@@ -53,13 +55,18 @@ func (r *ApiTokenCredentialResource) Schema(ctx context.Context, req resource.Sc
 				Optional:            true,
 			},
 			"api_token": schema.StringAttribute{
-				MarkdownDescription: "The description of the Api Token Credential.",
+				MarkdownDescription: "The API token of the Api Token Credential. Exactly one of `api_token` or `api_token_wo` must be set.",
 				Sensitive:           true,
-				Required:            true,
+				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"api_token_wo":         writeOnlyAttribute("api_token"),
+			"api_token_wo_version": writeOnlyVersionAttribute("api_token", true),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -87,16 +94,17 @@ func (r *ApiTokenCredentialResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateApiTokenCredential")
 	createResp, err := r.provider.service.CreateCredential(ctx, &client.CredentialRequest{
 		Name:           data.Name.ValueString(),
 		Description:    data.Description.ValueString(),
 		CredentialType: client.CredentialTypeApiToken,
-		ApiToken:       data.ApiToken.ValueString(),
+		ApiToken:       secretValue(data.ApiToken, writeOnlyValue(ctx, req.Config, "api_token_wo", &resp.Diagnostics)),
 	})
 	if err != nil {
-		errMessage := checkCredentialNameAlreadyExists(err, data.Name.ValueString())
-		resp.Diagnostics.AddError("Error creating Api Token Credential", errMessage)
+		addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Api Token Credential", err, data.Name.ValueString(), "Credential")
 		return
 	}
 
@@ -112,6 +120,8 @@ func (r *ApiTokenCredentialResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -148,6 +158,8 @@ func (r *ApiTokenCredentialResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("UpdateApiTokenCredential")
 	_, err := r.provider.service.UpdateCredential(ctx,
 		data.ID.ValueString(),
@@ -162,8 +174,7 @@ func (r *ApiTokenCredentialResource) Update(ctx context.Context, req resource.Up
 				fmt.Sprintf("Api Token Credential with ID %s is not found. Removing from state.", data.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			errMessage := checkCredentialNameAlreadyExists(err, data.Name.ValueString())
-			resp.Diagnostics.AddError("Error updating Api Token Credential", errMessage)
+			addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Api Token Credential", err, data.Name.ValueString(), "Credential")
 		}
 		return
 	}
@@ -179,6 +190,8 @@ func (r *ApiTokenCredentialResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteApiTokenCredential")
 	err := r.provider.service.DeleteCredential(ctx, data.ID.ValueString())
 	if err != nil {
@@ -192,3 +205,12 @@ func (r *ApiTokenCredentialResource) Delete(ctx context.Context, req resource.De
 func (r *ApiTokenCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r ApiTokenCredentialResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("api_token"),
+			path.MatchRoot("api_token_wo"),
+		),
+	}
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultApiTokenExpiresIn = time.Hour
+	apiTokenPrivateStateKey  = "api_key_id"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ApiTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ApiTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ApiTokenEphemeralResource{}
+
+func NewApiTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ApiTokenEphemeralResource{}
+}
+
+// ApiTokenEphemeralResource defines the ephemeral resource implementation.
+type ApiTokenEphemeralResource struct {
+	provider *Provider
+}
+
+func (r *ApiTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *ApiTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates a short-lived DataRobot API key that is never stored in the Terraform state. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the API key.",
+				Required:            true,
+			},
+			"expires_in": schema.StringAttribute{
+				MarkdownDescription: "How long the API key stays valid, as a Go duration string (e.g. `30m`, `2h`). Defaults to `1h`.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationStringValidator{},
+				},
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "The scope level of the API key: `viewer` (GET requests only), `user` (GET, POST, PUT and PATCH requests) or `admin` (no restriction). Defaults to the DataRobot default for API keys.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(client.ViewerLevel), string(client.UserLevel), string(client.AdminLevel)),
+				},
+			},
+			"revoke_on_close": schema.BoolAttribute{
+				MarkdownDescription: "Whether to delete the API key when Terraform closes the ephemeral resource at the end of the run. Defaults to `true`, because the key is created again on every plan and apply. Set it to `false` when the key is handed to a system that outlives the run, so it expires on its own instead.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the API key.",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The API key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expire_at": schema.StringAttribute{
+				MarkdownDescription: "The expiration time of the API key.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ApiTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if r.provider, ok = req.ProviderData.(*Provider); !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected  %T, got: %T. Please report this issue to the provider developers.", Provider{}, req.ProviderData),
+		)
+	}
+}
+
+func (r *ApiTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ApiTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresIn := defaultApiTokenExpiresIn
+	if IsKnown(data.ExpiresIn) {
+		// the value was already validated by durationStringValidator
+		expiresIn, _ = time.ParseDuration(data.ExpiresIn.ValueString())
+	}
+
+	traceAPICall("CreateAPIKey")
+	apiKey, err := r.provider.service.CreateAPIKey(ctx, &client.CreateAPIKeyRequest{
+		Name:     data.Name.ValueString(),
+		ExpireAt: time.Now().UTC().Add(expiresIn).Format(time.RFC3339),
+		Scope:    client.ScopeLevel(data.Scope.ValueString()),
+	})
+	if err != nil {
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating API key", err)
+		return
+	}
+
+	data.ID = types.StringValue(apiKey.ID)
+	data.Token = types.StringValue(apiKey.Key)
+	data.ExpireAt = types.StringValue(apiKey.ExpireAt)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+
+	// an unset revoke_on_close revokes the key, ephemeral resources have no defaults
+	if data.RevokeOnClose.IsNull() || data.RevokeOnClose.ValueBool() {
+		apiKeyID, err := json.Marshal(apiKey.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error storing API key ID", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiTokenPrivateStateKey, apiKeyID)...)
+	}
+}
+
+func (r *ApiTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	apiKeyIDBytes, diags := req.Private.GetKey(ctx, apiTokenPrivateStateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || apiKeyIDBytes == nil {
+		return
+	}
+
+	var apiKeyID string
+	if err := json.Unmarshal(apiKeyIDBytes, &apiKeyID); err != nil {
+		resp.Diagnostics.AddError("Error reading API key ID", err.Error())
+		return
+	}
+
+	traceAPICall("DeleteAPIKey")
+	if err := r.provider.service.DeleteAPIKey(ctx, apiKeyID); err != nil {
+		if !errors.Is(err, &client.NotFoundError{}) {
+			resp.Diagnostics.AddError("Error revoking API key", err.Error())
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +17,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AppOAuthResource{}
 var _ resource.ResourceWithImportState = &AppOAuthResource{}
+var _ resource.ResourceWithConfigValidators = &AppOAuthResource{}
 
 func NewAppOAuthResource() resource.Resource {
 	return &AppOAuthResource{}
@@ -29,7 +31,7 @@ func (r *AppOAuthResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_app_oauth"
 }
 
-func (r *AppOAuthResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *AppOAuthResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Resource for managing OAuth providers in DataRobot. This resource allows you to create, read, update, and delete OAuth provider configurations.",
 
@@ -60,10 +62,12 @@ func (r *AppOAuthResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"client_secret": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "OAuth client secret.",
+				Description: "OAuth client secret. Exactly one of `client_secret` or `client_secret_wo` must be set.",
 			},
+			"client_secret_wo":         writeOnlyAttribute("client_secret"),
+			"client_secret_wo_version": writeOnlyVersionAttribute("client_secret", false),
 			"secure_config_id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Secure config ID for the OAuth provider.",
@@ -73,6 +77,9 @@ func (r *AppOAuthResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "Status of the OAuth provider.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -98,11 +105,13 @@ func (r *AppOAuthResource) Create(ctx context.Context, req resource.CreateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
 	request := &client.CreateAppOAuthProviderRequest{
 		Name:         data.Name.ValueString(),
 		Type:         data.Type.ValueString(),
 		ClientID:     data.ClientID.ValueString(),
-		ClientSecret: data.ClientSecret.ValueString(),
+		ClientSecret: secretValue(data.ClientSecret, writeOnlyValue(ctx, req.Config, "client_secret_wo", &resp.Diagnostics)),
 	}
 
 	traceAPICall("CreateAppOAuthProvider")
@@ -130,6 +139,8 @@ func (r *AppOAuthResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -167,6 +178,8 @@ func (r *AppOAuthResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state AppOAuthResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -190,6 +203,9 @@ func (r *AppOAuthResource) Update(ctx context.Context, req resource.UpdateReques
 	if plan.ClientSecret != state.ClientSecret && !plan.ClientSecret.IsNull() {
 		request.ClientSecret = plan.ClientSecret.ValueString()
 	}
+	if plan.ClientSecretWOVersion != state.ClientSecretWOVersion {
+		request.ClientSecret = writeOnlyValue(ctx, req.Config, "client_secret_wo", &resp.Diagnostics).ValueString()
+	}
 
 	if request.Name == "" && request.ClientSecret == "" {
 		resp.Diagnostics.AddWarning(
@@ -226,6 +242,8 @@ func (r *AppOAuthResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -244,3 +262,12 @@ func (r *AppOAuthResource) Delete(ctx context.Context, req resource.DeleteReques
 func (r *AppOAuthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r AppOAuthResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("client_secret"),
+			path.MatchRoot("client_secret_wo"),
+		),
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &AppOAuthTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AppOAuthTokenEphemeralResource{}
+
+func NewAppOAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AppOAuthTokenEphemeralResource{}
+}
+
+// AppOAuthTokenEphemeralResource defines the ephemeral resource implementation.
+type AppOAuthTokenEphemeralResource struct {
+	provider *Provider
+}
+
+func (r *AppOAuthTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_oauth_token"
+}
+
+func (r *AppOAuthTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Exchanges a configured OAuth provider for an access token that is never stored in the Terraform state. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"provider_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the OAuth provider, e.g. `datarobot_app_oauth.example.id`.",
+				Required:            true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "The OAuth access token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"token_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the access token, e.g. `Bearer`.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The expiration time of the access token.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AppOAuthTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if r.provider, ok = req.ProviderData.(*Provider); !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected  %T, got: %T. Please report this issue to the provider developers.", Provider{}, req.ProviderData),
+		)
+	}
+}
+
+func (r *AppOAuthTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AppOAuthTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	traceAPICall("GetAppOAuthProviderToken")
+	token, err := r.provider.service.GetAppOAuthProviderToken(ctx, data.ProviderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting OAuth access token",
+			fmt.Sprintf("Could not get an access token for OAuth provider with ID %s: %s", data.ProviderID.ValueString(), err),
+		)
+		return
+	}
+
+	data.AccessToken = types.StringValue(token.AccessToken)
+	data.TokenType = types.StringValue(token.TokenType)
+	data.ExpiresAt = types.StringValue(token.ExpiresAt)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+
+	// Terraform opens the ephemeral resource again once the token expires during a long run.
+	if expiresAt, err := time.Parse(time.RFC3339, token.ExpiresAt); err == nil {
+		resp.RenewAt = expiresAt
+	}
+}
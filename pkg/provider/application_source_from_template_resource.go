@@ -94,6 +94,12 @@ func (r *ApplicationSourceFromTemplateResource) Schema(ctx context.Context, req
 				MarkdownDescription: "The hash of file contents for each file in files.",
 				ElementType:         types.StringType,
 			},
+			"file_manifest": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 hash of each file uploaded from `folder_path` and `files`, by its path in the Application Source, as of the last apply.",
+				ElementType:         types.StringType,
+			},
+			"pending_changes": pendingChangesAttribute("`folder_path` and `files`"),
 			"resources": schema.SingleNestedAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -148,6 +154,9 @@ func (r *ApplicationSourceFromTemplateResource) Schema(ctx context.Context, req
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -175,12 +184,14 @@ func (r *ApplicationSourceFromTemplateResource) Create(ctx context.Context, req
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateApplicationSourceFromTemplate")
 	createApplicationSourceFromTemplateResp, err := r.provider.service.CreateApplicationSourceFromTemplate(ctx, &client.CreateApplicationSourceFromTemplateRequest{
 		CustomTemplateID: data.TemplateID.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Application source", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Application source", err)
 		return
 	}
 	data.ID = types.StringValue(createApplicationSourceFromTemplateResp.ID)
@@ -193,7 +204,7 @@ func (r *ApplicationSourceFromTemplateResource) Create(ctx context.Context, req
 				Name: data.Name.ValueString(),
 			})
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Application source", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Application source", err)
 			return
 		}
 	} else {
@@ -223,7 +234,7 @@ func (r *ApplicationSourceFromTemplateResource) Create(ctx context.Context, req
 		createApplicationSourceFromTemplateResp.LatestVersion.ID,
 		updateApplicationSourceVersionRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Application source version", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Application source version", err)
 		return
 	}
 	data.VersionID = types.StringValue(createApplicationSourceFromTemplateVersionResp.ID)
@@ -239,7 +250,13 @@ func (r *ApplicationSourceFromTemplateResource) Create(ctx context.Context, req
 		data.Files,
 		make([]string, 0))
 	if err != nil {
-		resp.Diagnostics.AddError("Error adding files to Application Source", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error adding files to Application Source", err)
+		return
+	}
+
+	data.FileManifest, err = localFilesManifestValue(ctx, r.provider.fileHashCache(), data.FolderPath, data.Files)
+	if err != nil {
+		resp.Diagnostics.AddError("Error calculating file manifest", err.Error())
 		return
 	}
 
@@ -266,7 +283,7 @@ func (r *ApplicationSourceFromTemplateResource) Create(ctx context.Context, req
 		}
 		jsonParams, err := json.Marshal(params)
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating runtime parameter values", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating runtime parameter values", err)
 			return
 		}
 
@@ -278,7 +295,7 @@ func (r *ApplicationSourceFromTemplateResource) Create(ctx context.Context, req
 				RuntimeParameterValues: string(jsonParams),
 			})
 		if err != nil {
-			resp.Diagnostics.AddError("Error adding runtime parameter values to Application Source version", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error adding runtime parameter values to Application Source version", err)
 			return
 		}
 	}
@@ -313,6 +330,8 @@ func (r *ApplicationSourceFromTemplateResource) Read(ctx context.Context, req re
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -348,6 +367,7 @@ func (r *ApplicationSourceFromTemplateResource) Read(ctx context.Context, req re
 		return
 	}
 
+	data.PendingChanges = types.ObjectNull(pendingChangesAttrTypes)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -360,6 +380,8 @@ func (r *ApplicationSourceFromTemplateResource) Update(ctx context.Context, req
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state ApplicationSourceFromTemplateResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -381,7 +403,7 @@ func (r *ApplicationSourceFromTemplateResource) Update(ctx context.Context, req
 					fmt.Sprintf("Application Source with ID %s is not found. Removing from state.", plan.ID.ValueString()))
 				resp.State.RemoveResource(ctx)
 			} else {
-				resp.Diagnostics.AddError("Error updating Application Source", err.Error())
+				addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Application Source", err)
 			}
 			return
 		}
@@ -411,7 +433,7 @@ func (r *ApplicationSourceFromTemplateResource) Update(ctx context.Context, req
 	traceAPICall("CreateApplicationSourceVersion")
 	createApplicationSourceFromTemplateVersionResp, err := r.provider.service.CreateApplicationSourceVersion(ctx, plan.ID.ValueString(), createVersionRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Application Source version", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Application Source version", err)
 		return
 	}
 	applicationSourceFromTemplateVersion := *createApplicationSourceFromTemplateVersionResp
@@ -422,7 +444,13 @@ func (r *ApplicationSourceFromTemplateResource) Update(ctx context.Context, req
 		plan.FolderPathHash != state.FolderPathHash {
 		err = r.updateLocalFiles(ctx, state, plan, applicationSourceFromTemplateVersion)
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Application Source files", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Application Source files", err)
+			return
+		}
+
+		plan.FileManifest, err = localFilesManifestValue(ctx, r.provider.fileHashCache(), plan.FolderPath, plan.Files)
+		if err != nil {
+			resp.Diagnostics.AddError("Error calculating file manifest", err.Error())
 			return
 		}
 	}
@@ -491,7 +519,7 @@ func (r *ApplicationSourceFromTemplateResource) Update(ctx context.Context, req
 
 	jsonParams, err := json.Marshal(params)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating runtime parameters", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating runtime parameters", err)
 		return
 	}
 	updateVersionRequest.RuntimeParameterValues = string(jsonParams)
@@ -502,7 +530,7 @@ func (r *ApplicationSourceFromTemplateResource) Update(ctx context.Context, req
 		applicationSourceFromTemplateVersion.ID,
 		updateVersionRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Application Source version", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Application Source version", err)
 		return
 	}
 
@@ -538,6 +566,8 @@ func (r *ApplicationSourceFromTemplateResource) Delete(ctx context.Context, req
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteApplicationSource")
 	err := r.provider.service.DeleteApplicationSource(ctx, data.ID.ValueString())
 	if err != nil {
@@ -573,7 +603,7 @@ func (r ApplicationSourceFromTemplateResource) ModifyPlan(ctx context.Context, r
 	}
 	plan.FilesHashes = filesHashes
 
-	folderPathHash, err := computeFolderHash(plan.FolderPath)
+	folderPathHash, err := computeFolderHash(ctx, plan.FolderPath, r.provider.fileHashCache())
 	if err != nil {
 		resp.Diagnostics.AddError("Error calculating folder path hash", err.Error())
 		return
@@ -583,6 +613,8 @@ func (r ApplicationSourceFromTemplateResource) ModifyPlan(ctx context.Context, r
 
 	if req.State.Raw.IsNull() {
 		// resource is being created
+		plan.PendingChanges = previewLocalFileChanges(ctx, &resp.Diagnostics, r.provider.fileHashCache(), plan.FolderPath, plan.Files, types.MapNull(types.StringType))
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 		return
 	}
 
@@ -613,6 +645,20 @@ func (r ApplicationSourceFromTemplateResource) ModifyPlan(ctx context.Context, r
 		plan.VersionID = types.StringUnknown()
 	}
 
+	// the manifest of changed local files is only known after they are uploaded
+	plan.FileManifest = state.FileManifest
+	plan.PendingChanges = state.PendingChanges
+	if !reflect.DeepEqual(plan.Files, state.Files) ||
+		!reflect.DeepEqual(plan.FilesHashes, state.FilesHashes) ||
+		plan.FolderPath != state.FolderPath ||
+		plan.FolderPathHash != state.FolderPathHash {
+		plan.FileManifest = types.MapUnknown(types.StringType)
+		plan.PendingChanges = previewLocalFileChanges(ctx, &resp.Diagnostics, r.provider.fileHashCache(), plan.FolderPath, plan.Files, state.FileManifest)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if !IsKnown(plan.RuntimeParameterValues) {
 		// use empty list if runtime parameter values are unknown
 		plan.RuntimeParameterValues, _ = types.ListValueFrom(
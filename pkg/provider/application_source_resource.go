@@ -86,6 +86,12 @@ func (r *ApplicationSourceResource) Schema(ctx context.Context, req resource.Sch
 				MarkdownDescription: "The hash of file contents for each file in files.",
 				ElementType:         types.StringType,
 			},
+			"file_manifest": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 hash of each file uploaded from `folder_path` and `files`, by its path in the Application Source. Only the files that changed since the last apply are uploaded.",
+				ElementType:         types.StringType,
+			},
+			"pending_changes": pendingChangesAttribute("`folder_path` and `files`"),
 			"resources": schema.SingleNestedAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -149,6 +155,9 @@ func (r *ApplicationSourceResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -176,6 +185,8 @@ func (r *ApplicationSourceResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	createReq := &client.CreateApplicationSourceRequest{}
 	if IsKnown(data.Name) {
 		createReq.Name = data.Name.ValueString()
@@ -184,7 +195,7 @@ func (r *ApplicationSourceResource) Create(ctx context.Context, req resource.Cre
 	traceAPICall("CreateApplicationSource")
 	createApplicationSourceResp, err := r.provider.service.CreateApplicationSource(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Application source", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Application source", err)
 		return
 	}
 	data.ID = types.StringValue(createApplicationSourceResp.ID)
@@ -218,7 +229,7 @@ func (r *ApplicationSourceResource) Create(ctx context.Context, req resource.Cre
 		createApplicationSourceResp.ID,
 		createApplicationSourceVersionRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Application source version", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Application source version", err)
 		return
 	}
 	data.VersionID = types.StringValue(createApplicationSourceVersionResp.ID)
@@ -226,14 +237,20 @@ func (r *ApplicationSourceResource) Create(ctx context.Context, req resource.Cre
 	data.BaseEnvironmentVersionID = types.StringValue(createApplicationSourceVersionResp.BaseEnvironmentVersionID)
 	data.RequiredKeyScopeLevel = scopeLevelToTerraformString(createApplicationSourceVersionResp.RequiredKeyScopeLevel)
 
+	filesSync, err := planLocalFilesSync(ctx, r.provider.fileHashCache(), data.FolderPath, data.Files, types.MapNull(types.StringType))
+	if err != nil {
+		resp.Diagnostics.AddError("Error preparing local files", err.Error())
+		return
+	}
+	data.FileManifest = filesSync.Manifest
+
 	err = r.addLocalFilesToApplicationSource(
 		ctx,
 		createApplicationSourceResp.ID,
 		createApplicationSourceVersionResp.ID,
-		data.FolderPath,
-		data.Files)
+		filesSync.Uploads)
 	if err != nil {
-		resp.Diagnostics.AddError("Error adding files to Application Source", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error adding files to Application Source", err)
 		return
 	}
 
@@ -284,6 +301,8 @@ func (r *ApplicationSourceResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -320,6 +339,7 @@ func (r *ApplicationSourceResource) Read(ctx context.Context, req resource.ReadR
 	}
 	data.RequiredKeyScopeLevel = scopeLevelToTerraformString(applicationSource.LatestVersion.RequiredKeyScopeLevel)
 
+	data.PendingChanges = types.ObjectNull(pendingChangesAttrTypes)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -344,7 +364,7 @@ func (r *ApplicationSourceResource) updateApplicationSourceNameIfChanged(
 				fmt.Sprintf("Application Source with ID %s is not found. Removing from state.", plan.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error updating Application Source", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Application Source", err)
 		}
 		return false
 	}
@@ -360,6 +380,8 @@ func (r *ApplicationSourceResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state ApplicationSourceResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -395,7 +417,7 @@ func (r *ApplicationSourceResource) Update(ctx context.Context, req resource.Upd
 	traceAPICall("CreateApplicationSourceVersion")
 	createApplicationSourceVersionResp, err := r.provider.service.CreateApplicationSourceVersion(ctx, plan.ID.ValueString(), createVersionRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Application Source version", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Application Source version", err)
 		return
 	}
 	applicationSourceVersion := *createApplicationSourceVersionResp
@@ -405,9 +427,9 @@ func (r *ApplicationSourceResource) Update(ctx context.Context, req resource.Upd
 		!reflect.DeepEqual(plan.FilesHashes, state.FilesHashes) ||
 		plan.FolderPath != state.FolderPath ||
 		plan.FolderPathHash != state.FolderPathHash {
-		err = r.updateLocalFiles(ctx, state, plan, applicationSourceVersion)
+		plan.FileManifest, err = r.updateLocalFiles(ctx, state, plan, applicationSourceVersion)
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Application Source files", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Application Source files", err)
 			return
 		}
 		localFilesUpdated = true
@@ -439,7 +461,7 @@ func (r *ApplicationSourceResource) Update(ctx context.Context, req resource.Upd
 		applicationSourceVersion.ID,
 		updateVersionRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Application Source version", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Application Source version", err)
 		return
 	}
 
@@ -452,7 +474,7 @@ func (r *ApplicationSourceResource) Update(ctx context.Context, req resource.Upd
 		plan,
 		localFilesUpdated,
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating runtime parameters for Application Source", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating runtime parameters for Application Source", err)
 		return
 	}
 
@@ -489,6 +511,8 @@ func (r *ApplicationSourceResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteApplicationSource")
 	err := r.provider.service.DeleteApplicationSource(ctx, data.ID.ValueString())
 	if err != nil {
@@ -523,7 +547,7 @@ func (r ApplicationSourceResource) ModifyPlan(ctx context.Context, req resource.
 	}
 	plan.FilesHashes = filesHashes
 
-	folderPathHash, err := computeFolderHash(plan.FolderPath)
+	folderPathHash, err := computeFolderHash(ctx, plan.FolderPath, r.provider.fileHashCache())
 	if err != nil {
 		resp.Diagnostics.AddError("Error calculating folder path hash", err.Error())
 		return
@@ -537,6 +561,7 @@ func (r ApplicationSourceResource) ModifyPlan(ctx context.Context, req resource.
 
 	if req.State.Raw.IsNull() {
 		// resource is being created
+		plan.PendingChanges = previewLocalFileChanges(ctx, &resp.Diagnostics, r.provider.fileHashCache(), plan.FolderPath, plan.Files, types.MapNull(types.StringType))
 		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 		return
 	}
@@ -565,6 +590,19 @@ func (r ApplicationSourceResource) ModifyPlan(ctx context.Context, req resource.
 		plan.VersionID = types.StringUnknown()
 	}
 
+	// the manifest of changed local files is only known after they are uploaded
+	plan.FileManifest = state.FileManifest
+	plan.PendingChanges = state.PendingChanges
+	if !reflect.DeepEqual(plan.Files, state.Files) ||
+		!reflect.DeepEqual(plan.FilesHashes, state.FilesHashes) ||
+		plan.FolderPathHash != state.FolderPathHash {
+		plan.FileManifest = types.MapUnknown(types.StringType)
+		plan.PendingChanges = previewLocalFileChanges(ctx, &resp.Diagnostics, r.provider.fileHashCache(), plan.FolderPath, plan.Files, state.FileManifest)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if plan.RequiredKeyScopeLevel.IsUnknown() {
 		plan.RequiredKeyScopeLevel = state.RequiredKeyScopeLevel
 	}
@@ -641,16 +679,10 @@ func (r *ApplicationSourceResource) addLocalFilesToApplicationSource(
 	ctx context.Context,
 	id string,
 	versionId string,
-	folderPath types.String,
-	files types.Dynamic,
+	localFiles []client.FileInfo,
 ) (
 	err error,
 ) {
-	localFiles, err := prepareLocalFiles(folderPath, files)
-	if err != nil {
-		return
-	}
-
 	tflog.Debug(ctx, "Uploading files in batches", map[string]interface{}{
 		"total_files": len(localFiles),
 		"batch_size":  100,
@@ -681,20 +713,24 @@ func (r *ApplicationSourceResource) addLocalFilesToApplicationSource(
 	return
 }
 
+// updateLocalFiles replaces the modified and removed files in the new version and uploads
+// the added and modified ones. It returns the manifest to store in state.
 func (r *ApplicationSourceResource) updateLocalFiles(
 	ctx context.Context,
 	state ApplicationSourceResourceModel,
 	plan ApplicationSourceResourceModel,
 	applicationSourceVersion client.ApplicationSourceVersion,
 ) (
+	manifest types.Map,
 	err error,
 ) {
-	filesToDelete := make([]string, 0)
-	for _, item := range applicationSourceVersion.Items {
-		if item.FileSource == "local" {
-			filesToDelete = append(filesToDelete, item.ID)
-		}
+	filesSync, err := planLocalFilesSync(ctx, r.provider.fileHashCache(), plan.FolderPath, plan.Files, state.FileManifest)
+	if err != nil {
+		return
 	}
+	manifest = filesSync.Manifest
+
+	filesToDelete := filesSync.replacedItemIDs(applicationSourceVersion.Items)
 
 	if len(filesToDelete) > 0 {
 		tflog.Debug(ctx, "Deleting files in batches", map[string]interface{}{
@@ -727,7 +763,7 @@ func (r *ApplicationSourceResource) updateLocalFiles(
 					RequiredKeyScopeLevel: client.ScopeLevel(plan.RequiredKeyScopeLevel.ValueString()), // not overriding RequiredKeyScopeLevel with None
 				})
 			if err != nil {
-				return fmt.Errorf("failed to delete file batch %d-%d: %w", i+1, end, err)
+				return manifest, fmt.Errorf("failed to delete file batch %d-%d: %w", i+1, end, err)
 			}
 		}
 	}
@@ -736,8 +772,7 @@ func (r *ApplicationSourceResource) updateLocalFiles(
 		ctx,
 		state.ID.ValueString(),
 		applicationSourceVersion.ID,
-		plan.FolderPath,
-		plan.Files,
+		filesSync.Uploads,
 	)
 
 	return
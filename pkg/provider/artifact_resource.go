@@ -8,6 +8,7 @@ import (
 
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -15,10 +16,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -177,11 +180,35 @@ func (r *ArtifactResource) Schema(ctx context.Context, req resource.SchemaReques
 					},
 					"dir_hash": schema.StringAttribute{
 						Computed:            true,
-						MarkdownDescription: "SHA-256 fingerprint of `dir` contents, used to detect changes and skip re-upload when unchanged.",
+						MarkdownDescription: "SHA-256 fingerprint of the `dir` contents not excluded by `ignore_file` or `ignore_patterns`, used to detect changes and skip re-upload when unchanged.",
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
 					},
+					"file_manifest": schema.MapAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "SHA-256 hash of each file uploaded from `dir`, keyed by its path in the catalog, as of the last upload. Compared with the catalog to detect remote drift.",
+						PlanModifiers: []planmodifier.Map{
+							mapplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"pending_changes": pendingChangesAttribute("`dir`"),
+					"ignore_patterns": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Paths under `dir` to exclude from the upload, in `.gitignore` syntax (for example `node_modules/`, `*.pyc`, `!keep.pyc`). Applied after the patterns of `ignore_file`.",
+					},
+					"ignore_file": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Path to a file of ignore patterns in `.gitignore` syntax, such as `.drignore` or `.gitignore`. Relative paths are resolved against `dir`.",
+					},
+					"detect_remote_drift": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "When `true` (default), refresh lists the files of the recorded `catalog_version_id` and compares them with `file_manifest`. Files added, removed or modified outside Terraform mark the source as changed, so the next apply uploads `dir` again. Set to `false` to skip the listing for very large catalogs.",
+						Default:             booldefault.StaticBool(true),
+					},
 					"wait_for_build": schema.BoolAttribute{
 						Optional:            true,
 						Computed:            true,
@@ -191,9 +218,25 @@ func (r *ArtifactResource) Schema(ctx context.Context, req resource.SchemaReques
 							boolplanmodifier.UseStateForUnknown(),
 						},
 					},
+					"retain_versions": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Number of most recent catalog versions to keep. After each apply, older versions of the catalog are deleted, except versions still referenced by the `code_ref` of any artifact. When omitted, no versions are deleted.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"retain_versions_dry_run": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "When `true`, the catalog versions that `retain_versions` would delete are listed in a warning instead of being deleted. Defaults to `false`.",
+						Default:             booldefault.StaticBool(false),
+					},
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -219,6 +262,8 @@ func (r *ArtifactResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	createReq := artifactCreateRequest(data)
 	targetLocked := createReq.Status == client.ArtifactStatusLocked
 	if artifactSourceConfigured(&data) && targetLocked {
@@ -228,7 +273,7 @@ func (r *ArtifactResource) Create(ctx context.Context, req resource.CreateReques
 	traceAPICall("CreateArtifact")
 	artifact, err := r.provider.service.CreateArtifact(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Artifact", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Artifact", err)
 		return
 	}
 
@@ -257,7 +302,8 @@ func (r *ArtifactResource) Create(ctx context.Context, req resource.CreateReques
 
 	data.ID = types.StringValue(uuid.NewString())
 	loadArtifactIntoModel(artifact, &data)
-	refreshArtifactSourceDirHash(&data)
+	refreshArtifactSourceDirHash(ctx, &data, r.provider.fileHashCache())
+	r.pruneArtifactSourceVersions(ctx, &data, nil, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -269,6 +315,8 @@ func (r *ArtifactResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ArtifactID.IsNull() || data.ArtifactID.IsUnknown() {
 		return
 	}
@@ -290,7 +338,22 @@ func (r *ArtifactResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	loadArtifactIntoModel(artifact, &data)
-	refreshArtifactSourceDirHash(&data)
+	refreshArtifactSourceDirHash(ctx, &data, r.provider.fileHashCache())
+	if data.Source != nil {
+		data.Source.PendingChanges = types.ObjectNull(pendingChangesAttrTypes)
+	}
+
+	drifted, err := r.detectArtifactSourceDrift(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to check artifact source for remote drift", err.Error())
+	} else if len(drifted) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Artifact source changed outside Terraform",
+			fmt.Sprintf("%d file(s) in the catalog differ from the last upload of source.dir: %s. "+
+				"The next apply uploads the source again; set source.detect_remote_drift = false to skip this check.",
+				len(drifted), artifactSourceDriftSummary(drifted)))
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -303,6 +366,8 @@ func (r *ArtifactResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	// artifact_repository_id is Optional+Computed: when the user doesn't set it in config,
 	// the plan value is null (UseStateForUnknown only applies to unknown, not null).
 	// Preserve the computed value from state so subsequent versions are created in the same repo.
@@ -326,7 +391,7 @@ func (r *ArtifactResource) Update(ctx context.Context, req resource.UpdateReques
 			patchRequestFromPlan(plan, state, deferLock),
 		)
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Artifact", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Artifact", err)
 			return
 		}
 	case lockedSourceCloneNeeded:
@@ -335,14 +400,14 @@ func (r *ArtifactResource) Update(ctx context.Context, req resource.UpdateReques
 		traceAPICall("CreateUpdatedArtifact")
 		artifact, err = r.provider.service.CreateArtifact(ctx, createReq)
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating draft Artifact for source update", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating draft Artifact for source update", err)
 			return
 		}
 	default:
 		traceAPICall("CreateUpdatedArtifact")
 		artifact, err = r.provider.service.CreateArtifact(ctx, artifactCreateRequest(plan))
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating new Artifact version", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating new Artifact version", err)
 			return
 		}
 	}
@@ -376,7 +441,8 @@ func (r *ArtifactResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	loadArtifactIntoModel(artifact, &plan)
-	refreshArtifactSourceDirHash(&plan)
+	refreshArtifactSourceDirHash(ctx, &plan, r.provider.fileHashCache())
+	r.pruneArtifactSourceVersions(ctx, &plan, &state, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -392,6 +458,7 @@ func persistPartialArtifactUpdate(
 	loadArtifactIntoModel(artifact, plan)
 	if plan.Source != nil && state.Source != nil {
 		plan.Source.DirHash = state.Source.DirHash
+		plan.Source.FileManifest = state.Source.FileManifest
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -404,6 +471,8 @@ func (r *ArtifactResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ArtifactRepositoryID.IsNull() || data.ArtifactRepositoryID.IsUnknown() {
 		return
 	}
@@ -431,7 +500,7 @@ func (r *ArtifactResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 	}
 
 	if plan.Source != nil && IsKnown(plan.Source.Dir) {
-		dirHash, err := computeFolderHash(plan.Source.Dir)
+		dirHash, err := artifactSourceDirHash(ctx, plan.Source, r.provider.fileHashCache())
 		if err != nil {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("source").AtName("dir"),
@@ -462,6 +531,33 @@ func (r *ArtifactResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 			plan.ArtifactID = types.StringUnknown()
 			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("artifact_id"), types.StringUnknown())...)
 		}
+
+		priorArtifactID := state.ArtifactID.ValueString()
+		if artifactSourceConfigured(&plan) &&
+			(plan.ArtifactID.IsUnknown() || artifactSourceNeedsUpload(&plan, &state, priorArtifactID, priorArtifactID)) {
+			plan.Source.FileManifest = types.MapUnknown(types.StringType)
+		}
+	}
+
+	if plan.Source != nil {
+		switch {
+		case !artifactSourceConfigured(&plan):
+			plan.Source.PendingChanges = types.ObjectNull(pendingChangesAttrTypes)
+		case plan.Source.FileManifest.IsUnknown():
+			pendingChanges, changes, err := artifactSourcePendingChanges(ctx, r.provider.fileHashCache(), &plan, statePtr)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("source").AtName("dir"),
+					"Error previewing source changes",
+					err.Error(),
+				)
+				return
+			}
+			plan.Source.PendingChanges = pendingChanges
+			addPendingChangesWarning(&resp.Diagnostics, "source.dir", changes)
+		case statePtr != nil && state.Source != nil:
+			plan.Source.PendingChanges = state.Source.PendingChanges
+		}
 	}
 
 	applySourceManagedCodeRefsToPlan(&plan, statePtr, isCreate)
@@ -9,7 +9,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
 	mock_client "github.com/datarobot-community/terraform-provider-datarobot/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -366,7 +368,8 @@ func testArtifactSourceUpload(t *testing.T, isMock bool, mockService ...*mock_cl
 		}
 
 		svc.EXPECT().CreateArtifact(gomock.Any(), gomock.Any()).Return(draftArtifact, nil).Times(1)
-		svc.EXPECT().FilesAPI().Return(filesAPI).Times(2)
+		// two uploads, plus a listing of the catalog on each refresh for drift detection
+		svc.EXPECT().FilesAPI().Return(filesAPI).MinTimes(2)
 		svc.EXPECT().PatchArtifactCodeRef(gomock.Any(), artifactID, gomock.Any(), gomock.Any()).DoAndReturn(patchCodeRef).Times(2)
 		svc.EXPECT().PatchArtifact(gomock.Any(), artifactID, gomock.Any()).Return(draftArtifact, nil).Times(1)
 		svc.EXPECT().GetArtifact(gomock.Any(), artifactID).DoAndReturn(
@@ -517,6 +520,8 @@ func testArtifactSourceUploadLocked(t *testing.T, isMock bool, mockService ...*m
 				return nil, fmt.Errorf("GetArtifact(%s): not found", id)
 			},
 		).AnyTimes()
+		// refreshes list the catalog for drift detection
+		svc.EXPECT().FilesAPI().Return(filesAPI).AnyTimes()
 		svc.EXPECT().DeleteArtifactRepository(gomock.Any(), repoID).Return(nil)
 	}
 
@@ -2207,7 +2212,7 @@ func artifactResourceModelWithSource(name, dir string) ArtifactResourceModel {
 		Name:   types.StringValue(name),
 		Status: types.StringValue("draft"),
 		Type:   types.StringValue("service"),
-		Source: &ArtifactSourceModel{Dir: types.StringValue(dir)},
+		Source: &ArtifactSourceModel{Dir: types.StringValue(dir), FileManifest: types.MapNull(types.StringType), PendingChanges: types.ObjectNull(pendingChangesAttrTypes)},
 		Spec: &ArtifactSpecModel{
 			ContainerGroups: []ArtifactContainerGroupModel{{
 				Containers: []ArtifactContainerModel{{
@@ -2240,6 +2245,7 @@ func testArtifactApplyCreate(ctx context.Context, r *ArtifactResource, data Arti
 		return data, diags
 	}
 
+	fillTestTimeouts(&data.Timeouts)
 	plan := tfsdk.Plan{Schema: schema}
 	diags.Append(plan.Set(ctx, &data)...)
 	if diags.HasError() {
@@ -2269,6 +2275,7 @@ func testArtifactApplyRead(ctx context.Context, r *ArtifactResource, data Artifa
 		return data, diags, false
 	}
 
+	fillTestTimeouts(&data.Timeouts)
 	state := tfsdk.State{Schema: schema}
 	diags.Append(state.Set(ctx, &data)...)
 	if diags.HasError() {
@@ -2295,6 +2302,8 @@ func testArtifactApplyUpdate(ctx context.Context, r *ArtifactResource, planModel
 		return planModel, diags
 	}
 
+	fillTestTimeouts(&planModel.Timeouts)
+	fillTestTimeouts(&stateModel.Timeouts)
 	plan := tfsdk.Plan{Schema: schema}
 	diags.Append(plan.Set(ctx, &planModel)...)
 	if diags.HasError() {
@@ -2542,7 +2551,7 @@ func TestArtifactResourceSourceUpdateLockedSpecChangeCloneLock(t *testing.T) {
 		})
 
 	resource := &ArtifactResource{provider: &Provider{service: mockService}}
-	dirHash, err := computeFolderHash(types.StringValue(sourceDir))
+	dirHash, err := computeFolderHash(context.Background(), types.StringValue(sourceDir), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -3082,7 +3091,11 @@ func TestArtifactResourceSourceReadGetArtifactError(t *testing.T) {
 	resource := &ArtifactResource{provider: &Provider{service: mockService}}
 	_, diags, removed := testArtifactApplyRead(context.Background(), resource, ArtifactResourceModel{
 		ArtifactID: types.StringValue(artifactID),
-		Source:     &ArtifactSourceModel{Dir: types.StringValue(writeArtifactSourceTree(t, map[string]string{"main.py": "x"}))},
+		Source: &ArtifactSourceModel{
+			Dir:            types.StringValue(writeArtifactSourceTree(t, map[string]string{"main.py": "x"})),
+			FileManifest:   types.MapNull(types.StringType),
+			PendingChanges: types.ObjectNull(pendingChangesAttrTypes),
+		},
 	})
 	if removed {
 		t.Fatal("expected resource to remain when read fails with API error")
@@ -3106,7 +3119,11 @@ func TestArtifactResourceSourceReadNotFoundRemovesState(t *testing.T) {
 	resource := &ArtifactResource{provider: &Provider{service: mockService}}
 	_, diags, removed := testArtifactApplyRead(context.Background(), resource, ArtifactResourceModel{
 		ArtifactID: types.StringValue(artifactID),
-		Source:     &ArtifactSourceModel{Dir: types.StringValue(writeArtifactSourceTree(t, map[string]string{"main.py": "x"}))},
+		Source: &ArtifactSourceModel{
+			Dir:            types.StringValue(writeArtifactSourceTree(t, map[string]string{"main.py": "x"})),
+			FileManifest:   types.MapNull(types.StringType),
+			PendingChanges: types.ObjectNull(pendingChangesAttrTypes),
+		},
 	})
 	if !removed {
 		t.Fatal("expected read not-found to mark resource removed")
@@ -3137,7 +3154,7 @@ func TestArtifactResourceSourceReadRefreshesDirHash(t *testing.T) {
 	if err := os.WriteFile(filepath.Join(sourceDir, "main.py"), []byte("after"), 0o644); err != nil {
 		t.Fatal(err)
 	}
-	expectedHash, err := computeFolderHash(types.StringValue(sourceDir))
+	expectedHash, err := computeFolderHash(context.Background(), types.StringValue(sourceDir), nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -3145,7 +3162,12 @@ func TestArtifactResourceSourceReadRefreshesDirHash(t *testing.T) {
 	resource := &ArtifactResource{provider: &Provider{service: mockService}}
 	result, diags, removed := testArtifactApplyRead(context.Background(), resource, ArtifactResourceModel{
 		ArtifactID: types.StringValue(artifactID),
-		Source:     &ArtifactSourceModel{Dir: types.StringValue(sourceDir), DirHash: types.StringValue("stale")},
+		Source: &ArtifactSourceModel{
+			Dir:            types.StringValue(sourceDir),
+			DirHash:        types.StringValue("stale"),
+			FileManifest:   types.MapNull(types.StringType),
+			PendingChanges: types.ObjectNull(pendingChangesAttrTypes),
+		},
 	})
 	if removed || diags.HasError() {
 		t.Fatalf("read failed: removed=%v err=%v", removed, diagErrorSummary(diags))
@@ -3155,6 +3177,45 @@ func TestArtifactResourceSourceReadRefreshesDirHash(t *testing.T) {
 	}
 }
 
+func TestArtifactResourceSourceReadDetectsRemoteDrift(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockService := mock_client.NewMockService(ctrl)
+
+	sourceDir := writeArtifactSourceTree(t, map[string]string{"main.py": "local"})
+	artifactID := uuid.NewString()
+	repoID := uuid.NewString()
+	name := "source-read-drift-" + uuid.NewString()[:8]
+
+	draftArtifact := artifactFixtureDraftWithBuildConfig(artifactID, &repoID, name)
+	patchedArtifact := artifactSourcePatchedArtifact(draftArtifact, artifactSourceTestCatalogID, artifactSourceTestVersionID)
+	mockService.EXPECT().GetArtifact(gomock.Any(), artifactID).Return(patchedArtifact, nil)
+
+	filesAPI := newSyncTestFilesAPI()
+	filesAPI.remoteFiles = map[string]filesapi.FileMeta{"main.py": {Hash: testSHA256Hex("edited remotely"), Size: 15}}
+	mockService.EXPECT().FilesAPI().Return(filesAPI)
+
+	resource := &ArtifactResource{provider: &Provider{service: mockService}}
+	result, diags, removed := testArtifactApplyRead(context.Background(), resource, ArtifactResourceModel{
+		ArtifactID: types.StringValue(artifactID),
+		Source: &ArtifactSourceModel{
+			Dir:            types.StringValue(sourceDir),
+			DirHash:        types.StringValue("synced"),
+			FileManifest:   artifactSourceManifestValue(artifactsource.Manifest{"main.py": {Hash: testSHA256Hex("local")}}),
+			PendingChanges: types.ObjectNull(pendingChangesAttrTypes),
+		},
+	})
+	if removed || diags.HasError() {
+		t.Fatalf("read failed: removed=%v err=%v", removed, diagErrorSummary(diags))
+	}
+	if !result.Source.DirHash.IsNull() {
+		t.Fatalf("dir_hash = %q, want null after remote drift", result.Source.DirHash.ValueString())
+	}
+	if len(diags.Warnings()) != 1 || !strings.Contains(diags.Warnings()[0].Detail(), "main.py") {
+		t.Fatalf("warnings = %v, want drift warning naming main.py", diags.Warnings())
+	}
+}
+
 func artifactConfigWithSource(name, status, dir string) string {
 	return fmt.Sprintf(`
 resource "datarobot_artifact" "test" {
@@ -3336,6 +3397,7 @@ func TestDecodePlanArtifactModelUnknownCodeRef(t *testing.T) {
 func testArtifactPlanWithUnknownCodeRef(t *testing.T, ctx context.Context, schema schema.Schema, model *ArtifactResourceModel) tfsdk.Plan {
 	t.Helper()
 
+	fillTestTimeouts(&model.Timeouts)
 	plan := tfsdk.Plan{Schema: schema}
 	if diags := plan.Set(ctx, model); diags.HasError() {
 		t.Fatalf("plan.Set: %s", diagErrorSummary(diags))
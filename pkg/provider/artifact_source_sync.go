@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 func artifactSourceConfigured(data *ArtifactResourceModel) bool {
@@ -75,9 +79,17 @@ func (r *ArtifactResource) pushArtifactSource(
 		return nil, fmt.Errorf("resolve source directory %q: %w", dir, err)
 	}
 
+	ignore, err := artifactSourceIgnore(data.Source)
+	if err != nil {
+		return nil, err
+	}
+
 	opts := artifactsource.Options{
 		Dir:       absDir,
 		CatalogID: existingCatalogID,
+		Ignore:    ignore,
+		HashCache: r.provider.fileHashCache(),
+		Limits:    r.provider.artifactUploadLimits(),
 	}
 	if prior != nil {
 		opts.CatalogVersionID = catalogVersionIDFromModel(prior)
@@ -120,10 +132,156 @@ func (r *ArtifactResource) syncArtifactSource(
 	if err != nil {
 		return nil, fmt.Errorf("patch artifact code reference: %w", err)
 	}
+	plan.Source.FileManifest = artifactSourceManifestValue(pushResult.FileHashes)
 
 	return artifact, nil
 }
 
+// pruneArtifactSourceVersions applies source.retain_versions to the catalog of the artifact.
+// Versions referenced by the code_ref of any artifact are kept, as well as the versions of
+// this resource before and after the apply. Failures are reported as warnings because the
+// artifact itself was already applied.
+func (r *ArtifactResource) pruneArtifactSourceVersions(
+	ctx context.Context,
+	data *ArtifactResourceModel,
+	prior *ArtifactResourceModel,
+	diags *diag.Diagnostics,
+) {
+	if !artifactSourceConfigured(data) || !IsKnown(data.Source.RetainVersions) {
+		return
+	}
+	catalogID := catalogIDFromModel(data)
+	if catalogID == "" {
+		return
+	}
+
+	keep := map[string]bool{}
+	for _, model := range []*ArtifactResourceModel{data, prior} {
+		if versionID := catalogVersionIDFromModel(model); versionID != "" {
+			keep[versionID] = true
+		}
+	}
+
+	traceAPICall("ListArtifacts")
+	artifacts, err := r.provider.service.ListArtifacts(ctx, &client.ListArtifactsRequest{})
+	if err != nil {
+		diags.AddWarning(
+			"Unable to prune artifact source catalog versions",
+			fmt.Sprintf("Listing artifacts to find catalog versions in use failed, so no versions were deleted: %s", err))
+		return
+	}
+	for i := range artifacts {
+		for _, ref := range client.CodeRefs(&artifacts[i]) {
+			if ref.CatalogID == catalogID && ref.CatalogVersionID != "" {
+				keep[ref.CatalogVersionID] = true
+			}
+		}
+	}
+
+	dryRun := IsKnown(data.Source.RetainVersionsDryRun) && data.Source.RetainVersionsDryRun.ValueBool()
+	traceAPICall("PruneVersions")
+	pruned, err := artifactsource.PruneVersions(ctx, r.provider.service.FilesAPI(), artifactsource.PruneOptions{
+		CatalogID: catalogID,
+		Retain:    int(data.Source.RetainVersions.ValueInt64()),
+		Keep:      keep,
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		diags.AddWarning(
+			"Unable to prune artifact source catalog versions",
+			fmt.Sprintf("Pruning versions of catalog %s failed: %s", catalogID, err))
+		return
+	}
+	if len(pruned) == 0 {
+		return
+	}
+
+	if dryRun {
+		diags.AddWarning(
+			"Catalog versions that source.retain_versions would delete",
+			fmt.Sprintf("%d version(s) of catalog %s would be deleted: %s. "+
+				"Set source.retain_versions_dry_run = false to delete them.",
+				len(pruned), catalogID, strings.Join(pruned, ", ")))
+		return
+	}
+	tflog.Info(ctx, "Deleted artifact source catalog versions", map[string]any{
+		"catalog_id":  catalogID,
+		"version_ids": pruned,
+	})
+}
+
+// detectArtifactSourceDrift lists the files of the catalog version recorded in code_ref
+// and compares them with source.file_manifest. When they diverge, dir_hash is cleared so
+// the next plan uploads the source again, and the drifted paths are returned.
+func (r *ArtifactResource) detectArtifactSourceDrift(ctx context.Context, data *ArtifactResourceModel) ([]string, error) {
+	if !artifactSourceConfigured(data) || !IsKnown(data.Source.FileManifest) {
+		return nil, nil
+	}
+	if IsKnown(data.Source.DetectRemoteDrift) && !data.Source.DetectRemoteDrift.ValueBool() {
+		return nil, nil
+	}
+
+	catalogID := catalogIDFromModel(data)
+	catalogVersionID := catalogVersionIDFromModel(data)
+	if catalogID == "" || catalogVersionID == "" {
+		return nil, nil
+	}
+
+	traceAPICall("AllFiles")
+	remote, err := r.provider.service.FilesAPI().AllFiles(ctx, catalogID, catalogVersionID)
+	if err != nil {
+		return nil, fmt.Errorf("list files of catalog %s version %s: %w", catalogID, catalogVersionID, err)
+	}
+
+	drifted := artifactsource.RemoteDrift(manifestFromMapValue(data.Source.FileManifest), remote)
+	if len(drifted) > 0 {
+		data.Source.DirHash = types.StringNull()
+	}
+	return drifted, nil
+}
+
+// artifactSourcePendingChanges previews the files the next upload of dir pushes relative
+// to the file_manifest of state. It is null while the ignore rules are unknown.
+func artifactSourcePendingChanges(ctx context.Context, cache *artifactsource.HashCache, plan, state *ArtifactResourceModel) (types.Object, fileChanges, error) {
+	if !artifactSourceIgnoreKnown(plan.Source) {
+		return types.ObjectNull(pendingChangesAttrTypes), fileChanges{}, nil
+	}
+	ignore, err := artifactSourceIgnore(plan.Source)
+	if err != nil {
+		return types.ObjectNull(pendingChangesAttrTypes), fileChanges{}, err
+	}
+	local, err := artifactsource.ScanManifest(plan.Source.Dir.ValueString(), ignore, cache)
+	if err != nil {
+		return types.ObjectNull(pendingChangesAttrTypes), fileChanges{}, err
+	}
+
+	base := types.MapNull(types.StringType)
+	if state != nil && state.Source != nil {
+		base = state.Source.FileManifest
+	}
+	changes := diffFileChanges(manifestFromMapValue(base), local)
+	value, err := changes.objectValue(ctx)
+	return value, changes, err
+}
+
+// artifactSourceDriftSummary lists the first drifted paths for a diagnostic.
+func artifactSourceDriftSummary(drifted []string) string {
+	const maxListed = 10
+	if len(drifted) <= maxListed {
+		return strings.Join(drifted, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(drifted[:maxListed], ", "), len(drifted)-maxListed)
+}
+
+// artifactSourceManifestValue converts the per-file hashes of a push to source.file_manifest.
+func artifactSourceManifestValue(manifest artifactsource.Manifest) types.Map {
+	elements := make(map[string]attr.Value, len(manifest))
+	for path, entry := range manifest {
+		elements[path] = types.StringValue(entry.Hash)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
 func (r *ArtifactResource) rollbackArtifactCreate(ctx context.Context, artifact *client.Artifact, deleteRepository bool) {
 	if artifact == nil || !deleteRepository || artifact.ArtifactRepositoryID == nil {
 		return
@@ -191,16 +349,73 @@ func (r *ArtifactResource) lockArtifact(ctx context.Context, artifactID string)
 	})
 }
 
-func refreshArtifactSourceDirHash(data *ArtifactResourceModel) {
+func refreshArtifactSourceDirHash(ctx context.Context, data *ArtifactResourceModel, cache *artifactsource.HashCache) {
 	if !artifactSourceConfigured(data) {
 		return
 	}
-	dirHash, err := computeFolderHash(data.Source.Dir)
+	dirHash, err := artifactSourceDirHash(ctx, data.Source, cache)
 	if err == nil {
 		data.Source.DirHash = dirHash
 	}
 }
 
+// artifactSourceIgnoreKnown reports whether the ignore rules can be read at plan time.
+func artifactSourceIgnoreKnown(source *ArtifactSourceModel) bool {
+	return localSourceIgnoreKnown(source.IgnoreFile, source.IgnorePatterns)
+}
+
+// artifactSourceIgnore compiles the patterns of ignore_file followed by ignore_patterns,
+// so that dir_hash and the upload exclude the same paths. It returns nil without rules.
+func artifactSourceIgnore(source *ArtifactSourceModel) (artifactsource.IgnoreFunc, error) {
+	return localSourceIgnore(source.Dir, source.IgnoreFile, source.IgnorePatterns)
+}
+
+// localSourceIgnoreKnown reports whether ignore_file and ignore_patterns are known.
+func localSourceIgnoreKnown(ignoreFile types.String, ignorePatterns []types.String) bool {
+	if ignoreFile.IsUnknown() {
+		return false
+	}
+	for _, pattern := range ignorePatterns {
+		if pattern.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// localSourceIgnore compiles the patterns of ignoreFile, resolved against dir when
+// relative, followed by ignorePatterns.
+func localSourceIgnore(dir, ignoreFile types.String, ignorePatterns []types.String) (artifactsource.IgnoreFunc, error) {
+	var patterns []string
+	if IsKnown(ignoreFile) {
+		path := ignoreFile.ValueString()
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir.ValueString(), path)
+		}
+		lines, err := artifactsource.ReadIgnoreFile(path)
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, lines...)
+	}
+	patterns = append(patterns, convertTfStringList(ignorePatterns)...)
+
+	return artifactsource.CompileIgnore(patterns)
+}
+
+// artifactSourceDirHash fingerprints the source directory with its ignore rules applied.
+// The hash is unknown while the rules are.
+func artifactSourceDirHash(ctx context.Context, source *ArtifactSourceModel, cache *artifactsource.HashCache) (types.String, error) {
+	if !artifactSourceIgnoreKnown(source) {
+		return types.StringUnknown(), nil
+	}
+	ignore, err := artifactSourceIgnore(source)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return computeFolderHashIgnoring(ctx, source.Dir, ignore, cache)
+}
+
 func cloneCodeRefModel(ref *ArtifactCodeRefModel) *ArtifactCodeRefModel {
 	if ref == nil {
 		return nil
@@ -2,16 +2,24 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
 	mock_client "github.com/datarobot-community/terraform-provider-datarobot/mock"
 	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -303,7 +311,7 @@ func TestRefreshArtifactSourceDirHash(t *testing.T) {
 
 	t.Run("no source block leaves model unchanged", func(t *testing.T) {
 		data := &ArtifactResourceModel{}
-		refreshArtifactSourceDirHash(data)
+		refreshArtifactSourceDirHash(context.Background(), data, nil)
 		if data.Source != nil {
 			t.Fatal("expected source to remain nil")
 		}
@@ -315,13 +323,13 @@ func TestRefreshArtifactSourceDirHash(t *testing.T) {
 			Source: &ArtifactSourceModel{Dir: types.StringValue(dir)},
 		}
 
-		refreshArtifactSourceDirHash(data)
+		refreshArtifactSourceDirHash(context.Background(), data, nil)
 		if !IsKnown(data.Source.DirHash) {
 			t.Fatal("expected computed dir_hash")
 		}
 
 		first := data.Source.DirHash
-		refreshArtifactSourceDirHash(data)
+		refreshArtifactSourceDirHash(context.Background(), data, nil)
 		if !data.Source.DirHash.Equal(first) {
 			t.Fatal("expected stable hash on unchanged tree")
 		}
@@ -332,18 +340,38 @@ func TestRefreshArtifactSourceDirHash(t *testing.T) {
 		data := &ArtifactResourceModel{
 			Source: &ArtifactSourceModel{Dir: types.StringValue(dir)},
 		}
-		refreshArtifactSourceDirHash(data)
+		refreshArtifactSourceDirHash(context.Background(), data, nil)
 		first := data.Source.DirHash
 
 		if err := os.WriteFile(filepath.Join(dir, "main.py"), []byte("v2"), 0o644); err != nil {
 			t.Fatal(err)
 		}
-		refreshArtifactSourceDirHash(data)
+		refreshArtifactSourceDirHash(context.Background(), data, nil)
 		if data.Source.DirHash.Equal(first) {
 			t.Fatal("expected dir_hash to change after file edit")
 		}
 	})
 
+	t.Run("ignored files do not change hash", func(t *testing.T) {
+		dir := writeArtifactSourceTree(t, map[string]string{"main.py": "v1", "cache/data.bin": "a"})
+		data := &ArtifactResourceModel{
+			Source: &ArtifactSourceModel{
+				Dir:            types.StringValue(dir),
+				IgnorePatterns: []types.String{types.StringValue("cache/")},
+			},
+		}
+		refreshArtifactSourceDirHash(context.Background(), data, nil)
+		first := data.Source.DirHash
+
+		if err := os.WriteFile(filepath.Join(dir, "cache", "data.bin"), []byte("b"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		refreshArtifactSourceDirHash(context.Background(), data, nil)
+		if !data.Source.DirHash.Equal(first) {
+			t.Fatal("expected dir_hash to ignore changes under an ignored directory")
+		}
+	})
+
 	t.Run("missing directory leaves dir_hash unset", func(t *testing.T) {
 		data := &ArtifactResourceModel{
 			Source: &ArtifactSourceModel{
@@ -351,13 +379,88 @@ func TestRefreshArtifactSourceDirHash(t *testing.T) {
 				DirHash: types.StringNull(),
 			},
 		}
-		refreshArtifactSourceDirHash(data)
+		refreshArtifactSourceDirHash(context.Background(), data, nil)
 		if IsKnown(data.Source.DirHash) {
 			t.Fatal("expected dir_hash to remain unset when directory is missing")
 		}
 	})
 }
 
+func TestArtifactSourceIgnore(t *testing.T) {
+	t.Parallel()
+
+	dir := writeArtifactSourceTree(t, map[string]string{
+		".drignore": "*.log\n!keep.log\n",
+	})
+
+	t.Run("no rules", func(t *testing.T) {
+		ignore, err := artifactSourceIgnore(&ArtifactSourceModel{Dir: types.StringValue(dir)})
+		if err != nil {
+			t.Fatalf("artifactSourceIgnore() error = %v", err)
+		}
+		if ignore != nil {
+			t.Fatal("expected nil ignore func without rules")
+		}
+	})
+
+	t.Run("ignore_patterns apply after ignore_file", func(t *testing.T) {
+		ignore, err := artifactSourceIgnore(&ArtifactSourceModel{
+			Dir:            types.StringValue(dir),
+			IgnoreFile:     types.StringValue(".drignore"),
+			IgnorePatterns: []types.String{types.StringValue("keep.log")},
+		})
+		if err != nil {
+			t.Fatalf("artifactSourceIgnore() error = %v", err)
+		}
+		if !ignore("app.log", false) {
+			t.Error("expected app.log to be ignored by ignore_file")
+		}
+		if !ignore("keep.log", false) {
+			t.Error("expected keep.log to be ignored by ignore_patterns")
+		}
+		if ignore("main.py", false) {
+			t.Error("expected main.py to be kept")
+		}
+	})
+
+	t.Run("missing ignore_file", func(t *testing.T) {
+		_, err := artifactSourceIgnore(&ArtifactSourceModel{
+			Dir:        types.StringValue(dir),
+			IgnoreFile: types.StringValue(".gitignore"),
+		})
+		if err == nil {
+			t.Fatal("expected error for missing ignore file")
+		}
+	})
+
+	t.Run("unknown patterns leave dir_hash unknown", func(t *testing.T) {
+		dirHash, err := artifactSourceDirHash(context.Background(), &ArtifactSourceModel{
+			Dir:            types.StringValue(dir),
+			IgnorePatterns: []types.String{types.StringUnknown()},
+		}, nil)
+		if err != nil {
+			t.Fatalf("artifactSourceDirHash() error = %v", err)
+		}
+		if !dirHash.IsUnknown() {
+			t.Fatal("expected unknown dir_hash")
+		}
+	})
+
+	t.Run("hash without rules matches computeFolderHash", func(t *testing.T) {
+		want, err := computeFolderHash(context.Background(), types.StringValue(dir), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := artifactSourceDirHash(context.Background(), &ArtifactSourceModel{Dir: types.StringValue(dir)}, nil)
+		if err != nil {
+			t.Fatalf("artifactSourceDirHash() error = %v", err)
+		}
+		if !got.Equal(want) {
+			t.Fatalf("dir_hash = %s, want %s", got, want)
+		}
+	})
+}
+
 func TestRollbackArtifactCreate(t *testing.T) {
 	t.Parallel()
 
@@ -418,7 +521,7 @@ func TestSyncArtifactSource(t *testing.T) {
 
 		resource := &ArtifactResource{provider: &Provider{service: mockService}}
 		dir := writeArtifactSourceTree(t, map[string]string{"main.py": "stable"})
-		hash, err := computeFolderHash(types.StringValue(dir))
+		hash, err := computeFolderHash(context.Background(), types.StringValue(dir), nil)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -464,6 +567,34 @@ func TestSyncArtifactSource(t *testing.T) {
 		}
 	})
 
+	t.Run("stores file manifest after upload", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+		filesAPI := newSyncTestFilesAPI()
+
+		mockService.EXPECT().FilesAPI().Return(filesAPI)
+		mockService.EXPECT().
+			PatchArtifactCodeRef(gomock.Any(), artifactID, gomock.Any(), gomock.Any()).
+			Return(&client.Artifact{ID: artifactID}, nil)
+
+		resource := &ArtifactResource{provider: &Provider{service: mockService}}
+		dir := writeArtifactSourceTree(t, map[string]string{"main.py": "a", "lib/util.py": "b"})
+		plan := &ArtifactResourceModel{
+			Source: &ArtifactSourceModel{
+				Dir:          types.StringValue(dir),
+				FileManifest: types.MapUnknown(types.StringType),
+			},
+		}
+
+		if _, err := resource.syncArtifactSource(context.Background(), plan, nil, &client.Artifact{ID: artifactID}, ""); err != nil {
+			t.Fatalf("syncArtifactSource() error = %v", err)
+		}
+		manifest := manifestFromMapValue(plan.Source.FileManifest)
+		if len(manifest) != 2 || manifest["lib/util.py"].Hash != testSHA256Hex("b") {
+			t.Fatalf("file_manifest = %v, want hashes of main.py and lib/util.py", manifest)
+		}
+	})
+
 	t.Run("reuses catalog id from state", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mockService := mock_client.NewMockService(ctrl)
@@ -593,6 +724,208 @@ func TestSyncArtifactSource(t *testing.T) {
 	})
 }
 
+func TestDetectArtifactSourceDrift(t *testing.T) {
+	t.Parallel()
+
+	const (
+		catalogID = "aaaaaaaaaaaaaaaaaaaaaaaa"
+		versionID = "bbbbbbbbbbbbbbbbbbbbbbbb"
+	)
+
+	newModel := func(detect types.Bool) *ArtifactResourceModel {
+		return &ArtifactResourceModel{
+			Source: &ArtifactSourceModel{
+				Dir:               types.StringValue(t.TempDir()),
+				DirHash:           types.StringValue("dir-hash"),
+				FileManifest:      artifactSourceManifestValue(artifactsource.Manifest{"main.py": {Hash: testSHA256Hex("v1")}}),
+				PendingChanges:    types.ObjectNull(pendingChangesAttrTypes),
+				DetectRemoteDrift: detect,
+			},
+			Spec: artifactSpecWithCodeRef(catalogID, versionID),
+		}
+	}
+
+	t.Run("in sync keeps dir_hash", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+		filesAPI := newSyncTestFilesAPI()
+		filesAPI.remoteFiles = map[string]filesapi.FileMeta{"main.py": {Hash: testSHA256Hex("v1"), Size: 2}}
+		mockService.EXPECT().FilesAPI().Return(filesAPI)
+
+		resource := &ArtifactResource{provider: &Provider{service: mockService}}
+		data := newModel(types.BoolValue(true))
+
+		drifted, err := resource.detectArtifactSourceDrift(context.Background(), data)
+		if err != nil {
+			t.Fatalf("detectArtifactSourceDrift() error = %v", err)
+		}
+		if len(drifted) != 0 || data.Source.DirHash.ValueString() != "dir-hash" {
+			t.Fatalf("drifted = %v, dir_hash = %s; want no drift", drifted, data.Source.DirHash)
+		}
+	})
+
+	t.Run("remote edit clears dir_hash", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+		filesAPI := newSyncTestFilesAPI()
+		filesAPI.remoteFiles = map[string]filesapi.FileMeta{
+			"main.py":  {Hash: testSHA256Hex("edited in UI"), Size: 12},
+			"extra.py": {Hash: testSHA256Hex("added"), Size: 5},
+		}
+		mockService.EXPECT().FilesAPI().Return(filesAPI)
+
+		resource := &ArtifactResource{provider: &Provider{service: mockService}}
+		data := newModel(types.BoolNull())
+
+		drifted, err := resource.detectArtifactSourceDrift(context.Background(), data)
+		if err != nil {
+			t.Fatalf("detectArtifactSourceDrift() error = %v", err)
+		}
+		if len(drifted) != 2 || drifted[0] != "extra.py" || drifted[1] != "main.py" {
+			t.Fatalf("drifted = %v, want [extra.py main.py]", drifted)
+		}
+		if !data.Source.DirHash.IsNull() {
+			t.Fatal("expected dir_hash to be cleared on drift")
+		}
+	})
+
+	t.Run("list failure is returned", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+		filesAPI := newSyncTestFilesAPI()
+		filesAPI.allFilesErr = fmt.Errorf("not found")
+		mockService.EXPECT().FilesAPI().Return(filesAPI)
+
+		resource := &ArtifactResource{provider: &Provider{service: mockService}}
+		data := newModel(types.BoolValue(true))
+
+		if _, err := resource.detectArtifactSourceDrift(context.Background(), data); err == nil {
+			t.Fatal("expected list error")
+		}
+		if data.Source.DirHash.ValueString() != "dir-hash" {
+			t.Fatal("expected dir_hash to be kept when the check fails")
+		}
+	})
+
+	t.Run("skipped when disabled or without manifest", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+		resource := &ArtifactResource{provider: &Provider{service: mockService}}
+
+		disabled := newModel(types.BoolValue(false))
+		withoutManifest := newModel(types.BoolValue(true))
+		withoutManifest.Source.FileManifest = types.MapNull(types.StringType)
+		withoutCodeRef := newModel(types.BoolValue(true))
+		withoutCodeRef.Spec = nil
+
+		for _, data := range []*ArtifactResourceModel{disabled, withoutManifest, withoutCodeRef} {
+			if drifted, err := resource.detectArtifactSourceDrift(context.Background(), data); err != nil || drifted != nil {
+				t.Fatalf("detectArtifactSourceDrift() = %v, %v; want skipped", drifted, err)
+			}
+		}
+	})
+}
+
+func TestPruneArtifactSourceVersions(t *testing.T) {
+	t.Parallel()
+
+	const catalogID = "cat-1"
+
+	newModel := func(retain types.Int64, dryRun bool) *ArtifactResourceModel {
+		return &ArtifactResourceModel{
+			Source: &ArtifactSourceModel{
+				Dir:                  types.StringValue(t.TempDir()),
+				RetainVersions:       retain,
+				RetainVersionsDryRun: types.BoolValue(dryRun),
+			},
+			Spec: artifactSpecWithCodeRef(catalogID, syncTestVersionID(6)),
+		}
+	}
+	newFilesAPI := func() *syncTestFilesAPI {
+		filesAPI := newSyncTestFilesAPI()
+		for n := 6; n >= 1; n-- {
+			filesAPI.versions = append(filesAPI.versions, filesapi.CatalogVersion{ID: syncTestVersionID(n)})
+		}
+		return filesAPI
+	}
+	artifacts := []client.Artifact{
+		*artifactWithCodeRef("art-other", catalogID, syncTestVersionID(2)),
+		*artifactWithCodeRef("art-unrelated", "cat-2", syncTestVersionID(1)),
+	}
+
+	t.Run("deletes old versions not referenced", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+		filesAPI := newFilesAPI()
+		mockService.EXPECT().ListArtifacts(gomock.Any(), gomock.Any()).Return(artifacts, nil)
+		mockService.EXPECT().FilesAPI().Return(filesAPI)
+
+		resource := &ArtifactResource{provider: &Provider{service: mockService}}
+		prior := &ArtifactResourceModel{Spec: artifactSpecWithCodeRef(catalogID, syncTestVersionID(4))}
+		var diags diag.Diagnostics
+		resource.pruneArtifactSourceVersions(context.Background(), newModel(types.Int64Value(2), false), prior, &diags)
+
+		if diags.HasError() || diags.WarningsCount() != 0 {
+			t.Fatalf("diagnostics = %v, want none", diags)
+		}
+		sort.Strings(filesAPI.deletedVersions)
+		if want := []string{"ver-1", "ver-3"}; !reflect.DeepEqual(filesAPI.deletedVersions, want) {
+			t.Fatalf("deleted versions = %v, want %v", filesAPI.deletedVersions, want)
+		}
+	})
+
+	t.Run("dry run warns without deleting", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+		filesAPI := newFilesAPI()
+		mockService.EXPECT().ListArtifacts(gomock.Any(), gomock.Any()).Return(artifacts, nil)
+		mockService.EXPECT().FilesAPI().Return(filesAPI)
+
+		resource := &ArtifactResource{provider: &Provider{service: mockService}}
+		var diags diag.Diagnostics
+		resource.pruneArtifactSourceVersions(context.Background(), newModel(types.Int64Value(3), true), nil, &diags)
+
+		if len(filesAPI.deletedVersions) != 0 {
+			t.Fatalf("deleted versions = %v, want none", filesAPI.deletedVersions)
+		}
+		if diags.WarningsCount() != 1 || !strings.Contains(diags[0].Detail(), "ver-3, ver-1") {
+			t.Fatalf("diagnostics = %v, want a warning listing ver-3 and ver-1", diags)
+		}
+	})
+
+	t.Run("list failure warns without deleting", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+		mockService.EXPECT().ListArtifacts(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("boom"))
+
+		resource := &ArtifactResource{provider: &Provider{service: mockService}}
+		var diags diag.Diagnostics
+		resource.pruneArtifactSourceVersions(context.Background(), newModel(types.Int64Value(1), false), nil, &diags)
+
+		if diags.HasError() || diags.WarningsCount() != 1 {
+			t.Fatalf("diagnostics = %v, want one warning", diags)
+		}
+	})
+
+	t.Run("skipped without retain_versions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+
+		resource := &ArtifactResource{provider: &Provider{service: mockService}}
+		var diags diag.Diagnostics
+		resource.pruneArtifactSourceVersions(context.Background(), newModel(types.Int64Null(), false), nil, &diags)
+
+		if len(diags) != 0 {
+			t.Fatalf("diagnostics = %v, want none", diags)
+		}
+	})
+}
+
+func testSHA256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
 func writeArtifactSourceTree(t *testing.T, files map[string]string) string {
 	t.Helper()
 
@@ -650,8 +983,20 @@ type syncTestFilesAPI struct {
 	version   int
 	uploadErr error
 
+	stagedFiles map[string]filesapi.FileMeta
+	remoteFiles map[string]filesapi.FileMeta
+	allFilesErr error
+
 	createCatalogCalls    int
 	uploadFromZipNewCalls int
+	allFilesCalls         int
+	deletedFiles          []string
+	deletedCatalogs       []string
+
+	versions        []filesapi.CatalogVersion
+	listVersionsErr error
+	mu              sync.Mutex
+	deletedVersions []string
 }
 
 func newSyncTestFilesAPI() *syncTestFilesAPI {
@@ -677,20 +1022,33 @@ func (m *syncTestFilesAPI) CreateStage(context.Context, string) (*filesapi.Stage
 	if m.uploadErr != nil {
 		return nil, m.uploadErr
 	}
+	m.stagedFiles = make(map[string]filesapi.FileMeta)
 	return &filesapi.StageResp{CatalogID: m.catalogID, StageID: "stage-1"}, nil
 }
 
-func (m *syncTestFilesAPI) UploadToStage(context.Context, string, string, string, int64, io.Reader) error {
+func (m *syncTestFilesAPI) UploadToStage(_ context.Context, _, _, name string, size int64, body io.Reader) error {
 	if m.uploadErr != nil {
 		return m.uploadErr
 	}
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	m.stagedFiles[name] = filesapi.FileMeta{Hash: testSHA256Hex(string(content)), Size: size}
 	return nil
 }
 
+func (m *syncTestFilesAPI) UploadToStageChunked(ctx context.Context, catalogID, stageID, name string, size int64, body io.ReaderAt, _ int64) error {
+	return m.UploadToStage(ctx, catalogID, stageID, name, size, io.NewSectionReader(body, 0, size))
+}
+
+// ApplyStage makes the staged files the contents of the new catalog version, as a full
+// push with REPLACE does, so that AllFiles reports no drift after an upload.
 func (m *syncTestFilesAPI) ApplyStage(context.Context, string, string, string) (*filesapi.ApplyStageResp, error) {
 	if m.uploadErr != nil {
 		return nil, m.uploadErr
 	}
+	m.remoteFiles = m.stagedFiles
 	m.version++
 	return &filesapi.ApplyStageResp{
 		CatalogID:        m.catalogID,
@@ -729,19 +1087,36 @@ func (m *syncTestFilesAPI) PollStatus(context.Context, string) (*filesapi.Status
 }
 
 func (m *syncTestFilesAPI) AllFiles(context.Context, string, string) (map[string]filesapi.FileMeta, error) {
-	return nil, nil
+	m.allFilesCalls++
+	if m.allFilesErr != nil {
+		return nil, m.allFilesErr
+	}
+	return m.remoteFiles, nil
 }
 
 func (m *syncTestFilesAPI) DownloadFile(context.Context, string, string, string, io.Writer) (string, int64, error) {
 	return "", 0, nil
 }
 
-func (m *syncTestFilesAPI) DeleteFiles(context.Context, string, []string) (*filesapi.DeleteFilesResp, error) {
+func (m *syncTestFilesAPI) DeleteFiles(_ context.Context, _ string, paths []string) (*filesapi.DeleteFilesResp, error) {
+	m.deletedFiles = append(m.deletedFiles, paths...)
 	return &filesapi.DeleteFilesResp{}, nil
 }
 
 func (m *syncTestFilesAPI) ListVersions(context.Context, string, int) ([]filesapi.CatalogVersion, error) {
-	return nil, nil
+	return m.versions, m.listVersionsErr
+}
+
+func (m *syncTestFilesAPI) DeleteCatalog(_ context.Context, catalogID string) error {
+	m.deletedCatalogs = append(m.deletedCatalogs, catalogID)
+	return nil
+}
+
+func (m *syncTestFilesAPI) DeleteVersion(_ context.Context, _, versionID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deletedVersions = append(m.deletedVersions, versionID)
+	return nil
 }
 
 func syncTestVersionID(n int) string {
@@ -753,7 +1128,7 @@ func testSourcePlanModel(t *testing.T, dir string, spec *ArtifactSpecModel, opts
 
 	model := &ArtifactResourceModel{
 		Status: types.StringValue("draft"),
-		Source: &ArtifactSourceModel{Dir: types.StringValue(dir)},
+		Source: &ArtifactSourceModel{Dir: types.StringValue(dir), FileManifest: types.MapNull(types.StringType), PendingChanges: types.ObjectNull(pendingChangesAttrTypes)},
 		Spec:   spec,
 	}
 	for _, opt := range opts {
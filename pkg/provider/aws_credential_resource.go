@@ -77,6 +77,10 @@ func (r *AwsCredentialResource) Schema(ctx context.Context, req resource.SchemaR
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"aws_secret_access_key_wo":         writeOnlyAttribute("aws_secret_access_key"),
+			"aws_secret_access_key_wo_version": writeOnlyVersionAttribute("aws_secret_access_key", true),
+			"aws_session_token_wo":             writeOnlyAttribute("aws_session_token"),
+			"aws_session_token_wo_version":     writeOnlyVersionAttribute("aws_session_token", true),
 			"config_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the saved shared secure configuration. If specified, cannot include awsAccessKeyId, awsSecretAccessKey or awsSessionToken.",
 				Optional:            true,
@@ -85,6 +89,9 @@ func (r *AwsCredentialResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -111,19 +118,20 @@ func (r *AwsCredentialResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateAwsCredential")
 	createResp, err := r.provider.service.CreateCredential(ctx, &client.CredentialRequest{
 		Name:               data.Name.ValueString(),
 		Description:        data.Description.ValueString(),
 		CredentialType:     client.CredentialTypeS3,
 		AWSAccessKeyID:     data.AWSAccessKeyID.ValueString(),
-		AWSSecretAccessKey: data.AWSSecretAccessKey.ValueString(),
-		AWSSessionToken:    data.AWSSessionToken.ValueString(),
+		AWSSecretAccessKey: secretValue(data.AWSSecretAccessKey, writeOnlyValue(ctx, req.Config, "aws_secret_access_key_wo", &resp.Diagnostics)),
+		AWSSessionToken:    secretValue(data.AWSSessionToken, writeOnlyValue(ctx, req.Config, "aws_session_token_wo", &resp.Diagnostics)),
 		ConfigID:           data.ConfigID.ValueString(),
 	})
 	if err != nil {
-		errMessage := checkCredentialNameAlreadyExists(err, data.Name.ValueString())
-		resp.Diagnostics.AddError("Error creating AWS Credential", errMessage)
+		addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error creating AWS Credential", err, data.Name.ValueString(), "Credential")
 		return
 	}
 	data.ID = types.StringValue(createResp.ID)
@@ -139,6 +147,8 @@ func (r *AwsCredentialResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -177,6 +187,8 @@ func (r *AwsCredentialResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("UpdateAwsCredential")
 	_, err := r.provider.service.UpdateCredential(ctx,
 		data.ID.ValueString(),
@@ -191,8 +203,7 @@ func (r *AwsCredentialResource) Update(ctx context.Context, req resource.UpdateR
 				fmt.Sprintf("AWS Credential with ID %s is not found. Removing from state.", data.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			errMessage := checkCredentialNameAlreadyExists(err, data.Name.ValueString())
-			resp.Diagnostics.AddError("Error updating AWS Credential", errMessage)
+			addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error updating AWS Credential", err, data.Name.ValueString(), "Credential")
 		}
 		return
 	}
@@ -208,6 +219,8 @@ func (r *AwsCredentialResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteAwsCredential")
 	err := r.provider.service.DeleteCredential(ctx, data.ID.ValueString())
 	if err != nil {
@@ -236,5 +249,21 @@ func (r AwsCredentialResource) ConfigValidators(ctx context.Context) []resource.
 			path.MatchRoot("config_id"),
 			path.MatchRoot("aws_session_token"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("config_id"),
+			path.MatchRoot("aws_secret_access_key_wo"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("config_id"),
+			path.MatchRoot("aws_session_token_wo"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("aws_secret_access_key"),
+			path.MatchRoot("aws_secret_access_key_wo"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("aws_session_token"),
+			path.MatchRoot("aws_session_token_wo"),
+		),
 	}
 }
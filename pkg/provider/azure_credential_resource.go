@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AzureCredentialResource{}
 var _ resource.ResourceWithImportState = &AzureCredentialResource{}
+var _ resource.ResourceWithConfigValidators = &AzureCredentialResource{}
 
 func NewAzureCredentialResource() resource.Resource {
 	return &AzureCredentialResource{}
@@ -53,13 +55,18 @@ func (r *AzureCredentialResource) Schema(ctx context.Context, req resource.Schem
 				Optional:            true,
 			},
 			"azure_connection_string": schema.StringAttribute{
-				MarkdownDescription: "The connection string of the Azure Credential.",
+				MarkdownDescription: "The connection string of the Azure Credential. Exactly one of `azure_connection_string` or `azure_connection_string_wo` must be set.",
 				Sensitive:           true,
-				Required:            true,
+				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"azure_connection_string_wo":         writeOnlyAttribute("azure_connection_string"),
+			"azure_connection_string_wo_version": writeOnlyVersionAttribute("azure_connection_string", true),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -87,16 +94,17 @@ func (r *AzureCredentialResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateAzureCredential")
 	createResp, err := r.provider.service.CreateCredential(ctx, &client.CredentialRequest{
 		Name:                  data.Name.ValueString(),
 		Description:           data.Description.ValueString(),
 		CredentialType:        client.CredentialTypeAzure,
-		AzureConnectionString: data.AzureConnectionString.ValueString(),
+		AzureConnectionString: secretValue(data.AzureConnectionString, writeOnlyValue(ctx, req.Config, "azure_connection_string_wo", &resp.Diagnostics)),
 	})
 	if err != nil {
-		errMessage := checkCredentialNameAlreadyExists(err, data.Name.ValueString())
-		resp.Diagnostics.AddError("Error creating Azure Credential", errMessage)
+		addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Azure Credential", err, data.Name.ValueString(), "Credential")
 		return
 	}
 
@@ -112,6 +120,8 @@ func (r *AzureCredentialResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -148,6 +158,8 @@ func (r *AzureCredentialResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("UpdateAzureCredential")
 	_, err := r.provider.service.UpdateCredential(ctx,
 		data.ID.ValueString(),
@@ -162,8 +174,7 @@ func (r *AzureCredentialResource) Update(ctx context.Context, req resource.Updat
 				fmt.Sprintf("Azure Credential with ID %s is not found. Removing from state.", data.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			errMessage := checkCredentialNameAlreadyExists(err, data.Name.ValueString())
-			resp.Diagnostics.AddError("Error updating Azure Credential", errMessage)
+			addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Azure Credential", err, data.Name.ValueString(), "Credential")
 		}
 		return
 	}
@@ -179,6 +190,8 @@ func (r *AzureCredentialResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteAzureCredential")
 	err := r.provider.service.DeleteCredential(ctx, data.ID.ValueString())
 	if err != nil {
@@ -192,3 +205,12 @@ func (r *AzureCredentialResource) Delete(ctx context.Context, req resource.Delet
 func (r *AzureCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r AzureCredentialResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("azure_connection_string"),
+			path.MatchRoot("azure_connection_string_wo"),
+		),
+	}
+}
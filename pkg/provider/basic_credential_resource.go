@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,6 +20,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BasicCredentialResource{}
 var _ resource.ResourceWithImportState = &BasicCredentialResource{}
+var _ resource.ResourceWithConfigValidators = &BasicCredentialResource{}
 
 func NewBasicCredentialResource() resource.Resource {
 	return &BasicCredentialResource{}
@@ -63,9 +65,9 @@ func (r *BasicCredentialResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password of the Basic Credential.",
+				MarkdownDescription: "The password of the Basic Credential. Exactly one of `password` or `password_wo` must be set.",
 				Sensitive:           true,
-				Required:            true,
+				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -76,6 +78,11 @@ func (r *BasicCredentialResource) Schema(ctx context.Context, req resource.Schem
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"password_wo":         writeOnlyAttribute("password", stringvalidator.LengthAtLeast(1)),
+			"password_wo_version": writeOnlyVersionAttribute("password", true),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -103,17 +110,18 @@ func (r *BasicCredentialResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateBasicCredential")
 	createResp, err := r.provider.service.CreateCredential(ctx, &client.CredentialRequest{
 		Name:           data.Name.ValueString(),
 		Description:    data.Description.ValueString(),
 		CredentialType: client.CredentialTypeBasic,
 		User:           data.User.ValueString(),
-		Password:       data.Password.ValueString(),
+		Password:       secretValue(data.Password, writeOnlyValue(ctx, req.Config, "password_wo", &resp.Diagnostics)),
 	})
 	if err != nil {
-		errMessage := checkCredentialNameAlreadyExists(err, data.Name.ValueString())
-		resp.Diagnostics.AddError("Error creating Basic Credential", errMessage)
+		addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Basic Credential", err, data.Name.ValueString(), "Credential")
 		return
 	}
 	data.ID = types.StringValue(createResp.ID)
@@ -129,6 +137,8 @@ func (r *BasicCredentialResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -165,6 +175,8 @@ func (r *BasicCredentialResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("UpdateBasicCredential")
 	_, err := r.provider.service.UpdateCredential(ctx,
 		data.ID.ValueString(),
@@ -179,8 +191,7 @@ func (r *BasicCredentialResource) Update(ctx context.Context, req resource.Updat
 				fmt.Sprintf("Basic Credential with ID %s is not found. Removing from state.", data.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			errMessage := checkCredentialNameAlreadyExists(err, data.Name.ValueString())
-			resp.Diagnostics.AddError("Error updating Basic Credential", errMessage)
+			addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Basic Credential", err, data.Name.ValueString(), "Credential")
 		}
 		return
 	}
@@ -196,6 +207,8 @@ func (r *BasicCredentialResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteBasicCredential")
 	err := r.provider.service.DeleteCredential(ctx, data.ID.ValueString())
 	if err != nil {
@@ -209,3 +222,12 @@ func (r *BasicCredentialResource) Delete(ctx context.Context, req resource.Delet
 func (r *BasicCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r BasicCredentialResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("password"),
+			path.MatchRoot("password_wo"),
+		),
+	}
+}
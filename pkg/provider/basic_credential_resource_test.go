@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccBasicCredentialResource(t *testing.T) {
@@ -113,6 +114,74 @@ func TestAccBasicCredentialResource(t *testing.T) {
 	})
 }
 
+func TestAccBasicCredentialResourceWriteOnly(t *testing.T) {
+	t.Parallel()
+	resourceName := "datarobot_basic_credential.test"
+	credential_name := uuid.NewString()
+
+	compareValuesDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+	compareValuesSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: basicCredentialWriteOnlyResourceConfig(credential_name, "example_password", 1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					compareValuesSame.AddStateValue(
+						resourceName,
+						tfjsonpath.New("id"),
+					),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkBasicCredentialResourceExists(),
+					resource.TestCheckNoResourceAttr(resourceName, "password"),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "1"),
+				),
+			},
+			// Changing the password without bumping the version is a no-op
+			{
+				Config:   basicCredentialWriteOnlyResourceConfig(credential_name, "new_example_password", 1),
+				PlanOnly: true,
+			},
+			// Bumping the version triggers replace
+			{
+				Config: basicCredentialWriteOnlyResourceConfig(credential_name, "new_example_password", 2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					compareValuesDiffer.AddStateValue(
+						resourceName,
+						tfjsonpath.New("id"),
+					),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkBasicCredentialResourceExists(),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func basicCredentialWriteOnlyResourceConfig(name, password string, version int) string {
+	return fmt.Sprintf(`
+resource "datarobot_basic_credential" "test" {
+	  name = "%s"
+	  user = "example_user"
+	  password_wo = "%s"
+	  password_wo_version = %d
+}
+`, name, password, version)
+}
+
 func basicCredentialResourceConfig(name, description, user, password string) string {
 	return fmt.Sprintf(`
 resource "datarobot_basic_credential" "test" {
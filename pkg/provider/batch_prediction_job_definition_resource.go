@@ -429,6 +429,9 @@ func (r *BatchPredictionJobDefinitionResource) Schema(ctx context.Context, req r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -455,6 +458,8 @@ func (r *BatchPredictionJobDefinitionResource) Create(ctx context.Context, req r
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	createRequest, err := buildRequest(data)
 	if err != nil {
 		resp.Diagnostics.AddError("Error building Batch Prediction Job Definition request", err.Error())
@@ -464,7 +469,7 @@ func (r *BatchPredictionJobDefinitionResource) Create(ctx context.Context, req r
 	traceAPICall("CreateBatchPredictionJobDefinition")
 	batchPredictionJobDefinition, err := r.provider.service.CreateBatchPredictionJobDefinition(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Batch Prediction Job Definition", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Batch Prediction Job Definition", err)
 		return
 	}
 	data.ID = types.StringValue(batchPredictionJobDefinition.ID)
@@ -481,6 +486,8 @@ func (r *BatchPredictionJobDefinitionResource) Read(ctx context.Context, req res
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -594,6 +601,8 @@ func (r *BatchPredictionJobDefinitionResource) Update(ctx context.Context, req r
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	updateRequest, err := buildRequest(data)
 	if err != nil {
 		resp.Diagnostics.AddError("Error building Batch Prediction Job Definition request", err.Error())
@@ -603,7 +612,7 @@ func (r *BatchPredictionJobDefinitionResource) Update(ctx context.Context, req r
 	traceAPICall("UpdateBatchPredictionJobDefinition")
 	batchPredictionJobDefinition, err := r.provider.service.UpdateBatchPredictionJobDefinition(ctx, data.ID.ValueString(), updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Batch Prediction Job Definition", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Batch Prediction Job Definition", err)
 		return
 	}
 	data.Name = types.StringValue(batchPredictionJobDefinition.Name)
@@ -619,6 +628,8 @@ func (r *BatchPredictionJobDefinitionResource) Delete(ctx context.Context, req r
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteBatchPredictionJobDefinition")
 	err := r.provider.service.DeleteBatchPredictionJobDefinition(ctx, data.ID.ValueString())
 	if err != nil {
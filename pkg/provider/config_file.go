@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// where a provider setting was read from, in order of precedence
+	settingSourceAttribute  = "provider configuration"
+	settingSourceEnvVar     = "environment variable"
+	settingSourceConfigFile = "config file"
+	settingSourceDefault    = "default"
+)
+
+// drConfigProfile holds the settings of the DataRobot SDK/CLI config file that the provider uses.
+type drConfigProfile struct {
+	Endpoint     string `yaml:"endpoint"`
+	Token        string `yaml:"token"`
+	TraceContext string `yaml:"trace_context"`
+}
+
+// drConfigFile is the DataRobot SDK/CLI config file (drconfig.yaml). The top-level
+// settings are the default profile; named profiles are listed under "profiles".
+type drConfigFile struct {
+	drConfigProfile `yaml:",inline"`
+	Profiles        map[string]drConfigProfile `yaml:"profiles"`
+}
+
+// defaultConfigFilePath returns the path where the DataRobot SDK and CLI store their config file.
+func defaultConfigFilePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "datarobot", "drconfig.yaml")
+}
+
+// loadConfigProfile reads a profile of the DataRobot config file. configPath and profile
+// come from the provider configuration and fall back to DATAROBOT_CONFIG_FILE and
+// DATAROBOT_PROFILE. A missing file is only an error if its path or a profile was set;
+// in that case the returned path is empty.
+func loadConfigProfile(configPath, profile string) (drConfigProfile, string, error) {
+	if configPath == "" {
+		configPath = os.Getenv(DataRobotConfigFileEnvVar)
+	}
+	if profile == "" {
+		profile = os.Getenv(DataRobotProfileEnvVar)
+	}
+
+	explicitPath := configPath != ""
+	if !explicitPath {
+		configPath = defaultConfigFilePath()
+	} else if strings.HasPrefix(configPath, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			configPath = filepath.Join(home, configPath[2:])
+		}
+	}
+
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !explicitPath && profile == "" {
+			return drConfigProfile{}, "", nil
+		}
+		return drConfigProfile{}, "", fmt.Errorf("could not read DataRobot config file: %w", err)
+	}
+
+	var configFile drConfigFile
+	if err = yaml.Unmarshal(content, &configFile); err != nil {
+		return drConfigProfile{}, "", fmt.Errorf("could not parse DataRobot config file %s: %w", configPath, err)
+	}
+
+	if profile == "" {
+		return configFile.drConfigProfile, configPath, nil
+	}
+
+	// A named profile does not inherit the top-level settings, so that selecting e.g. a
+	// staging profile can never send the default profile's token to another endpoint.
+	config, ok := configFile.Profiles[profile]
+	if !ok {
+		profiles := make([]string, 0, len(configFile.Profiles))
+		for name := range configFile.Profiles {
+			profiles = append(profiles, name)
+		}
+		sort.Strings(profiles)
+		return drConfigProfile{}, "", fmt.Errorf("profile %q not found in DataRobot config file %s, available profiles: [%s]",
+			profile, configPath, strings.Join(profiles, ", "))
+	}
+	return config, configPath, nil
+}
+
+// resolveProviderSetting returns the value of a provider setting and where it was read from:
+// the provider configuration, then the environment variable, then the config file.
+func resolveProviderSetting(attribute types.String, envVar, configFileValue string) (string, string) {
+	if IsKnown(attribute) {
+		return attribute.ValueString(), settingSourceAttribute
+	}
+	if value := os.Getenv(envVar); value != "" {
+		return value, settingSourceEnvVar
+	}
+	if configFileValue != "" {
+		return configFileValue, settingSourceConfigFile
+	}
+	return "", settingSourceDefault
+}
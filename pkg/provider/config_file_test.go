@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testConfigFile = `endpoint: https://app.datarobot.com/api/v2
+token: default-token
+profiles:
+  staging:
+    endpoint: https://staging.datarobot.com/api/v2
+    token: staging-token
+    trace_context: staging-trace
+  dev:
+    token: dev-token
+`
+
+func writeTestConfigFile(t *testing.T) string {
+	t.Helper()
+	configPath := filepath.Join(t.TempDir(), "drconfig.yaml")
+	if err := os.WriteFile(configPath, []byte(testConfigFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return configPath
+}
+
+func TestLoadConfigProfile(t *testing.T) {
+	configPath := writeTestConfigFile(t)
+	t.Setenv(DataRobotConfigFileEnvVar, "")
+	t.Setenv(DataRobotProfileEnvVar, "")
+
+	config, path, err := loadConfigProfile(configPath, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if path != configPath || config.Endpoint != "https://app.datarobot.com/api/v2" || config.Token != "default-token" {
+		t.Errorf("unexpected default profile %+v from %s", config, path)
+	}
+
+	config, _, err = loadConfigProfile(configPath, "staging")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Endpoint != "https://staging.datarobot.com/api/v2" || config.Token != "staging-token" || config.TraceContext != "staging-trace" {
+		t.Errorf("unexpected staging profile %+v", config)
+	}
+
+	// named profiles do not inherit the top-level settings
+	config, _, err = loadConfigProfile(configPath, "dev")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Endpoint != "" || config.Token != "dev-token" {
+		t.Errorf("unexpected dev profile %+v", config)
+	}
+
+	_, _, err = loadConfigProfile(configPath, "prod")
+	if err == nil || !strings.Contains(err.Error(), "available profiles: [dev, staging]") {
+		t.Errorf("expected profile not found error, got %v", err)
+	}
+}
+
+func TestLoadConfigProfileFromEnv(t *testing.T) {
+	t.Setenv(DataRobotConfigFileEnvVar, writeTestConfigFile(t))
+	t.Setenv(DataRobotProfileEnvVar, "staging")
+
+	config, _, err := loadConfigProfile("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Token != "staging-token" {
+		t.Errorf("expected the staging profile, got %+v", config)
+	}
+}
+
+func TestLoadConfigProfileMissingFile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(DataRobotConfigFileEnvVar, "")
+	t.Setenv(DataRobotProfileEnvVar, "")
+
+	// the default config file is optional
+	config, path, err := loadConfigProfile("", "")
+	if err != nil || path != "" || config != (drConfigProfile{}) {
+		t.Errorf("expected no config, got %+v from %q: %v", config, path, err)
+	}
+
+	if _, _, err = loadConfigProfile(filepath.Join(t.TempDir(), "missing.yaml"), ""); err == nil {
+		t.Error("expected an error for a missing config_path")
+	}
+	if _, _, err = loadConfigProfile("", "staging"); err == nil {
+		t.Error("expected an error for a profile without config file")
+	}
+}
+
+func TestResolveProviderSetting(t *testing.T) {
+	t.Setenv(DataRobotEndpointEnvVar, "https://env.datarobot.com/api/v2")
+
+	tests := []struct {
+		name            string
+		attribute       types.String
+		envVar          string
+		configFileValue string
+		expectedValue   string
+		expectedSource  string
+	}{
+		{"attribute", types.StringValue("https://hcl.datarobot.com/api/v2"), DataRobotEndpointEnvVar, "https://file.datarobot.com/api/v2", "https://hcl.datarobot.com/api/v2", settingSourceAttribute},
+		{"environment variable", types.StringNull(), DataRobotEndpointEnvVar, "https://file.datarobot.com/api/v2", "https://env.datarobot.com/api/v2", settingSourceEnvVar},
+		{"config file", types.StringNull(), "DATAROBOT_UNSET_TEST_VAR", "https://file.datarobot.com/api/v2", "https://file.datarobot.com/api/v2", settingSourceConfigFile},
+		{"default", types.StringNull(), "DATAROBOT_UNSET_TEST_VAR", "", "", settingSourceDefault},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, source := resolveProviderSetting(tt.attribute, tt.envVar, tt.configFileValue)
+			if value != tt.expectedValue || source != tt.expectedSource {
+				t.Errorf("got %q from %s, want %q from %s", value, source, tt.expectedValue, tt.expectedSource)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &CredentialSecretEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &CredentialSecretEphemeralResource{}
+
+func NewCredentialSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &CredentialSecretEphemeralResource{}
+}
+
+// CredentialSecretEphemeralResource defines the ephemeral resource implementation.
+type CredentialSecretEphemeralResource struct {
+	provider *Provider
+}
+
+func (r *CredentialSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_secret"
+}
+
+func (r *CredentialSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reads back the secret values of a stored credential without storing them in the Terraform state. Only the attributes that apply to the credential type are set. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the credential.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the credential.",
+				Computed:            true,
+			},
+			"credential_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the credential, e.g. `basic`, `api_token`, `s3`, `gcp` or `azure`.",
+				Computed:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The user of a `basic` credential.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of a `basic` credential.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The token of an OAuth credential.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"api_token": schema.StringAttribute{
+				MarkdownDescription: "The token of an `api_token` credential.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"aws_access_key_id": schema.StringAttribute{
+				MarkdownDescription: "The AWS access key ID of an `s3` credential.",
+				Computed:            true,
+			},
+			"aws_secret_access_key": schema.StringAttribute{
+				MarkdownDescription: "The AWS secret access key of an `s3` credential.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"aws_session_token": schema.StringAttribute{
+				MarkdownDescription: "The AWS session token of an `s3` credential.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"azure_connection_string": schema.StringAttribute{
+				MarkdownDescription: "The connection string of an `azure` credential.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"gcp_key": schema.StringAttribute{
+				MarkdownDescription: "The GCP key of a `gcp` credential, in JSON format.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *CredentialSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if r.provider, ok = req.ProviderData.(*Provider); !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected  %T, got: %T. Please report this issue to the provider developers.", Provider{}, req.ProviderData),
+		)
+	}
+}
+
+func (r *CredentialSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data CredentialSecretEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	traceAPICall("GetCredentialSecret")
+	credential, err := r.provider.service.GetCredentialSecret(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting credential secret",
+			fmt.Sprintf("Could not get the secret of credential with ID %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.Name = types.StringValue(credential.Name)
+	data.CredentialType = types.StringValue(credential.CredentialType)
+	data.User = optionalStringValueFromString(credential.User)
+	data.Password = optionalStringValueFromString(credential.Password)
+	data.Token = optionalStringValueFromString(credential.Token)
+	data.ApiToken = optionalStringValueFromString(credential.ApiToken)
+	data.AWSAccessKeyID = optionalStringValueFromString(credential.AWSAccessKeyID)
+	data.AWSSecretAccessKey = optionalStringValueFromString(credential.AWSSecretAccessKey)
+	data.AWSSessionToken = optionalStringValueFromString(credential.AWSSessionToken)
+	data.AzureConnectionString = optionalStringValueFromString(credential.AzureConnectionString)
+	data.GCPKey = types.StringNull()
+	if credential.GCPKey != nil {
+		gcpKey, err := json.Marshal(credential.GCPKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading GCP key", err.Error())
+			return
+		}
+		data.GCPKey = types.StringValue(string(gcpKey))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// cronFields lists the fields of a cron expression in order, with the range of values each one accepts.
+var cronFields = []struct {
+	name     string
+	min, max int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day_of_month", 1, 31},
+	{"month", 1, 12},
+	{"day_of_week", 0, 7},
+}
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &CronScheduleFunction{}
+
+func NewCronScheduleFunction() function.Function {
+	return &CronScheduleFunction{}
+}
+
+// CronScheduleFunction converts a cron expression to a schedule object.
+type CronScheduleFunction struct{}
+
+func (f *CronScheduleFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_schedule"
+}
+
+func (f *CronScheduleFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a cron expression to a schedule",
+		MarkdownDescription: "Converts a five-field cron expression (`minute hour day_of_month month day_of_week`) to the `schedule` object " +
+			"accepted by `datarobot_custom_job` and `datarobot_batch_prediction_job_definition`. Fields accept `*`, single values, " +
+			"comma-separated lists, ranges (`1-5`) and steps (`*/15`, `0-30/10`), which are expanded to the list of values they match. " +
+			"The `@yearly`, `@annually`, `@monthly`, `@weekly`, `@daily`, `@midnight` and `@hourly` macros are also accepted. " +
+			"A `day_of_week` of `7` is treated as Sunday (`0`).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "The cron expression, e.g. `0 */6 * * 1-5`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: scheduleAttributeTypes(),
+		},
+	}
+}
+
+func (f *CronScheduleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression string
+
+	resp.Error = req.Arguments.Get(ctx, &expression)
+	if resp.Error != nil {
+		return
+	}
+
+	schedule, err := parseCronSchedule(expression)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, schedule)
+}
+
+func scheduleAttributeTypes() map[string]attr.Type {
+	attributeTypes := make(map[string]attr.Type, len(cronFields))
+	for _, field := range cronFields {
+		attributeTypes[field.name] = types.ListType{ElemType: types.StringType}
+	}
+	return attributeTypes
+}
+
+// parseCronSchedule converts a cron expression to a Schedule that convertSchedule accepts.
+func parseCronSchedule(expression string) (schedule Schedule, err error) {
+	expression = strings.TrimSpace(expression)
+	if macro, ok := cronMacros[strings.ToLower(expression)]; ok {
+		expression = macro
+	}
+
+	parts := strings.Fields(expression)
+	if len(parts) != len(cronFields) {
+		err = fmt.Errorf("invalid cron expression %q: expected 5 fields (minute hour day_of_month month day_of_week), got %d", expression, len(parts))
+		return
+	}
+
+	values := make([][]types.String, len(cronFields))
+	for i, field := range cronFields {
+		var matched map[int]bool
+		if matched, err = parseCronField(parts[i], field.min, field.max); err != nil {
+			err = fmt.Errorf("invalid %s in cron expression %q: %w", field.name, expression, err)
+			return
+		}
+		// cron accepts both 0 and 7 for Sunday, DataRobot only 0
+		if field.name == "day_of_week" && matched[7] {
+			delete(matched, 7)
+			matched[0] = true
+		}
+		values[i] = cronFieldValues(matched)
+	}
+
+	schedule = Schedule{
+		Minute:     values[0],
+		Hour:       values[1],
+		DayOfMonth: values[2],
+		Month:      values[3],
+		DayOfWeek:  values[4],
+	}
+
+	// make sure the result is something the custom job and batch prediction resources can send
+	_, err = convertSchedule(schedule)
+	return
+}
+
+// parseCronField returns the set of values a single cron field matches, or nil for "*".
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	if field == "*" || field == "*/1" {
+		return nil, nil
+	}
+
+	matched := make(map[int]bool)
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+			rangePart = part[:i]
+		}
+
+		start, end := min, max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var startErr, endErr error
+			start, startErr = strconv.Atoi(bounds[0])
+			end, endErr = strconv.Atoi(bounds[1])
+			if startErr != nil || endErr != nil {
+				return nil, fmt.Errorf("invalid range %q", rangePart)
+			}
+		default:
+			value, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q", rangePart)
+			}
+			start = value
+			// a single value with a step runs from that value to the end of the range
+			if rangePart == part {
+				end = value
+			}
+		}
+
+		if start < min || end > max || start > end {
+			return nil, fmt.Errorf("%q is out of range %d-%d", part, min, max)
+		}
+		for value := start; value <= end; value += step {
+			matched[value] = true
+		}
+	}
+	return matched, nil
+}
+
+// cronFieldValues formats the values matched by a cron field as a schedule list.
+func cronFieldValues(matched map[int]bool) []types.String {
+	if matched == nil {
+		return []types.String{types.StringValue("*")}
+	}
+
+	values := make([]int, 0, len(matched))
+	for value := range matched {
+		values = append(values, value)
+	}
+	sort.Ints(values)
+
+	result := make([]types.String, len(values))
+	for i, value := range values {
+		result[i] = types.StringValue(strconv.Itoa(value))
+	}
+	return result
+}
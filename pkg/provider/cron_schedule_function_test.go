@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expression string
+		expected   [][]string
+	}{
+		{"* * * * *", [][]string{{"*"}, {"*"}, {"*"}, {"*"}, {"*"}}},
+		{"0 */6 * * 1-5", [][]string{{"0"}, {"0", "6", "12", "18"}, {"*"}, {"*"}, {"1", "2", "3", "4", "5"}}},
+		{"15,45 9 1 1-12/3 7", [][]string{{"15", "45"}, {"9"}, {"1"}, {"1", "4", "7", "10"}, {"0"}}},
+		{"50/5 0 * * *", [][]string{{"50", "55"}, {"0"}, {"*"}, {"*"}, {"*"}}},
+		{"@weekly", [][]string{{"0"}, {"0"}, {"*"}, {"*"}, {"0"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			schedule, err := parseCronSchedule(tt.expression)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for i, actual := range [][]types.String{schedule.Minute, schedule.Hour, schedule.DayOfMonth, schedule.Month, schedule.DayOfWeek} {
+				values := make([]string, len(actual))
+				for j, value := range actual {
+					values[j] = value.ValueString()
+				}
+				if !reflect.DeepEqual(values, tt.expected[i]) {
+					t.Errorf("%s: expected %v, got %v", cronFields[i].name, tt.expected[i], values)
+				}
+			}
+		})
+	}
+}
+
+func TestCronScheduleFunctionInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, expression := range []string{"* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "MON * * * *"} {
+		if _, funcErr := runFunction(t, NewCronScheduleFunction(), types.StringValue(expression)); funcErr == nil {
+			t.Errorf("%q: expected an error", expression)
+		}
+	}
+
+	result, funcErr := runFunction(t, NewCronScheduleFunction(), types.StringValue("0 0 * * *"))
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+	if _, ok := result.(types.Object); !ok {
+		t.Errorf("expected an object, got %T", result)
+	}
+}
@@ -23,6 +23,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CustomApplicationFromEnvironmentResource{}
 var _ resource.ResourceWithImportState = &CustomApplicationFromEnvironmentResource{}
+var _ resource.ResourceWithModifyPlan = &CustomApplicationFromEnvironmentResource{}
 
 func NewCustomApplicationFromEnvironmentResource() resource.Resource {
 	return &CustomApplicationFromEnvironmentResource{}
@@ -145,6 +146,14 @@ func (r *CustomApplicationFromEnvironmentResource) Schema(ctx context.Context, r
 				MarkdownDescription: "The list of Use Case IDs to add the Custom Application to.",
 				ElementType:         types.StringType,
 			},
+			"use_case_ids_all": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of Use Case IDs the Custom Application is added to: `use_case_ids` and the provider's `default_use_case_ids`.",
+				ElementType:         types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -172,6 +181,8 @@ func (r *CustomApplicationFromEnvironmentResource) Create(ctx context.Context, r
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateCustomApplication")
 	createRequest := &client.CreateCustomApplicationRequest{
 		EnvironmentID: data.EnvironmentID.ValueString(),
@@ -189,7 +200,7 @@ func (r *CustomApplicationFromEnvironmentResource) Create(ctx context.Context, r
 
 	application, err := r.provider.service.CreateCustomApplication(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Custom Application", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Application", err)
 		return
 	}
 
@@ -220,8 +231,7 @@ func (r *CustomApplicationFromEnvironmentResource) Create(ctx context.Context, r
 		traceAPICall("UpdateCustomApplication")
 		_, err = r.provider.service.UpdateApplication(ctx, application.ID, updateRequest)
 		if err != nil {
-			errMessage := checkApplicationNameAlreadyExists(err, data.Name.ValueString())
-			resp.Diagnostics.AddError("Error adding details to Custom Application", errMessage)
+			addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error adding details to Custom Application", err, data.Name.ValueString(), "Application")
 			return
 		}
 	}
@@ -250,7 +260,7 @@ func (r *CustomApplicationFromEnvironmentResource) Create(ctx context.Context, r
 		data.Resources = types.ObjectNull(applicationResourcesAttrTypes())
 	}
 
-	for _, useCaseID := range data.UseCaseIDs {
+	for _, useCaseID := range effectiveUseCaseIDs(data.UseCaseIDsAll, data.UseCaseIDs) {
 		traceAPICall("AddCustomApplicationToUseCase")
 		if err = addEntityToUseCase(
 			ctx,
@@ -259,7 +269,7 @@ func (r *CustomApplicationFromEnvironmentResource) Create(ctx context.Context, r
 			"customApplication",
 			application.ID,
 		); err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error adding Custom Application to Use Case %s", useCaseID), err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error adding Custom Application to Use Case %s", useCaseID), err)
 			return
 		}
 	}
@@ -275,6 +285,8 @@ func (r *CustomApplicationFromEnvironmentResource) Read(ctx context.Context, req
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -325,6 +337,8 @@ func (r *CustomApplicationFromEnvironmentResource) Update(ctx context.Context, r
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state CustomApplicationFromEnvironmentResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -369,8 +383,7 @@ func (r *CustomApplicationFromEnvironmentResource) Update(ctx context.Context, r
 				fmt.Sprintf("Custom Application with ID %s is not found. Removing from state.", plan.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			errMessage := checkApplicationNameAlreadyExists(err, plan.Name.ValueString())
-			resp.Diagnostics.AddError("Error updating Custom Application", errMessage)
+			addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Application", err, plan.Name.ValueString(), "Application")
 		}
 		return
 	}
@@ -402,10 +415,10 @@ func (r *CustomApplicationFromEnvironmentResource) Update(ctx context.Context, r
 		r.provider.service,
 		"customApplication",
 		application.ID,
-		state.UseCaseIDs,
-		plan.UseCaseIDs,
+		effectiveUseCaseIDs(state.UseCaseIDsAll, state.UseCaseIDs),
+		effectiveUseCaseIDs(plan.UseCaseIDsAll, plan.UseCaseIDs),
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating Use Cases for Custom Application", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Use Cases for Custom Application", err)
 		return
 	}
 
@@ -420,6 +433,8 @@ func (r *CustomApplicationFromEnvironmentResource) Delete(ctx context.Context, r
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteCustomApplication")
 	err := r.provider.service.DeleteApplication(ctx, data.ID.ValueString())
 	if err != nil {
@@ -433,3 +448,7 @@ func (r *CustomApplicationFromEnvironmentResource) Delete(ctx context.Context, r
 func (r *CustomApplicationFromEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *CustomApplicationFromEnvironmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.provider.modifyPlanUseCaseIDsAll(ctx, req, resp)
+}
@@ -24,6 +24,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CustomApplicationResource{}
 var _ resource.ResourceWithImportState = &CustomApplicationResource{}
+var _ resource.ResourceWithModifyPlan = &CustomApplicationResource{}
 
 func NewCustomApplicationResource() resource.Resource {
 	return &CustomApplicationResource{}
@@ -150,6 +151,11 @@ func (r *CustomApplicationResource) Schema(ctx context.Context, req resource.Sch
 				MarkdownDescription: "The list of Use Case IDs to add the Custom Application to.",
 				ElementType:         types.StringType,
 			},
+			"use_case_ids_all": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of Use Case IDs the Custom Application is added to: `use_case_ids` and the provider's `default_use_case_ids`.",
+				ElementType:         types.StringType,
+			},
 			"required_key_scope_level": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "The API key scope level required for requests to this custom application. Can be set to 'viewer', 'user', or 'admin'.",
@@ -158,6 +164,9 @@ func (r *CustomApplicationResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -184,6 +193,8 @@ func (r *CustomApplicationResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateCustomApplication")
 	createRequest := &client.CreateCustomApplicationRequest{
 		ApplicationSourceVersionID: data.SourceVersionID.ValueString(),
@@ -205,7 +216,7 @@ func (r *CustomApplicationResource) Create(ctx context.Context, req resource.Cre
 
 	application, err := r.provider.service.CreateCustomApplication(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Custom Application", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Application", err)
 		return
 	}
 
@@ -236,8 +247,7 @@ func (r *CustomApplicationResource) Create(ctx context.Context, req resource.Cre
 		traceAPICall("UpdateCustomApplication")
 		_, err = r.provider.service.UpdateApplication(ctx, application.ID, updateRequest)
 		if err != nil {
-			errMessage := checkApplicationNameAlreadyExists(err, data.Name.ValueString())
-			resp.Diagnostics.AddError("Error adding details to Custom Application", errMessage)
+			addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error adding details to Custom Application", err, data.Name.ValueString(), "Application")
 			return
 		}
 	}
@@ -266,7 +276,7 @@ func (r *CustomApplicationResource) Create(ctx context.Context, req resource.Cre
 		data.Resources = types.ObjectNull(applicationResourcesAttrTypes())
 	}
 
-	for _, useCaseID := range data.UseCaseIDs {
+	for _, useCaseID := range effectiveUseCaseIDs(data.UseCaseIDsAll, data.UseCaseIDs) {
 		traceAPICall("AddCustomApplicationToUseCase")
 		if err = addEntityToUseCase(
 			ctx,
@@ -275,7 +285,7 @@ func (r *CustomApplicationResource) Create(ctx context.Context, req resource.Cre
 			"customApplication",
 			application.ID,
 		); err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error adding Custom Application to Use Case %s", useCaseID), err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error adding Custom Application to Use Case %s", useCaseID), err)
 			return
 		}
 	}
@@ -294,6 +304,8 @@ func (r *CustomApplicationResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -348,6 +360,8 @@ func (r *CustomApplicationResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state CustomApplicationResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -406,8 +420,7 @@ func (r *CustomApplicationResource) Update(ctx context.Context, req resource.Upd
 				fmt.Sprintf("Custom Application with ID %s is not found. Removing from state.", plan.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			errMessage := checkApplicationNameAlreadyExists(err, plan.Name.ValueString())
-			resp.Diagnostics.AddError("Error updating Custom Application", errMessage)
+			addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Application", err, plan.Name.ValueString(), "Application")
 		}
 		return
 	}
@@ -442,10 +455,10 @@ func (r *CustomApplicationResource) Update(ctx context.Context, req resource.Upd
 		r.provider.service,
 		"customApplication",
 		application.ID,
-		state.UseCaseIDs,
-		plan.UseCaseIDs,
+		effectiveUseCaseIDs(state.UseCaseIDsAll, state.UseCaseIDs),
+		effectiveUseCaseIDs(plan.UseCaseIDsAll, plan.UseCaseIDs),
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating Use Cases for Custom Application", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Use Cases for Custom Application", err)
 		return
 	}
 
@@ -460,6 +473,8 @@ func (r *CustomApplicationResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteCustomApplication")
 	err := r.provider.service.DeleteApplication(ctx, data.ID.ValueString())
 	if err != nil {
@@ -473,3 +488,7 @@ func (r *CustomApplicationResource) Delete(ctx context.Context, req resource.Del
 func (r *CustomApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *CustomApplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.provider.modifyPlanUseCaseIDsAll(ctx, req, resp)
+}
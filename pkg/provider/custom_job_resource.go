@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -125,6 +126,12 @@ func (r *CustomJobResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "The hash of file contents for each file in files.",
 				ElementType:         types.StringType,
 			},
+			"file_manifest": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 hash of each file uploaded from `folder_path` and `files`, by its path in the Custom Job. Only the files that changed since the last apply are uploaded.",
+				ElementType:         types.StringType,
+			},
+			"pending_changes": pendingChangesAttribute("`folder_path` and `files`"),
 			"egress_network_policy": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -173,6 +180,9 @@ func (r *CustomJobResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "The ID of the schedule associated with the custom job.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -199,6 +209,8 @@ func (r *CustomJobResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	customJob, err := r.provider.service.CreateCustomJob(ctx, &client.CreateCustomJobRequest{
 		Name:                 data.Name.ValueString(),
 		Description:          StringValuePointerOptional(data.Description),
@@ -211,19 +223,13 @@ func (r *CustomJobResource) Create(ctx context.Context, req resource.CreateReque
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Custom Job", err.Error())
-		return
-	}
-
-	localFiles, err := prepareLocalFiles(data.FolderPath, data.Files)
-	if err != nil {
-		resp.Diagnostics.AddError("Error preparing local files", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Job", err)
 		return
 	}
 
-	customJob, err = r.provider.service.UpdateCustomJobFiles(ctx, customJob.ID, localFiles)
+	customJob, data.FileManifest, err = syncCustomJobFiles(ctx, r.provider.service, r.provider.fileHashCache(), customJob, data.FolderPath, data.Files, types.MapNull(types.StringType))
 	if err != nil {
-		resp.Diagnostics.AddError("Error adding Custom Job files", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error adding Custom Job files", err)
 		return
 	}
 
@@ -253,7 +259,7 @@ func (r *CustomJobResource) Create(ctx context.Context, req resource.CreateReque
 
 		scheduleResponse, err := r.provider.service.CreateCustomJobSchedule(ctx, customJob.ID, scheduleRequest)
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating Custom Job Schedule", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Job Schedule", err)
 			return
 		}
 
@@ -288,6 +294,8 @@ func (r *CustomJobResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -349,6 +357,7 @@ func (r *CustomJobResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 	data.EgressNetworkPolicy = types.StringValue(customJob.Resources.EgressNetworkPolicy)
 
+	data.PendingChanges = types.ObjectNull(pendingChangesAttrTypes)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -360,21 +369,26 @@ func (r *CustomJobResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if !reflect.DeepEqual(plan.FilesHashes, state.FilesHashes) ||
+	if !reflect.DeepEqual(plan.Files, state.Files) ||
+		!reflect.DeepEqual(plan.FilesHashes, state.FilesHashes) ||
 		plan.FolderPathHash != state.FolderPathHash {
-		localFiles, err := prepareLocalFiles(plan.FolderPath, plan.Files)
+		traceAPICall("GetCustomJob")
+		customJob, err := r.provider.service.GetCustomJob(ctx, plan.ID.ValueString())
 		if err != nil {
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error getting Custom Job", err)
 			return
 		}
 
-		_, err = r.provider.service.UpdateCustomJobFiles(ctx, plan.ID.ValueString(), localFiles)
+		_, plan.FileManifest, err = syncCustomJobFiles(ctx, r.provider.service, r.provider.fileHashCache(), customJob, plan.FolderPath, plan.Files, state.FileManifest)
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Custom Job files", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Job files", err)
 			return
 		}
 	}
@@ -394,12 +408,12 @@ func (r *CustomJobResource) Update(ctx context.Context, req resource.UpdateReque
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Custom Job", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Job", err)
 		return
 	}
 
 	if err = r.updateRuntimeParameterValuesUnified(ctx, plan.ID.ValueString(), plan.Name.ValueString(), state, plan); err != nil {
-		resp.Diagnostics.AddError("Error updating runtime parameter values", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating runtime parameter values", err)
 		return
 	}
 
@@ -419,7 +433,7 @@ func (r *CustomJobResource) Update(ctx context.Context, req resource.UpdateReque
 			// Create a new schedule if ScheduleID does not exist
 			scheduleResponse, err := r.provider.service.CreateCustomJobSchedule(ctx, plan.ID.ValueString(), scheduleRequest)
 			if err != nil {
-				resp.Diagnostics.AddError("Error creating Custom Job Schedule", err.Error())
+				addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Job Schedule", err)
 				return
 			}
 			plan.ScheduleID = types.StringValue(scheduleResponse.ID)
@@ -427,7 +441,7 @@ func (r *CustomJobResource) Update(ctx context.Context, req resource.UpdateReque
 			// Update the existing schedule
 			_, err := r.provider.service.UpdateCustomJobSchedule(ctx, plan.ID.ValueString(), state.ScheduleID.ValueString(), scheduleRequest)
 			if err != nil {
-				resp.Diagnostics.AddError("Error updating Custom Job Schedule", err.Error())
+				addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Job Schedule", err)
 				return
 			}
 		}
@@ -437,6 +451,38 @@ func (r *CustomJobResource) Update(ctx context.Context, req resource.UpdateReque
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
+// syncCustomJobFiles uploads the local files that changed since priorManifest and
+// removes the files they replace, in a single update of the Custom Job. It returns the
+// manifest to store in state.
+func syncCustomJobFiles(
+	ctx context.Context,
+	service client.Service,
+	cache *artifactsource.HashCache,
+	customJob *client.CustomJob,
+	folderPath types.String,
+	files types.Dynamic,
+	priorManifest types.Map,
+) (
+	*client.CustomJob,
+	types.Map,
+	error,
+) {
+	filesSync, err := planLocalFilesSync(ctx, cache, folderPath, files, priorManifest)
+	if err != nil {
+		return nil, types.MapNull(types.StringType), err
+	}
+	if filesSync.IsEmpty() {
+		return customJob, filesSync.Manifest, nil
+	}
+
+	traceAPICall("UpdateCustomJobFiles")
+	customJob, err = service.UpdateCustomJobFiles(ctx, customJob.ID, filesSync.Uploads, filesSync.replacedItemIDs(customJob.Items))
+	if err != nil {
+		return nil, types.MapNull(types.StringType), err
+	}
+	return customJob, filesSync.Manifest, nil
+}
+
 func (r *CustomJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data CustomJobResourceModel
 
@@ -444,6 +490,8 @@ func (r *CustomJobResource) Delete(ctx context.Context, req resource.DeleteReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
 	// Delete the schedule
 	if data.Schedule != nil {
 		err := r.provider.service.DeleteCustomJobSchedule(ctx, data.ID.ValueString(), data.ScheduleID.ValueString())
@@ -487,7 +535,7 @@ func (r CustomJobResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 	}
 	plan.FilesHashes = filesHashes
 
-	folderPathHash, err := computeFolderHash(plan.FolderPath)
+	folderPathHash, err := computeFolderHash(ctx, plan.FolderPath, r.provider.fileHashCache())
 	if err != nil {
 		resp.Diagnostics.AddError("Error calculating folder path hash", err.Error())
 		return
@@ -498,6 +546,8 @@ func (r CustomJobResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 
 	if req.State.Raw.IsNull() {
 		// resource is being created
+		plan.PendingChanges = previewLocalFileChanges(ctx, &resp.Diagnostics, r.provider.fileHashCache(), plan.FolderPath, plan.Files, types.MapNull(types.StringType))
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 		return
 	}
 
@@ -508,6 +558,19 @@ func (r CustomJobResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 		return
 	}
 
+	// the manifest of changed local files is only known after they are uploaded
+	plan.FileManifest = state.FileManifest
+	plan.PendingChanges = state.PendingChanges
+	if !reflect.DeepEqual(plan.Files, state.Files) ||
+		!reflect.DeepEqual(plan.FilesHashes, state.FilesHashes) ||
+		plan.FolderPathHash != state.FolderPathHash {
+		plan.FileManifest = types.MapUnknown(types.StringType)
+		plan.PendingChanges = previewLocalFileChanges(ctx, &resp.Diagnostics, r.provider.fileHashCache(), plan.FolderPath, plan.Files, state.FileManifest)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if !IsKnown(plan.EnvironmentID) {
 		if plan.EnvironmentVersionID == state.EnvironmentVersionID {
 			// use state environment id if environment version id is not changed
@@ -621,7 +621,7 @@ func TestIntegrationCustomJobResourceRuntimeParameters(t *testing.T) {
 
 	// Create: files upload
 	mockService.EXPECT().
-		UpdateCustomJobFiles(gomock.Any(), jobID, gomock.Any()).
+		UpdateCustomJobFiles(gomock.Any(), jobID, gomock.Any(), gomock.Any()).
 		Return(customJob, nil)
 
 	// Create: runtime_parameters call (key assertion – must have RuntimeParameters set)
@@ -696,7 +696,7 @@ func TestIntegrationCustomJobResourceRuntimeParametersOldAPI(t *testing.T) {
 		CreateCustomJob(gomock.Any(), gomock.Any()).
 		Return(customJob, nil)
 	mockService.EXPECT().
-		UpdateCustomJobFiles(gomock.Any(), jobID, gomock.Any()).
+		UpdateCustomJobFiles(gomock.Any(), jobID, gomock.Any(), gomock.Any()).
 		Return(customJob, nil)
 
 	// ...v2 probe fails with old-API error...
@@ -175,6 +175,9 @@ func (r *CustomMetricFromJobResource) Schema(ctx context.Context, req resource.S
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -201,6 +204,8 @@ func (r *CustomMetricFromJobResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	request := &client.CreateCustomMetricFromJobRequest{
 		CustomJobID: data.CustomJobID.ValueString(),
 		Name:        data.Name.ValueString(),
@@ -259,7 +264,7 @@ func (r *CustomMetricFromJobResource) Create(ctx context.Context, req resource.C
 
 	customMetric, err := r.provider.service.CreateCustomMetricFromJob(ctx, data.DeploymentID.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Custom Metric", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Metric", err)
 		return
 	}
 	data.ID = types.StringValue(customMetric.ID)
@@ -276,6 +281,8 @@ func (r *CustomMetricFromJobResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -314,6 +321,8 @@ func (r *CustomMetricFromJobResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	request := &client.UpdateCustomMetricRequest{
 		Name:        StringValuePointerOptional(data.Name),
 		Description: StringValuePointerOptional(data.Description),
@@ -353,7 +362,7 @@ func (r *CustomMetricFromJobResource) Update(ctx context.Context, req resource.U
 
 	_, err := r.provider.service.UpdateCustomMetric(ctx, data.DeploymentID.ValueString(), data.ID.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Custom Metric", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Metric", err)
 		return
 	}
 
@@ -368,6 +377,8 @@ func (r *CustomMetricFromJobResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteCustomMetric")
 	err := r.provider.service.DeleteCustomMetric(ctx, data.DeploymentID.ValueString(), data.ID.ValueString())
 	if err != nil {
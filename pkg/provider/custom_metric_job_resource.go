@@ -109,6 +109,12 @@ func (r *CustomMetricJobResource) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "The hash of file contents for each file in files.",
 				ElementType:         types.StringType,
 			},
+			"file_manifest": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 hash of each file uploaded from `folder_path` and `files`, by its path in the Custom Metric Job. Only the files that changed since the last apply are uploaded.",
+				ElementType:         types.StringType,
+			},
+			"pending_changes": pendingChangesAttribute("`folder_path` and `files`"),
 			"egress_network_policy": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -153,6 +159,9 @@ func (r *CustomMetricJobResource) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "Determines whether the metric is related to the model or deployment.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -179,6 +188,8 @@ func (r *CustomMetricJobResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	customMetricJob, err := r.provider.service.CreateCustomJob(ctx, &client.CreateCustomJobRequest{
 		Name:                 data.Name.ValueString(),
 		Description:          StringValuePointerOptional(data.Description),
@@ -191,18 +202,13 @@ func (r *CustomMetricJobResource) Create(ctx context.Context, req resource.Creat
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Custom Job", err.Error())
-		return
-	}
-
-	localFiles, err := prepareLocalFiles(data.FolderPath, data.Files)
-	if err != nil {
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Job", err)
 		return
 	}
 
-	customMetricJob, err = r.provider.service.UpdateCustomJobFiles(ctx, customMetricJob.ID, localFiles)
+	customMetricJob, data.FileManifest, err = syncCustomJobFiles(ctx, r.provider.service, r.provider.fileHashCache(), customMetricJob, data.FolderPath, data.Files, types.MapNull(types.StringType))
 	if err != nil {
-		resp.Diagnostics.AddError("Error adding Custom Job files", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error adding Custom Job files", err)
 		return
 	}
 
@@ -217,7 +223,7 @@ func (r *CustomMetricJobResource) Create(ctx context.Context, req resource.Creat
 			Name:                   customMetricJob.Name,
 			RuntimeParameterValues: runtimeParameterValues,
 		}); err != nil {
-			resp.Diagnostics.AddError("Error adding runtime parameter values to Custom Job", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error adding runtime parameter values to Custom Job", err)
 			return
 		}
 	}
@@ -234,7 +240,7 @@ func (r *CustomMetricJobResource) Create(ctx context.Context, req resource.Creat
 		IsModelSpecific: data.IsModelSpecific.ValueBool(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Hosted Custom Metric Template", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Hosted Custom Metric Template", err)
 		return
 	}
 
@@ -260,6 +266,8 @@ func (r *CustomMetricJobResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -310,6 +318,7 @@ func (r *CustomMetricJobResource) Read(ctx context.Context, req resource.ReadReq
 	data.TimeStep = types.StringValue(hostedCustomMetricTemplate.TimeStep)
 	data.IsModelSpecific = types.BoolValue(hostedCustomMetricTemplate.IsModelSpecific)
 
+	data.PendingChanges = types.ObjectNull(pendingChangesAttrTypes)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
@@ -321,21 +330,26 @@ func (r *CustomMetricJobResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if !reflect.DeepEqual(plan.FilesHashes, state.FilesHashes) ||
+	if !reflect.DeepEqual(plan.Files, state.Files) ||
+		!reflect.DeepEqual(plan.FilesHashes, state.FilesHashes) ||
 		plan.FolderPathHash != state.FolderPathHash {
-		localFiles, err := prepareLocalFiles(plan.FolderPath, plan.Files)
+		traceAPICall("GetCustomJob")
+		customMetricJob, err := r.provider.service.GetCustomJob(ctx, plan.ID.ValueString())
 		if err != nil {
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error getting Custom Job", err)
 			return
 		}
 
-		_, err = r.provider.service.UpdateCustomJobFiles(ctx, plan.ID.ValueString(), localFiles)
+		_, plan.FileManifest, err = syncCustomJobFiles(ctx, r.provider.service, r.provider.fileHashCache(), customMetricJob, plan.FolderPath, plan.Files, state.FileManifest)
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Custom Job files", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Job files", err)
 			return
 		}
 	}
@@ -364,7 +378,7 @@ func (r *CustomMetricJobResource) Update(ctx context.Context, req resource.Updat
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Custom Job", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Job", err)
 		return
 	}
 
@@ -384,7 +398,7 @@ func (r *CustomMetricJobResource) Update(ctx context.Context, req resource.Updat
 			IsModelSpecific: plan.IsModelSpecific.ValueBool(),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Hosted Custom Metric Template", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Hosted Custom Metric Template", err)
 			return
 		}
 	}
@@ -400,6 +414,8 @@ func (r *CustomMetricJobResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteCustomJob")
 	err := r.provider.service.DeleteCustomJob(ctx, data.ID.ValueString())
 	if err != nil {
@@ -435,7 +451,7 @@ func (r CustomMetricJobResource) ModifyPlan(ctx context.Context, req resource.Mo
 	}
 	plan.FilesHashes = filesHashes
 
-	folderPathHash, err := computeFolderHash(plan.FolderPath)
+	folderPathHash, err := computeFolderHash(ctx, plan.FolderPath, r.provider.fileHashCache())
 	if err != nil {
 		resp.Diagnostics.AddError("Error calculating folder path hash", err.Error())
 		return
@@ -446,6 +462,8 @@ func (r CustomMetricJobResource) ModifyPlan(ctx context.Context, req resource.Mo
 
 	if req.State.Raw.IsNull() {
 		// resource is being created
+		plan.PendingChanges = previewLocalFileChanges(ctx, &resp.Diagnostics, r.provider.fileHashCache(), plan.FolderPath, plan.Files, types.MapNull(types.StringType))
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 		return
 	}
 
@@ -456,6 +474,19 @@ func (r CustomMetricJobResource) ModifyPlan(ctx context.Context, req resource.Mo
 		return
 	}
 
+	// the manifest of changed local files is only known after they are uploaded
+	plan.FileManifest = state.FileManifest
+	plan.PendingChanges = state.PendingChanges
+	if !reflect.DeepEqual(plan.Files, state.Files) ||
+		!reflect.DeepEqual(plan.FilesHashes, state.FilesHashes) ||
+		plan.FolderPathHash != state.FolderPathHash {
+		plan.FileManifest = types.MapUnknown(types.StringType)
+		plan.PendingChanges = previewLocalFileChanges(ctx, &resp.Diagnostics, r.provider.fileHashCache(), plan.FolderPath, plan.Files, state.FileManifest)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if !IsKnown(plan.EnvironmentID) {
 		if plan.EnvironmentVersionID == state.EnvironmentVersionID {
 			// use state environment id if environment version id is not changed
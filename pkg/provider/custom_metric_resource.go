@@ -138,6 +138,9 @@ func (r *CustomMetricResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -164,6 +167,8 @@ func (r *CustomMetricResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	request := &client.CreateCustomMetricRequest{
 		Name:            data.Name.ValueString(),
 		Description:     data.Description.ValueString(),
@@ -209,7 +214,7 @@ func (r *CustomMetricResource) Create(ctx context.Context, req resource.CreateRe
 
 	customMetric, err := r.provider.service.CreateCustomMetric(ctx, data.DeploymentID.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Custom Metric", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Metric", err)
 		return
 	}
 	data.ID = types.StringValue(customMetric.ID)
@@ -226,6 +231,8 @@ func (r *CustomMetricResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -292,6 +299,8 @@ func (r *CustomMetricResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	request := &client.UpdateCustomMetricRequest{
 		Name:           StringValuePointerOptional(data.Name),
 		Description:    StringValuePointerOptional(data.Description),
@@ -335,7 +344,7 @@ func (r *CustomMetricResource) Update(ctx context.Context, req resource.UpdateRe
 
 	_, err := r.provider.service.UpdateCustomMetric(ctx, data.DeploymentID.ValueString(), data.ID.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Custom Metric", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Metric", err)
 		return
 	}
 
@@ -350,6 +359,8 @@ func (r *CustomMetricResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteCustomMetric")
 	err := r.provider.service.DeleteCustomMetric(ctx, data.DeploymentID.ValueString(), data.ID.ValueString())
 	if err != nil {
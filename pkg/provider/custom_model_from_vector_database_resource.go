@@ -121,6 +121,9 @@ func (r *CustomModelFromVectorDatabaseResource) Schema(ctx context.Context, req
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -154,6 +157,8 @@ func (r *CustomModelFromVectorDatabaseResource) Create(ctx context.Context, req
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	resources := buildCustomModelVersionResources(plan)
 
 	traceAPICall("CreateCustomModelVersionFromVectorDatabase")
@@ -163,7 +168,7 @@ func (r *CustomModelFromVectorDatabaseResource) Create(ctx context.Context, req
 		&client.CreateCustomModelVersionFromVectorDatabaseRequest{Resources: resources},
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Custom Model from Vector Database", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Model from Vector Database", err)
 		return
 	}
 	if customModelVersion == nil || customModelVersion.CustomModelID == "" {
@@ -188,7 +193,7 @@ func (r *CustomModelFromVectorDatabaseResource) Create(ctx context.Context, req
 			Name:        plan.Name.ValueString(),
 			Description: plan.Description.ValueString(),
 		}); err != nil {
-			resp.Diagnostics.AddError("Error updating Custom Model", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Model", err)
 			return
 		}
 	}
@@ -210,6 +215,8 @@ func (r *CustomModelFromVectorDatabaseResource) Read(ctx context.Context, req re
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -241,6 +248,8 @@ func (r *CustomModelFromVectorDatabaseResource) Update(ctx context.Context, req
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state CustomModelFromVectorDatabaseResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -254,7 +263,7 @@ func (r *CustomModelFromVectorDatabaseResource) Update(ctx context.Context, req
 			Name:        plan.Name.ValueString(),
 			Description: plan.Description.ValueString(),
 		}); err != nil {
-			resp.Diagnostics.AddError("Error updating Custom Model", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Model", err)
 			return
 		}
 	}
@@ -277,6 +286,8 @@ func (r *CustomModelFromVectorDatabaseResource) Delete(ctx context.Context, req
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteCustomModel")
 	if err := r.provider.service.DeleteCustomModel(ctx, data.ID.ValueString()); err != nil {
 		if !errors.Is(err, &client.NotFoundError{}) {
@@ -291,7 +302,7 @@ func (r *CustomModelFromVectorDatabaseResource) ImportState(ctx context.Context,
 }
 
 func (r *CustomModelFromVectorDatabaseResource) waitForCustomModelReady(ctx context.Context, customModelID string) (*client.CustomModel, error) {
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	operation := func() error {
 		traceAPICall("IsCustomModelReady")
@@ -104,6 +104,9 @@ func (r *CustomModelLLMValidationResource) Schema(ctx context.Context, req resou
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -130,6 +133,8 @@ func (r *CustomModelLLMValidationResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateCustomModelLLMValidation")
 	customModelLlmValidation, statusID, err := r.provider.service.CreateCustomModelLLMValidation(ctx, &client.CreateCustomModelLLMValidationRequest{
 		DeploymentID:      data.DeploymentID.ValueString(),
@@ -142,7 +147,7 @@ func (r *CustomModelLLMValidationResource) Create(ctx context.Context, req resou
 		UseCaseID:         StringValuePointerOptional(data.UseCaseID),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating custom model LLM validation", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating custom model LLM validation", err)
 		return
 	}
 
@@ -176,6 +181,8 @@ func (r *CustomModelLLMValidationResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -214,6 +221,8 @@ func (r *CustomModelLLMValidationResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state CustomModelLLMValidationResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -240,7 +249,7 @@ func (r *CustomModelLLMValidationResource) Update(ctx context.Context, req resou
 				fmt.Sprintf("custom model LLM validation with ID %s is not found. Removing from state.", plan.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error updating custom model LLM validation", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating custom model LLM validation", err)
 		}
 		return
 	}
@@ -263,6 +272,8 @@ func (r *CustomModelLLMValidationResource) Delete(ctx context.Context, req resou
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteCustomModelLLMValidation")
 	err := r.provider.service.DeleteCustomModelLLMValidation(ctx, data.ID.ValueString())
 	if err != nil {
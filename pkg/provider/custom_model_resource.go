@@ -235,6 +235,12 @@ func (r *CustomModelResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "The hash of file contents for each file in files.",
 				ElementType:         types.StringType,
 			},
+			"file_manifest": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 hash of each file uploaded from `folder_path` and `files`, by its path in the Custom Model. Only the files that changed since the last apply are uploaded.",
+				ElementType:         types.StringType,
+			},
+			"pending_changes": pendingChangesAttribute("`folder_path` and `files`"),
 			"guard_configurations": schema.ListNestedAttribute{
 				Optional:            true,
 				MarkdownDescription: "The guard configurations for the Custom Model.",
@@ -444,6 +450,11 @@ func (r *CustomModelResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "The list of Use Case IDs to add the Custom Model version to.",
 				ElementType:         types.StringType,
 			},
+			"use_case_ids_all": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of Use Case IDs the Custom Model version is added to: `use_case_ids` and the provider's `default_use_case_ids`.",
+				ElementType:         types.StringType,
+			},
 			"tags": schema.SetNestedAttribute{
 				Optional:            true,
 				MarkdownDescription: "The list of tags to assign to the Custom Model.",
@@ -460,6 +471,25 @@ func (r *CustomModelResource) Schema(ctx context.Context, req resource.SchemaReq
 					},
 				},
 			},
+			"tags_all": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The tags assigned to the Custom Model: `tags` and the provider's `default_tags`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the tag.",
+						},
+						"value": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The value of the tag.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -487,6 +517,8 @@ func (r *CustomModelResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	// Normalize Tags to ensure it has the correct type
 	plan.Tags, diags = normalizeTagsSet(ctx, plan.Tags)
 	resp.Diagnostics.Append(diags...)
@@ -496,7 +528,7 @@ func (r *CustomModelResource) Create(ctx context.Context, req resource.CreateReq
 
 	customModelID, baseEnvironmentID, baseEnvironmentVersionID, state, err := r.initializeCustomModel(ctx, plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Custom Model", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Model", err)
 		return
 	}
 
@@ -515,7 +547,7 @@ func (r *CustomModelResource) Create(ctx context.Context, req resource.CreateReq
 			BaseEnvironmentVersionID: baseEnvironmentVersionID,
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating Custom Model version", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Model version", err)
 			return
 		}
 		baseEnvironmentID = customModelVersion.BaseEnvironmentID
@@ -529,14 +561,20 @@ func (r *CustomModelResource) Create(ctx context.Context, req resource.CreateReq
 				customModelID,
 				baseEnvironmentID,
 			); err != nil {
-				resp.Diagnostics.AddError("Error creating Custom Model version from remote repository", err.Error())
+				addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Model version from remote repository", err)
 				return
 			}
 		}
 	}
 
-	if err := r.createCustomModelVersionFromFiles(ctx, plan.FolderPath, plan.Files, customModelID, baseEnvironmentID); err != nil {
-		resp.Diagnostics.AddError("Error creating Custom Model version from files", err.Error())
+	filesSync, err := planLocalFilesSync(ctx, r.provider.fileHashCache(), plan.FolderPath, plan.Files, types.MapNull(types.StringType))
+	if err != nil {
+		resp.Diagnostics.AddError("Error preparing local files", err.Error())
+		return
+	}
+
+	if err := r.createCustomModelVersionFromFiles(ctx, filesSync, nil, customModelID, baseEnvironmentID); err != nil {
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Model version from files", err)
 		return
 	}
 
@@ -555,6 +593,9 @@ func (r *CustomModelResource) Create(ctx context.Context, req resource.CreateReq
 	state.FolderPathHash = plan.FolderPathHash
 	state.Files = plan.Files
 	state.FilesHashes = plan.FilesHashes
+	state.FileManifest = filesSync.Manifest
+	state.PendingChanges = plan.PendingChanges
+	state.Timeouts = plan.Timeouts
 	state.TargetType = types.StringValue(customModel.TargetType)
 	state.TargetName = types.StringValue(customModel.TargetName)
 	state.PositiveClassLabel = types.StringValue(customModel.PositiveClassLabel)
@@ -565,7 +606,7 @@ func (r *CustomModelResource) Create(ctx context.Context, req resource.CreateReq
 
 	if len(plan.GuardConfigurations) > 0 {
 		if err = r.createCustomModelVersionFromGuards(ctx, plan, customModelID, customModel.LatestVersion.ID, plan.GuardConfigurations, []GuardConfiguration{}); err != nil {
-			resp.Diagnostics.AddError("Error creating Custom Model version from Guards", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Model version from Guards", err)
 			return
 		}
 		state.GuardConfigurations = plan.GuardConfigurations
@@ -591,14 +632,14 @@ func (r *CustomModelResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	traceAPICall("CreateCustomModelVersionCreateFromLatest")
 	if _, err = r.provider.service.CreateCustomModelVersionCreateFromLatest(ctx, customModelID, payload); err != nil {
-		resp.Diagnostics.AddError("Error creating Custom Model version", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Model version", err)
 		return
 	}
 	state.Replicas = plan.Replicas
 	state.NetworkAccess = plan.NetworkAccess
 
 	if _, err = r.addResourceBundle(ctx, customModel, &state, plan); err != nil {
-		resp.Diagnostics.AddError("Error adding resource bundle", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error adding resource bundle", err)
 		return
 	}
 
@@ -637,7 +678,7 @@ func (r *CustomModelResource) Create(ctx context.Context, req resource.CreateReq
 			runtimeParamsToApply,
 		)
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating Custom Model version with runtime parameters", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Model version with runtime parameters", err)
 			return
 		}
 		state.VersionID = types.StringValue(customModel.LatestVersion.ID)
@@ -647,7 +688,7 @@ func (r *CustomModelResource) Create(ctx context.Context, req resource.CreateReq
 		traceAPICall("CreateDependencyBuild")
 		_, err := r.provider.service.CreateDependencyBuild(ctx, customModel.ID, customModel.LatestVersion.ID)
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating Custom Model dependency build", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Model dependency build", err)
 			return
 		}
 
@@ -658,7 +699,7 @@ func (r *CustomModelResource) Create(ctx context.Context, req resource.CreateReq
 		}
 	}
 
-	for _, useCaseID := range plan.UseCaseIDs {
+	for _, useCaseID := range effectiveUseCaseIDs(plan.UseCaseIDsAll, plan.UseCaseIDs) {
 		traceAPICall("AddCustomModelVersionToUseCase")
 		if err = addEntityToUseCase(
 			ctx,
@@ -667,11 +708,12 @@ func (r *CustomModelResource) Create(ctx context.Context, req resource.CreateReq
 			"customModelVersion",
 			customModel.LatestVersion.ID,
 		); err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error adding Custom Model version to Use Case %s", useCaseID), err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error adding Custom Model version to Use Case %s", useCaseID), err)
 			return
 		}
 	}
 	state.UseCaseIDs = plan.UseCaseIDs
+	state.UseCaseIDsAll = plan.UseCaseIDsAll
 
 	// Read the final model state one more time to ensure we have the latest base environment version ID
 	// This is important because dependency builds and other operations might have changed it
@@ -696,6 +738,7 @@ func (r *CustomModelResource) Create(ctx context.Context, req resource.CreateReq
 	// Use the planned tags in the state to avoid inconsistency issues
 	// The Read operation will refresh from the API if needed
 	state.Tags = plan.Tags
+	state.TagsAll = plan.TagsAll
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -709,6 +752,8 @@ func (r *CustomModelResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -742,10 +787,12 @@ func (r *CustomModelResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	loadCustomModelToTerraformState(
+		r.provider,
 		*customModel,
 		data.SourceLLMBlueprintID.ValueString(),
 		&data,
 		&resp.Diagnostics)
+	data.PendingChanges = types.ObjectNull(pendingChangesAttrTypes)
 
 	// Normalize Tags after loading from API to ensure correct type
 	data.Tags, diags = normalizeTagsSet(ctx, data.Tags)
@@ -766,6 +813,8 @@ func (r *CustomModelResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	// Normalize Tags to ensure it has the correct type
 	plan.Tags, diags = normalizeTagsSet(ctx, plan.Tags)
 	resp.Diagnostics.Append(diags...)
@@ -808,13 +857,13 @@ func (r *CustomModelResource) Update(ctx context.Context, req resource.UpdateReq
 	initialRuntimeParams := customModel.LatestVersion.RuntimeParameters
 
 	if err := r.updateCustomModel(ctx, customModel, &state, plan); err != nil {
-		resp.Diagnostics.AddError("Error updating Custom Model", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Model", err)
 		return
 	}
 
 	newVersionCreated, err := r.createNewCustomModelVersion(ctx, plan, customModel)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Custom Model version", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Custom Model version", err)
 		return
 	}
 
@@ -827,43 +876,43 @@ func (r *CustomModelResource) Update(ctx context.Context, req resource.UpdateReq
 
 	remoteReposVersionCreated, err := r.updateRemoteRepositories(ctx, customModel, &state, plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating remote repositories", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating remote repositories", err)
 		return
 	}
 
 	localFilesUpdated, err := r.updateLocalFiles(ctx, customModel, &state, plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Custom Model from files", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Model from files", err)
 		return
 	}
 
 	guardsVersionCreated, err := r.updateGuardConfigurations(ctx, &state, plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating guard configurations", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating guard configurations", err)
 		return
 	}
 
 	resourceSettingsVersionCreated, err := r.updateResourceSettings(ctx, customModel, &state, plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating resource settings", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating resource settings", err)
 		return
 	}
 
 	resourceBundleVersionCreated, err := r.addResourceBundle(ctx, customModel, &state, plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Error adding resource bundle", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error adding resource bundle", err)
 		return
 	}
 
 	versionModifiedInApply := newVersionCreated || remoteReposVersionCreated || localFilesUpdated || guardsVersionCreated || resourceSettingsVersionCreated || resourceBundleVersionCreated
 
 	if err = r.updateRuntimeParameterValuesUnified(ctx, customModel, state, plan, initialRuntimeParams, versionModifiedInApply); err != nil {
-		resp.Diagnostics.AddError("Error updating runtime parameter values", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating runtime parameter values", err)
 		return
 	}
 
 	if err = r.updateTrainingDataset(ctx, customModel, &state, plan); err != nil {
-		resp.Diagnostics.AddError("Error updating training dataset", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating training dataset", err)
 		return
 	}
 
@@ -883,7 +932,7 @@ func (r *CustomModelResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if err = r.updateDependencyBuild(ctx, customModel); err != nil {
-		resp.Diagnostics.AddError("Error updating Custom Model dependency build", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Custom Model dependency build", err)
 		return
 	}
 
@@ -893,12 +942,15 @@ func (r *CustomModelResource) Update(ctx context.Context, req resource.UpdateReq
 		"customModelVersion",
 		customModel.LatestVersion.ID,
 		[]types.String{}, // there are no existing linked use cases because this is a new version
-		plan.UseCaseIDs,
+		effectiveUseCaseIDs(plan.UseCaseIDsAll, plan.UseCaseIDs),
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating Use Cases for Custom Model version", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Use Cases for Custom Model version", err)
 		return
 	}
 	state.UseCaseIDs = plan.UseCaseIDs
+	state.UseCaseIDsAll = plan.UseCaseIDsAll
+	state.PendingChanges = plan.PendingChanges
+	state.Timeouts = plan.Timeouts
 
 	state.RuntimeParameterValues, diags = formatRuntimeParameterValuesByManagedKeys(
 		ctx,
@@ -921,6 +973,8 @@ func (r *CustomModelResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	customModelID := data.ID.ValueString()
 	tflog.Info(ctx, "Starting Custom Model deletion", map[string]interface{}{
 		"custom_model_id": customModelID,
@@ -941,7 +995,7 @@ func (r *CustomModelResource) Delete(ctx context.Context, req resource.DeleteReq
 		// This works around Terraform's non-deterministic deletion order bugs (#37975, #30439)
 		// Instead of blocking (which creates deadlock), we store the custom model ID in
 		// provider-level state and return success. The deployment will complete the deletion.
-		if strings.Contains(err.Error(), "409 Conflict") || strings.Contains(err.Error(), "existing deployments") {
+		if errors.Is(err, &client.ConflictError{}) || strings.Contains(err.Error(), "existing deployments") {
 			tflog.Debug(ctx, "Custom Model has deployments, deferring deletion to deployment resource", map[string]interface{}{
 				"custom_model_id": customModelID,
 			})
@@ -994,17 +1048,30 @@ func (r CustomModelResource) ModifyPlan(ctx context.Context, req resource.Modify
 	}
 	plan.FilesHashes = filesHashes
 
-	folderPathHash, err := computeFolderHash(plan.FolderPath)
+	folderPathHash, err := computeFolderHash(ctx, plan.FolderPath, r.provider.fileHashCache())
 	if err != nil {
 		resp.Diagnostics.AddError("Error calculating folder path hash", err.Error())
 		return
 	}
 	plan.FolderPathHash = folderPathHash
 
+	plan.UseCaseIDsAll = r.provider.useCaseIDsAll(plan.UseCaseIDs)
+	plan.TagsAll = types.SetUnknown(tagObjectType)
+	if !plan.Tags.IsUnknown() {
+		tags, diags := normalizeTagsSet(ctx, plan.Tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.TagsAll = r.provider.tagsAll(tags)
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 
 	if req.State.Raw.IsNull() {
 		// resource is being created
+		plan.PendingChanges = previewLocalFileChanges(ctx, &resp.Diagnostics, r.provider.fileHashCache(), plan.FolderPath, plan.Files, types.MapNull(types.StringType))
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 		return
 	}
 
@@ -1050,6 +1117,19 @@ func (r CustomModelResource) ModifyPlan(ctx context.Context, req resource.Modify
 		plan.VersionID = types.StringUnknown()
 	}
 
+	// the manifest of changed local files is only known after they are uploaded
+	plan.FileManifest = state.FileManifest
+	plan.PendingChanges = state.PendingChanges
+	if !reflect.DeepEqual(plan.Files, state.Files) ||
+		!reflect.DeepEqual(plan.FilesHashes, state.FilesHashes) ||
+		plan.FolderPathHash != state.FolderPathHash {
+		plan.FileManifest = types.MapUnknown(types.StringType)
+		plan.PendingChanges = previewLocalFileChanges(ctx, &resp.Diagnostics, r.provider.fileHashCache(), plan.FolderPath, plan.Files, state.FileManifest)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if !IsKnown(plan.BaseEnvironmentID) {
 		if plan.BaseEnvironmentVersionID == state.BaseEnvironmentVersionID {
 			// use state base environment id if base environment version id is not changed
@@ -1110,6 +1190,7 @@ func (r CustomModelResource) ConfigValidators(ctx context.Context) []resource.Co
 }
 
 func loadCustomModelToTerraformState(
+	p *Provider,
 	customModel client.CustomModel,
 	sourceBlueprintId string,
 	state *CustomModelResourceModel,
@@ -1170,7 +1251,7 @@ func loadCustomModelToTerraformState(
 	}
 
 	if len(customModel.Tags) > 0 {
-		state.Tags = initializeTagsFromModel(customModel.Tags, diags)
+		state.Tags, state.TagsAll = p.tagsFromAPI(customModel.Tags, state.Tags, diags)
 		if diags.HasError() {
 			return
 		}
@@ -1178,7 +1259,7 @@ func loadCustomModelToTerraformState(
 }
 
 func (r *CustomModelResource) waitForCustomModelToBeReady(ctx context.Context, customModelId string) (*client.CustomModel, error) {
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	operation := func() error {
 		traceAPICall("IsCustomModelReady")
@@ -1203,7 +1284,7 @@ func (r *CustomModelResource) waitForCustomModelToBeReady(ctx context.Context, c
 }
 
 func (r *CustomModelResource) waitForTrainingDataToBeAssigned(ctx context.Context, customModelId string) error {
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	operation := func() error {
 		traceAPICall("GetCustomModel")
@@ -1231,7 +1312,7 @@ func (r *CustomModelResource) waitForTrainingDataToBeAssigned(ctx context.Contex
 }
 
 func (r *CustomModelResource) waitForDependencyBuild(ctx context.Context, id, versionID string) error {
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	operation := func() error {
 		traceAPICall("GetDependencyBuild")
@@ -1291,24 +1372,22 @@ func (r *CustomModelResource) createCustomModelVersionFromRemoteRepository(
 	return
 }
 
+// createCustomModelVersionFromFiles creates a version from the latest one that uploads the
+// added and modified files of the sync and removes the replaced items.
 func (r *CustomModelResource) createCustomModelVersionFromFiles(
 	ctx context.Context,
-	folderPath types.String,
-	files types.Dynamic,
+	filesSync *localFilesSync,
+	items []client.FileItem,
 	customModelID string,
 	baseEnvironmentID string,
 ) (
 	err error,
 ) {
-	localFiles, err := prepareLocalFiles(folderPath, files)
-	if err != nil {
-		return
-	}
-
 	traceAPICall("CreateCustomModelVersionFromLocalFiles")
 	_, err = r.provider.service.CreateCustomModelVersionFromFiles(ctx, customModelID, &client.CreateCustomModelVersionFromFilesRequest{
 		BaseEnvironmentID: baseEnvironmentID,
-		Files:             localFiles,
+		Files:             filesSync.Uploads,
+		FilesToDelete:     filesSync.replacedItemIDs(items),
 	})
 	if err != nil {
 		return
@@ -1569,6 +1648,11 @@ func (r *CustomModelResource) updateCustomModel(
 		updateRequest.ClassLabels = classLabels
 	}
 
+	if !plan.TagsAll.Equal(state.TagsAll) {
+		tags := convertSetTagsToClientTags(plan.TagsAll)
+		updateRequest.Tags = &tags
+	}
+
 	traceAPICall("UpdateCustomModel")
 	if customModel, err = r.provider.service.UpdateCustomModel(ctx, customModel.ID, updateRequest); err != nil {
 		return
@@ -1581,6 +1665,8 @@ func (r *CustomModelResource) updateCustomModel(
 	state.PositiveClassLabel = types.StringValue(customModel.PositiveClassLabel)
 	state.NegativeClassLabel = types.StringValue(customModel.NegativeClassLabel)
 	state.PredictionThreshold = types.Float64Value(customModel.PredictionThreshold)
+	state.Tags = plan.Tags
+	state.TagsAll = plan.TagsAll
 	if customModel.Language != "" {
 		state.Language = types.StringValue(customModel.Language)
 	}
@@ -1806,42 +1892,31 @@ func (r *CustomModelResource) updateLocalFiles(
 		return false, nil
 	}
 
-	filesToDelete := make([]string, 0)
-	for _, item := range customModel.LatestVersion.Items {
-		if item.FileSource == "local" {
-			filesToDelete = append(filesToDelete, item.ID)
-		}
+	filesSync, err := planLocalFilesSync(ctx, r.provider.fileHashCache(), plan.FolderPath, plan.Files, state.FileManifest)
+	if err != nil {
+		return false, err
 	}
 
-	if len(filesToDelete) > 0 {
-		traceAPICall("CreateCustomModelVersionCreateFromLatestDeleteFiles")
-		_, err = r.provider.service.CreateCustomModelVersionCreateFromLatest(ctx, customModel.ID, &client.CreateCustomModelVersionFromLatestRequest{
-			IsMajorUpdate:            "false",
-			BaseEnvironmentID:        customModel.LatestVersion.BaseEnvironmentID,
-			BaseEnvironmentVersionID: customModel.LatestVersion.BaseEnvironmentVersionID,
-			FilesToDelete:            filesToDelete,
-		})
-		if err != nil {
+	if !filesSync.IsEmpty() {
+		if err = r.createCustomModelVersionFromFiles(
+			ctx,
+			filesSync,
+			customModel.LatestVersion.Items,
+			customModel.ID,
+			customModel.LatestVersion.BaseEnvironmentID,
+		); err != nil {
 			return false, err
 		}
-	}
-
-	if err = r.createCustomModelVersionFromFiles(
-		ctx,
-		plan.FolderPath,
-		plan.Files,
-		customModel.ID,
-		customModel.LatestVersion.BaseEnvironmentID,
-	); err != nil {
-		return false, err
+		versionCreated = true
 	}
 
 	state.Files = plan.Files
 	state.FolderPath = plan.FolderPath
 	state.FolderPathHash = plan.FolderPathHash
 	state.FilesHashes = plan.FilesHashes
+	state.FileManifest = filesSync.Manifest
 
-	return true, nil
+	return versionCreated, nil
 }
 
 func (r *CustomModelResource) updateGuardConfigurations(
@@ -2196,7 +2271,7 @@ func (r *CustomModelResource) initializeCustomModel(
 		return
 	}
 
-	tags := convertSetTagsToClientTags(plan.Tags)
+	tags := convertSetTagsToClientTags(plan.TagsAll)
 
 	traceAPICall("CreateCustomModel")
 	createResp, createErr := r.provider.service.CreateCustomModel(ctx, &client.CreateCustomModelRequest{
@@ -99,6 +99,9 @@ func (r *DatasourceResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -125,6 +128,8 @@ func (r *DatasourceResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	createDatasourceRequest := &client.CreateDatasourceRequest{
 		CanonicalName: data.CanonicalName.ValueString(),
 		Type:          data.DataSourceType.ValueString(),
@@ -143,7 +148,7 @@ func (r *DatasourceResource) Create(ctx context.Context, req resource.CreateRequ
 	traceAPICall("CreateDatasource")
 	dataSource, err := r.provider.service.CreateDatasource(ctx, createDatasourceRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Datasource", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Datasource", err)
 		return
 	}
 	data.ID = types.StringValue(dataSource.ID)
@@ -159,6 +164,8 @@ func (r *DatasourceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -200,12 +207,14 @@ func (r *DatasourceResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("UpdateDatasource")
 	_, err := r.provider.service.UpdateDatasource(ctx, data.ID.ValueString(), &client.UpdateDatasourceRequest{
 		CanonicalName: data.CanonicalName.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Datasource", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Datasource", err)
 		return
 	}
 
@@ -220,6 +229,8 @@ func (r *DatasourceResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteDatasource")
 	err := r.provider.service.DeleteDatasource(ctx, state.ID.ValueString())
 	if err != nil {
@@ -83,6 +83,9 @@ func (r *DatastoreResource) Schema(ctx context.Context, req resource.SchemaReque
 				ElementType:         types.MapType{ElemType: types.StringType},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -109,6 +112,8 @@ func (r *DatastoreResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	createDatastoreRequest := &client.CreateDatastoreRequest{
 		CanonicalName: data.CanonicalName.ValueString(),
 		Type:          data.DataStoreType.ValueString(),
@@ -129,7 +134,7 @@ func (r *DatastoreResource) Create(ctx context.Context, req resource.CreateReque
 	traceAPICall("CreateDatastore")
 	dataStore, err := r.provider.service.CreateDatastore(ctx, createDatastoreRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Datastore", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Datastore", err)
 		return
 	}
 	data.ID = types.StringValue(dataStore.ID)
@@ -145,6 +150,8 @@ func (r *DatastoreResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -180,6 +187,8 @@ func (r *DatastoreResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	updateDatastoreRequest := &client.UpdateDatastoreRequest{
 		CanonicalName: data.CanonicalName.ValueString(),
 		Params:        client.DatastoreParams{},
@@ -195,7 +204,7 @@ func (r *DatastoreResource) Update(ctx context.Context, req resource.UpdateReque
 	traceAPICall("UpdateDatastore")
 	_, err := r.provider.service.UpdateDatastore(ctx, data.ID.ValueString(), updateDatastoreRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Datastore", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Datastore", err)
 		return
 	}
 
@@ -210,6 +219,8 @@ func (r *DatastoreResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteDatastore")
 	err := r.provider.service.DeleteDatastore(ctx, state.ID.ValueString())
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DatasetFromDatasourceResource{}
 var _ resource.ResourceWithImportState = &DatasetFromDatasourceResource{}
+var _ resource.ResourceWithModifyPlan = &DatasetFromDatasourceResource{}
 
 func NewDatasetFromDatasourceResource() resource.Resource {
 	return &DatasetFromDatasourceResource{}
@@ -106,6 +107,15 @@ func (r *DatasetFromDatasourceResource) Schema(ctx context.Context, req resource
 				MarkdownDescription: "The list of Use Case IDs to add the Dataset to.",
 				ElementType:         types.StringType,
 			},
+			"use_case_ids_all": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of Use Case IDs the Dataset is added to: `use_case_ids` and the provider's `default_use_case_ids`.",
+				ElementType:         types.StringType,
+			},
+			"deletion_protection": deletionProtectionAttribute("Dataset"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -133,6 +143,8 @@ func (r *DatasetFromDatasourceResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	categories := make([]string, len(data.Categories))
 	for i, category := range data.Categories {
 		categories[i] = category.ValueString()
@@ -157,7 +169,7 @@ func (r *DatasetFromDatasourceResource) Create(ctx context.Context, req resource
 	traceAPICall("CreateDatasetFromDatasource")
 	createResp, err := r.provider.service.CreateDatasetFromDataSource(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Dataset", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Dataset", err)
 		return
 	}
 
@@ -168,7 +180,7 @@ func (r *DatasetFromDatasourceResource) Create(ctx context.Context, req resource
 	}
 	data.ID = types.StringValue(dataset.ID)
 
-	for _, useCaseID := range data.UseCaseIDs {
+	for _, useCaseID := range effectiveUseCaseIDs(data.UseCaseIDsAll, data.UseCaseIDs) {
 		traceAPICall("AddDatasetToUseCase")
 		if err = addEntityToUseCase(
 			ctx,
@@ -177,7 +189,7 @@ func (r *DatasetFromDatasourceResource) Create(ctx context.Context, req resource
 			"dataset",
 			dataset.ID,
 		); err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error adding Dataset to Use Case %s", useCaseID), err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error adding Dataset to Use Case %s", useCaseID), err)
 			return
 		}
 	}
@@ -193,6 +205,8 @@ func (r *DatasetFromDatasourceResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -232,6 +246,8 @@ func (r *DatasetFromDatasourceResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	categories := make([]string, len(plan.Categories))
 	for i, category := range plan.Categories {
 		categories[i] = category.ValueString()
@@ -242,7 +258,7 @@ func (r *DatasetFromDatasourceResource) Update(ctx context.Context, req resource
 		Categories: &categories,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Dataset", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Dataset", err)
 		return
 	}
 
@@ -251,10 +267,10 @@ func (r *DatasetFromDatasourceResource) Update(ctx context.Context, req resource
 		r.provider.service,
 		"dataset",
 		plan.ID.ValueString(),
-		state.UseCaseIDs,
-		plan.UseCaseIDs)
+		effectiveUseCaseIDs(state.UseCaseIDsAll, state.UseCaseIDs),
+		effectiveUseCaseIDs(plan.UseCaseIDsAll, plan.UseCaseIDs))
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Use Cases for Dataset", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Use Cases for Dataset", err)
 		return
 	}
 
@@ -269,6 +285,12 @@ func (r *DatasetFromDatasourceResource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	if checkDeletionProtection(state.DeletionProtection, "Dataset", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	ctx = withDeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteDataset")
 	err := r.provider.service.DeleteDataset(ctx, state.ID.ValueString())
 	if err != nil {
@@ -282,3 +304,15 @@ func (r *DatasetFromDatasourceResource) Delete(ctx context.Context, req resource
 func (r *DatasetFromDatasourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *DatasetFromDatasourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDeletionProtection(ctx, req, resp, "Dataset",
+		path.Root("data_source_id"),
+		path.Root("credential_id"),
+		path.Root("do_snapshot"),
+		path.Root("persist_data_after_ingestion"),
+		path.Root("use_kerberos"),
+		path.Root("sample_size_rows"),
+	)
+	r.provider.modifyPlanUseCaseIDsAll(ctx, req, resp)
+}
@@ -67,6 +67,15 @@ func (r *DatasetFromFileResource) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "The list of Use Case IDs to add the Dataset to.",
 				ElementType:         types.StringType,
 			},
+			"use_case_ids_all": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of Use Case IDs the Dataset is added to: `use_case_ids` and the provider's `default_use_case_ids`.",
+				ElementType:         types.StringType,
+			},
+			"deletion_protection": deletionProtectionAttribute("Dataset"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -94,6 +103,8 @@ func (r *DatasetFromFileResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	filePath := data.FilePath.ValueString()
 	fileInfo, err := os.Stat(filePath)
 	if err != nil {
@@ -121,7 +132,7 @@ func (r *DatasetFromFileResource) Create(ctx context.Context, req resource.Creat
 		fileContent,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Dataset", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Dataset", err)
 		return
 	}
 
@@ -131,7 +142,7 @@ func (r *DatasetFromFileResource) Create(ctx context.Context, req resource.Creat
 			Name: data.Name.ValueString(),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Dataset", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Dataset", err)
 			return
 		}
 	}
@@ -143,7 +154,7 @@ func (r *DatasetFromFileResource) Create(ctx context.Context, req resource.Creat
 	}
 	data.ID = types.StringValue(dataset.ID)
 
-	for _, useCaseID := range data.UseCaseIDs {
+	for _, useCaseID := range effectiveUseCaseIDs(data.UseCaseIDsAll, data.UseCaseIDs) {
 		traceAPICall("AddDatasetToUseCase")
 		if err = addEntityToUseCase(
 			ctx,
@@ -152,7 +163,7 @@ func (r *DatasetFromFileResource) Create(ctx context.Context, req resource.Creat
 			"dataset",
 			dataset.ID,
 		); err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error adding Dataset to Use Case %s", useCaseID), err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error adding Dataset to Use Case %s", useCaseID), err)
 			return
 		}
 	}
@@ -168,6 +179,8 @@ func (r *DatasetFromFileResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -206,13 +219,15 @@ func (r *DatasetFromFileResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	if IsKnown(plan.Name) {
 		traceAPICall("UpdateDataset")
 		_, err := r.provider.service.UpdateDataset(ctx, plan.ID.ValueString(), &client.UpdateDatasetRequest{
 			Name: plan.Name.ValueString(),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Dataset", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Dataset", err)
 			return
 		}
 	}
@@ -222,10 +237,10 @@ func (r *DatasetFromFileResource) Update(ctx context.Context, req resource.Updat
 		r.provider.service,
 		"dataset",
 		plan.ID.ValueString(),
-		state.UseCaseIDs,
-		plan.UseCaseIDs)
+		effectiveUseCaseIDs(state.UseCaseIDsAll, state.UseCaseIDs),
+		effectiveUseCaseIDs(plan.UseCaseIDsAll, plan.UseCaseIDs))
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Use Cases for Dataset", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Use Cases for Dataset", err)
 		return
 	}
 
@@ -240,6 +255,12 @@ func (r *DatasetFromFileResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	if checkDeletionProtection(state.DeletionProtection, "Dataset", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	ctx = withDeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteDataset")
 	err := r.provider.service.DeleteDataset(ctx, state.ID.ValueString())
 	if err != nil {
@@ -257,6 +278,7 @@ func (r *DatasetFromFileResource) ImportState(ctx context.Context, req resource.
 func (r DatasetFromFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
 		// Resource is being destroyed
+		modifyPlanDeletionProtection(ctx, req, resp, "Dataset")
 		return
 	}
 
@@ -274,6 +296,7 @@ func (r DatasetFromFileResource) ModifyPlan(ctx context.Context, req resource.Mo
 		return
 	}
 	plan.FileHash = types.StringValue(fileContentHash)
+	plan.UseCaseIDsAll = r.provider.useCaseIDsAll(plan.UseCaseIDs)
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 
 	if req.State.Raw.IsNull() {
@@ -291,4 +314,6 @@ func (r DatasetFromFileResource) ModifyPlan(ctx context.Context, req resource.Mo
 	if plan.FileHash != state.FileHash {
 		resp.RequiresReplace.Append(path.Root("file_hash"))
 	}
+
+	modifyPlanDeletionProtection(ctx, req, resp, "Dataset", path.Root("file_path"))
 }
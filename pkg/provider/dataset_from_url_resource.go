@@ -17,6 +17,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DatasetFromURLResource{}
 var _ resource.ResourceWithImportState = &DatasetFromURLResource{}
+var _ resource.ResourceWithModifyPlan = &DatasetFromURLResource{}
 
 func NewDatasetFromURLResource() resource.Resource {
 	return &DatasetFromURLResource{}
@@ -60,6 +61,15 @@ func (r *DatasetFromURLResource) Schema(ctx context.Context, req resource.Schema
 				MarkdownDescription: "The list of Use Case IDs to add the Dataset to.",
 				ElementType:         types.StringType,
 			},
+			"use_case_ids_all": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of Use Case IDs the Dataset is added to: `use_case_ids` and the provider's `default_use_case_ids`.",
+				ElementType:         types.StringType,
+			},
+			"deletion_protection": deletionProtectionAttribute("Dataset"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -87,12 +97,14 @@ func (r *DatasetFromURLResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateDatasetFromURL")
 	createResp, err := r.provider.service.CreateDatasetFromURL(ctx, &client.CreateDatasetFromURLRequest{
 		URL: data.URL.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Dataset", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Dataset", err)
 		return
 	}
 
@@ -102,7 +114,7 @@ func (r *DatasetFromURLResource) Create(ctx context.Context, req resource.Create
 			Name: data.Name.ValueString(),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Dataset", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Dataset", err)
 			return
 		}
 	}
@@ -114,7 +126,7 @@ func (r *DatasetFromURLResource) Create(ctx context.Context, req resource.Create
 	}
 	data.ID = types.StringValue(dataset.ID)
 
-	for _, useCaseID := range data.UseCaseIDs {
+	for _, useCaseID := range effectiveUseCaseIDs(data.UseCaseIDsAll, data.UseCaseIDs) {
 		traceAPICall("AddDatasetToUseCase")
 		if err = addEntityToUseCase(
 			ctx,
@@ -123,7 +135,7 @@ func (r *DatasetFromURLResource) Create(ctx context.Context, req resource.Create
 			"dataset",
 			dataset.ID,
 		); err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error adding Dataset to Use Case %s", useCaseID), err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error adding Dataset to Use Case %s", useCaseID), err)
 			return
 		}
 	}
@@ -139,6 +151,8 @@ func (r *DatasetFromURLResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -177,13 +191,15 @@ func (r *DatasetFromURLResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	if IsKnown(plan.Name) {
 		traceAPICall("UpdateDataset")
 		_, err := r.provider.service.UpdateDataset(ctx, plan.ID.ValueString(), &client.UpdateDatasetRequest{
 			Name: plan.Name.ValueString(),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Dataset", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Dataset", err)
 			return
 		}
 	}
@@ -193,10 +209,10 @@ func (r *DatasetFromURLResource) Update(ctx context.Context, req resource.Update
 		r.provider.service,
 		"dataset",
 		plan.ID.ValueString(),
-		state.UseCaseIDs,
-		plan.UseCaseIDs)
+		effectiveUseCaseIDs(state.UseCaseIDsAll, state.UseCaseIDs),
+		effectiveUseCaseIDs(plan.UseCaseIDsAll, plan.UseCaseIDs))
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Use Cases for Dataset", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Use Cases for Dataset", err)
 		return
 	}
 
@@ -211,6 +227,12 @@ func (r *DatasetFromURLResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	if checkDeletionProtection(state.DeletionProtection, "Dataset", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	ctx = withDeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteDataset")
 	err := r.provider.service.DeleteDataset(ctx, state.ID.ValueString())
 	if err != nil {
@@ -224,3 +246,8 @@ func (r *DatasetFromURLResource) Delete(ctx context.Context, req resource.Delete
 func (r *DatasetFromURLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *DatasetFromURLResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDeletionProtection(ctx, req, resp, "Dataset", path.Root("url"))
+	r.provider.modifyPlanUseCaseIDsAll(ctx, req, resp)
+}
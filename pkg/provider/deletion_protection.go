@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionAttribute is the deletion_protection attribute of resources whose deletion
+// loses data that cannot be recreated, e.g. the monitoring history of a Deployment. DataRobot
+// has no lock for these entities, so the provider enforces the protection from the state.
+func deletionProtectionAttribute(entity string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		MarkdownDescription: fmt.Sprintf("Whether the %[1]s is protected from deletion. While `true`, plans that destroy or replace the %[1]s fail, "+
+			"and so does deleting it. Set it to `false` and apply before deleting the %[1]s.", entity),
+	}
+}
+
+// modifyPlanDeletionProtection fails plans that destroy or replace a resource whose state has
+// deletion_protection set, so that the apply does not delete its dependents first. The framework
+// does not pass the replacements of attribute plan modifiers to ModifyPlan, so replaceAttributes
+// lists the attributes whose change requires replacing the resource; call it after adding any
+// RequiresReplace of the resource's own ModifyPlan.
+func modifyPlanDeletionProtection(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	entity string,
+	replaceAttributes ...path.Path,
+) {
+	if req.State.Raw.IsNull() {
+		// Resource is being created
+		return
+	}
+	if !req.Plan.Raw.IsNull() && len(resp.RequiresReplace) == 0 && !attributesChanged(ctx, req, replaceAttributes, &resp.Diagnostics) {
+		return
+	}
+
+	var id types.String
+	var protected types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &protected)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkDeletionProtection(protected, entity, id.ValueString(), &resp.Diagnostics)
+}
+
+// attributesChanged reports whether the plan changes any of the attributes, like the
+// RequiresReplace plan modifiers do, counting unknown planned values as changes.
+func attributesChanged(ctx context.Context, req resource.ModifyPlanRequest, attributes []path.Path, diags *diag.Diagnostics) bool {
+	for _, attribute := range attributes {
+		var planValue, stateValue attr.Value
+		diags.Append(req.Plan.GetAttribute(ctx, attribute, &planValue)...)
+		diags.Append(req.State.GetAttribute(ctx, attribute, &stateValue)...)
+		if diags.HasError() {
+			return false
+		}
+		if !planValue.Equal(stateValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDeletionProtection adds an error and returns true when protected is set.
+func checkDeletionProtection(protected types.Bool, entity, id string, diags *diag.Diagnostics) bool {
+	if !IsKnown(protected) || !protected.ValueBool() {
+		return false
+	}
+	diags.AddAttributeError(
+		path.Root("deletion_protection"),
+		fmt.Sprintf("%s is protected from deletion", entity),
+		fmt.Sprintf("%s %s has deletion_protection set, so it cannot be destroyed or replaced. "+
+			"Set deletion_protection to false and apply before deleting it.", entity, id))
+	return true
+}
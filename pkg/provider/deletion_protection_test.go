@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCheckDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		protected types.Bool
+		want      bool
+	}{
+		{types.BoolNull(), false},
+		{types.BoolValue(false), false},
+		{types.BoolValue(true), true},
+	} {
+		var diags diag.Diagnostics
+		if got := checkDeletionProtection(tt.protected, "Deployment", "dep-1", &diags); got != tt.want || diags.HasError() != tt.want {
+			t.Errorf("checkDeletionProtection(%s) = %v with diagnostics %v, want %v", tt.protected, got, diags, tt.want)
+		}
+	}
+}
+
+func TestModifyPlanDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&DatasetFromURLResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	state := func(protected types.Bool) tfsdk.State {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+		state.SetAttribute(ctx, path.Root("id"), types.StringValue("dataset-1"))
+		state.SetAttribute(ctx, path.Root("url"), types.StringValue("https://example.com/data.csv"))
+		state.SetAttribute(ctx, path.Root("deletion_protection"), protected)
+		return state
+	}
+
+	tests := []struct {
+		name      string
+		protected types.Bool
+		destroy   bool
+		url       string
+		wantError bool
+	}{
+		{name: "destroy protected", protected: types.BoolValue(true), destroy: true, wantError: true},
+		{name: "replace protected", protected: types.BoolValue(true), url: "https://example.com/other.csv", wantError: true},
+		{name: "update protected", protected: types.BoolValue(true)},
+		{name: "destroy unprotected", protected: types.BoolNull(), destroy: true},
+		{name: "replace unprotected", protected: types.BoolValue(false), url: "https://example.com/other.csv"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prior := state(tt.protected)
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: prior.Raw.Copy()}
+			if tt.destroy {
+				plan.Raw = tftypes.NewValue(objectType, nil)
+			}
+			if tt.url != "" {
+				plan.SetAttribute(ctx, path.Root("url"), types.StringValue(tt.url))
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan, RequiresReplace: path.Paths{}}
+
+			modifyPlanDeletionProtection(ctx, resource.ModifyPlanRequest{State: prior, Plan: plan}, resp, "Dataset", path.Root("url"))
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("diagnostics = %v, want error: %v", resp.Diagnostics, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DeploymentChallengerResource{}
+var _ resource.ResourceWithImportState = &DeploymentChallengerResource{}
+
+func NewDeploymentChallengerResource() resource.Resource {
+	return &DeploymentChallengerResource{}
+}
+
+// DeploymentChallengerResource defines the resource implementation.
+type DeploymentChallengerResource struct {
+	provider *Provider
+}
+
+func (r *DeploymentChallengerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_challenger"
+}
+
+func (r *DeploymentChallengerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A challenger of a Deployment: a registered model version that receives the predictions of the Deployment " +
+			"alongside its champion model, so that the two can be compared. " +
+			"The Deployment must have `challenger_models_settings` enabled. " +
+			"Use `promote_challenger_id` of `datarobot_deployment` to make a challenger the champion.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the Challenger.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the Deployment the Challenger is added to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"registered_model_version_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the registered model version of the Challenger.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prediction_environment_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the prediction environment of the Challenger. Defaults to the prediction environment of the Deployment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the Challenger.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *DeploymentChallengerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if r.provider, ok = req.ProviderData.(*Provider); !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected  %T, got: %T. Please report this issue to the provider developers.", Provider{}, req.ProviderData),
+		)
+	}
+}
+
+func (r *DeploymentChallengerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeploymentChallengerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
+	deploymentID := data.DeploymentID.ValueString()
+	if !IsKnown(data.PredictionEnvironmentID) {
+		traceAPICall("GetDeployment")
+		deployment, err := r.provider.service.GetDeployment(ctx, deploymentID)
+		if err != nil {
+			addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error getting Deployment with ID %s", deploymentID), err)
+			return
+		}
+		data.PredictionEnvironmentID = types.StringValue(deployment.PredictionEnvironment.ID)
+	}
+
+	traceAPICall("CreateDeploymentChallenger")
+	statusID, err := r.provider.service.CreateDeploymentChallenger(ctx, deploymentID, &client.CreateDeploymentChallengerRequest{
+		ModelPackageID:          data.RegisteredModelVersionID.ValueString(),
+		PredictionEnvironmentID: data.PredictionEnvironmentID.ValueString(),
+		Name:                    data.Name.ValueString(),
+	})
+	if err != nil {
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Challenger", err)
+		return
+	}
+	if statusID == "" {
+		resp.Diagnostics.AddError("Unable to find Challenger creation task", "Status ID is empty")
+		return
+	}
+
+	if err = waitForTaskStatusToComplete(ctx, r.provider.service, statusID); err != nil {
+		resp.Diagnostics.AddError("Challenger creation task not completed", err.Error())
+		return
+	}
+
+	// the task does not return the ID of the challenger, so look it up by its model and name
+	challenger, err := r.findChallenger(ctx, deploymentID, data.RegisteredModelVersionID.ValueString(), data.Name.ValueString())
+	if err != nil {
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error finding created Challenger", err)
+		return
+	}
+	data.ID = types.StringValue(challenger.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentChallengerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeploymentChallengerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
+	if data.ID.IsNull() {
+		return
+	}
+
+	id := data.ID.ValueString()
+
+	traceAPICall("GetDeploymentChallenger")
+	challenger, err := r.provider.service.GetDeploymentChallenger(ctx, data.DeploymentID.ValueString(), id)
+	if err != nil {
+		if errors.Is(err, &client.NotFoundError{}) {
+			resp.Diagnostics.AddWarning(
+				"Challenger not found",
+				fmt.Sprintf("Challenger with ID %s is not found. Removing from state.", id))
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error getting Challenger with ID %s", id),
+				err.Error())
+		}
+		return
+	}
+
+	data.Name = types.StringValue(challenger.Name)
+	data.RegisteredModelVersionID = types.StringValue(challenger.ModelPackage.ID)
+	data.PredictionEnvironmentID = types.StringValue(challenger.PredictionEnvironment.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentChallengerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DeploymentChallengerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
+	request := &client.UpdateDeploymentChallengerRequest{}
+	if plan.Name != state.Name {
+		request.Name = plan.Name.ValueString()
+	}
+	if IsKnown(plan.PredictionEnvironmentID) && plan.PredictionEnvironmentID != state.PredictionEnvironmentID {
+		request.PredictionEnvironmentID = plan.PredictionEnvironmentID.ValueString()
+	}
+
+	if *request != (client.UpdateDeploymentChallengerRequest{}) {
+		traceAPICall("UpdateDeploymentChallenger")
+		_, err := r.provider.service.UpdateDeploymentChallenger(ctx, plan.DeploymentID.ValueString(), plan.ID.ValueString(), request)
+		if err != nil {
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Challenger", err)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DeploymentChallengerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeploymentChallengerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
+	traceAPICall("DeleteDeploymentChallenger")
+	err := r.provider.service.DeleteDeploymentChallenger(ctx, data.DeploymentID.ValueString(), data.ID.ValueString())
+	if err != nil && !errors.Is(err, &client.NotFoundError{}) {
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error deleting Challenger", err)
+	}
+}
+
+// ImportState takes "deploymentId:challengerId", because challengers are read through their Deployment.
+func (r *DeploymentChallengerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form deploymentId:challengerId, got %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// findChallenger returns the challenger of the Deployment with the given registered model
+// version and name.
+func (r *DeploymentChallengerResource) findChallenger(ctx context.Context, deploymentID, versionID, name string) (*client.DeploymentChallenger, error) {
+	traceAPICall("ListDeploymentChallengers")
+	challengers, err := r.provider.service.ListDeploymentChallengers(ctx, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range challengers {
+		if challengers[i].ModelPackage.ID == versionID && challengers[i].Name == name {
+			return &challengers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no Challenger of Deployment %s has registered model version %s and name %q", deploymentID, versionID, name)
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	mock_client "github.com/datarobot-community/terraform-provider-datarobot/mock"
+	"github.com/golang/mock/gomock"
+)
+
+func TestFindChallenger(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockService := mock_client.NewMockService(ctrl)
+	resource := &DeploymentChallengerResource{provider: &Provider{service: mockService}}
+
+	mockService.EXPECT().ListDeploymentChallengers(gomock.Any(), "dep-1").Return([]client.DeploymentChallenger{
+		{ID: "challenger-1", Name: "other", ModelPackage: client.ModelPackage{ID: "pkg-1"}},
+		{ID: "challenger-2", Name: "candidate", ModelPackage: client.ModelPackage{ID: "pkg-1"}},
+	}, nil).Times(2)
+
+	challenger, err := resource.findChallenger(context.Background(), "dep-1", "pkg-1", "candidate")
+	if err != nil || challenger.ID != "challenger-2" {
+		t.Fatalf("challenger = %+v, err = %v; want challenger-2", challenger, err)
+	}
+	if _, err := resource.findChallenger(context.Background(), "dep-1", "pkg-2", "candidate"); err == nil {
+		t.Fatal("expected an error for a missing challenger")
+	}
+}
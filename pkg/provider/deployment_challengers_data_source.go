@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DeploymentChallengersDataSource{}
+
+func NewDeploymentChallengersDataSource() datasource.DataSource {
+	return &DeploymentChallengersDataSource{}
+}
+
+type DeploymentChallengersDataSource struct {
+	provider *Provider
+}
+
+func (d *DeploymentChallengersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_challengers"
+}
+
+func (d *DeploymentChallengersDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the Challengers of a Deployment with their accuracy metrics compared to the champion model. " +
+			"Metrics are only computed once the Deployment has received actuals.",
+
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the Deployment.",
+			},
+			"start": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The RFC 3339 start of the time period of the metrics. Defaults to seven days before `end`.",
+			},
+			"end": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The RFC 3339 end of the time period of the metrics. Defaults to the next hour.",
+			},
+			"champion": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The champion model of the Deployment.",
+				Attributes: map[string]schema.Attribute{
+					"registered_model_version_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The ID of the registered model version of the champion.",
+					},
+					"metrics": schema.MapAttribute{
+						Computed:            true,
+						ElementType:         types.Float64Type,
+						MarkdownDescription: "The accuracy metrics of the champion, keyed by metric name, e.g. `LogLoss` or `RMSE`.",
+					},
+				},
+			},
+			"challengers": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Challengers of the Deployment.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the Challenger, e.g. for `promote_challenger_id` of `datarobot_deployment`.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the Challenger.",
+						},
+						"registered_model_version_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the registered model version of the Challenger.",
+						},
+						"prediction_environment_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the prediction environment of the Challenger.",
+						},
+						"metrics": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.Float64Type,
+							MarkdownDescription: "The accuracy metrics of the Challenger, keyed by metric name.",
+						},
+						"metrics_percent_change": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.Float64Type,
+							MarkdownDescription: "The change of each metric of the Challenger relative to the champion, in percent. Metrics that the champion lacks or that are zero for the champion are omitted.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploymentChallengersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if d.provider, ok = req.ProviderData.(*Provider); !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please report this issue to the provider developers.", Provider{}, req.ProviderData),
+		)
+	}
+}
+
+func (d *DeploymentChallengersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DeploymentChallengersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deploymentID := config.DeploymentID.ValueString()
+
+	traceAPICall("GetDeployment")
+	deployment, err := d.provider.service.GetDeployment(ctx, deploymentID)
+	if err != nil {
+		addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error getting Deployment with ID %s", deploymentID), err)
+		return
+	}
+
+	traceAPICall("ListDeploymentChallengers")
+	challengers, err := d.provider.service.ListDeploymentChallengers(ctx, deploymentID)
+	if err != nil {
+		addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error listing Challengers of Deployment %s", deploymentID), err)
+		return
+	}
+
+	accuracy := func(modelID string) (map[string]float64, bool) {
+		traceAPICall("GetDeploymentAccuracy")
+		result, err := d.provider.service.GetDeploymentAccuracy(ctx, deploymentID, &client.DeploymentAccuracyRequest{
+			ModelID: modelID,
+			Start:   config.Start.ValueString(),
+			End:     config.End.ValueString(),
+		})
+		if err != nil {
+			addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error getting accuracy of model %s of Deployment %s", modelID, deploymentID), err)
+			return nil, false
+		}
+		return accuracyMetricValues(result), true
+	}
+
+	championMetrics, ok := accuracy(deployment.Model.ID)
+	if !ok {
+		return
+	}
+	config.Champion = &DeploymentModelMetricsModel{
+		RegisteredModelVersionID: types.StringValue(deployment.ModelPackage.ID),
+		Metrics:                  float64MapValue(championMetrics, &resp.Diagnostics),
+	}
+
+	config.Challengers = []DeploymentChallengerMetricsModel{}
+	for _, challenger := range challengers {
+		if challenger.ModelPackage.ID == deployment.ModelPackage.ID {
+			// the champion is listed among the challengers
+			continue
+		}
+		metrics, ok := accuracy(challenger.Model.ID)
+		if !ok {
+			return
+		}
+		config.Challengers = append(config.Challengers, DeploymentChallengerMetricsModel{
+			ID:                       types.StringValue(challenger.ID),
+			Name:                     types.StringValue(challenger.Name),
+			RegisteredModelVersionID: types.StringValue(challenger.ModelPackage.ID),
+			PredictionEnvironmentID:  types.StringValue(challenger.PredictionEnvironment.ID),
+			Metrics:                  float64MapValue(metrics, &resp.Diagnostics),
+			MetricsPercentChange:     float64MapValue(metricsPercentChange(metrics, championMetrics), &resp.Diagnostics),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// accuracyMetricValues returns the metrics of accuracy that have a value.
+func accuracyMetricValues(accuracy *client.DeploymentAccuracy) map[string]float64 {
+	values := map[string]float64{}
+	if accuracy == nil {
+		return values
+	}
+	for name, metric := range accuracy.Metrics {
+		if metric.Value != nil {
+			values[name] = *metric.Value
+		}
+	}
+	return values
+}
+
+// metricsPercentChange returns the change of each metric relative to the champion, in percent.
+func metricsPercentChange(metrics, champion map[string]float64) map[string]float64 {
+	changes := map[string]float64{}
+	for name, value := range metrics {
+		championValue, ok := champion[name]
+		if !ok || championValue == 0 {
+			continue
+		}
+		changes[name] = (value - championValue) / math.Abs(championValue) * 100
+	}
+	return changes
+}
+
+func float64MapValue(values map[string]float64, diags *diag.Diagnostics) types.Map {
+	elements := make(map[string]attr.Value, len(values))
+	for name, value := range values {
+		elements[name] = types.Float64Value(value)
+	}
+	result, d := types.MapValue(types.Float64Type, elements)
+	diags.Append(d...)
+	return result
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+)
+
+func TestAccuracyMetricValues(t *testing.T) {
+	t.Parallel()
+
+	value := 0.25
+	got := accuracyMetricValues(&client.DeploymentAccuracy{Metrics: map[string]client.DeploymentAccuracyMetric{
+		"LogLoss": {Value: &value},
+		"AUC":     {},
+	}})
+	if want := map[string]float64{"LogLoss": 0.25}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("accuracyMetricValues = %v, want %v", got, want)
+	}
+}
+
+func TestMetricsPercentChange(t *testing.T) {
+	t.Parallel()
+
+	got := metricsPercentChange(
+		map[string]float64{"LogLoss": 0.3, "RMSE": 2, "AUC": 0.9, "Gini": 0.5},
+		map[string]float64{"LogLoss": 0.4, "RMSE": -4, "AUC": 0},
+	)
+	want := map[string]float64{"LogLoss": -25, "RMSE": 150}
+	if len(got) != len(want) {
+		t.Fatalf("metricsPercentChange = %v, want %v", got, want)
+	}
+	for name, value := range want {
+		if diff := got[name] - value; diff > 1e-9 || diff < -1e-9 {
+			t.Fatalf("metricsPercentChange[%s] = %v, want %v", name, got[name], value)
+		}
+	}
+}
@@ -0,0 +1,362 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DeploymentDataSource{}
+
+func NewDeploymentDataSource() datasource.DataSource {
+	return &DeploymentDataSource{}
+}
+
+type DeploymentDataSource struct {
+	provider *Provider
+}
+
+func (d *DeploymentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (d *DeploymentDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up an existing Deployment by ID or label, e.g. a Deployment managed in another workspace.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the Deployment. Either `id` or `label` must be provided.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("label")),
+				},
+			},
+			"label": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The label of the Deployment. Either `id` or `label` must be provided. The label must identify a single Deployment.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the Deployment, e.g. `active` or `inactive`.",
+			},
+			"importance": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The importance of the Deployment.",
+			},
+			"prediction_environment_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the Prediction Environment of the Deployment.",
+			},
+			"prediction_environment_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the Prediction Environment of the Deployment.",
+			},
+			"prediction_environment_platform": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The platform of the Prediction Environment of the Deployment.",
+			},
+			"registered_model_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the Registered Model of the current model package of the Deployment.",
+			},
+			"registered_model_version_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the Registered Model Version (model package) currently deployed.",
+			},
+			"registered_model_version_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the Registered Model Version (model package) currently deployed.",
+			},
+			"prediction_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL to request predictions from the Deployment.",
+			},
+			"tags": deploymentTagsDataSourceAttribute(),
+			"challenger_models_settings": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The challenger models settings for the Deployment.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Is 'True' if challenger models is enabled for this deployment.",
+					},
+				},
+			},
+			"segment_analysis_settings": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The segment analysis settings for the Deployment.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Is 'True' if segment analysis is enabled for this deployment.",
+					},
+					"attributes": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "The segment attributes selected for tracking.",
+					},
+				},
+			},
+			"batch_monitoring_settings": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The batch monitoring settings for the Deployment.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "If batch monitoring is enabled.",
+					},
+				},
+			},
+			"drift_tracking_settings": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The drift tracking settings for the Deployment.",
+				Attributes: map[string]schema.Attribute{
+					"target_drift_enabled": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "If target drift tracking is turned on.",
+					},
+					"feature_drift_enabled": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "If feature drift tracking is turned on.",
+					},
+					"feature_selection": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The feature selection method used for drift tracking.",
+					},
+					"tracked_features": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "List of features tracked for drift.",
+					},
+				},
+			},
+			"association_id_settings": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Association ID settings for the Deployment.",
+				Attributes: map[string]schema.Attribute{
+					"auto_generate_id": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether to auto generate ID.",
+					},
+					"column_names": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Name of the columns used as association ID.",
+					},
+					"required_in_prediction_requests": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether the association ID column is required in prediction requests.",
+					},
+				},
+			},
+			"predictions_data_collection_settings": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The predictions data collection settings for the Deployment.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "If predictions data collections is enabled for this Deployment.",
+					},
+				},
+			},
+			"prediction_intervals_settings": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The prediction intervals settings for the Deployment.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether prediction intervals are enabled for this deployment.",
+					},
+					"percentiles": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.Int64Type,
+						MarkdownDescription: "List of enabled prediction intervals’ sizes for this deployment.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploymentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if d.provider, ok = req.ProviderData.(*Provider); !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please report this issue to the provider developers.", Provider{}, req.ProviderData),
+		)
+	}
+}
+
+func (d *DeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DeploymentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var deployment *client.Deployment
+	var err error
+	if IsKnown(config.ID) {
+		traceAPICall("GetDeployment")
+		deployment, err = d.provider.service.GetDeployment(ctx, config.ID.ValueString())
+		if err != nil {
+			if errors.Is(err, &client.NotFoundError{}) {
+				resp.Diagnostics.AddError("Deployment not found", fmt.Sprintf("Deployment with ID %q not found.", config.ID.ValueString()))
+			} else {
+				resp.Diagnostics.AddError(fmt.Sprintf("Error getting Deployment with ID %s", config.ID.ValueString()), err.Error())
+			}
+			return
+		}
+	} else {
+		label := config.Label.ValueString()
+		traceAPICall("ListDeployments")
+		deployments, err := d.provider.service.ListDeployments(ctx, &client.ListDeploymentsRequest{Search: label})
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing Deployments", err.Error())
+			return
+		}
+
+		var matches []client.Deployment
+		for _, candidate := range deployments {
+			if candidate.Label == label {
+				matches = append(matches, candidate)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddError("Deployment not found", fmt.Sprintf("Deployment with label %q not found.", label))
+			return
+		case 1:
+			deployment = &matches[0]
+		default:
+			resp.Diagnostics.AddError(
+				"Multiple Deployments found",
+				fmt.Sprintf("%d Deployments have the label %q. Look up the Deployment by `id` instead.", len(matches), label))
+			return
+		}
+	}
+
+	traceAPICall("GetDeploymentSettings")
+	settings, err := d.provider.service.GetDeploymentSettings(ctx, deployment.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error getting settings of Deployment with ID %s", deployment.ID), err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(deployment.ID)
+	config.Label = types.StringValue(deployment.Label)
+	config.Status = types.StringValue(deployment.Status)
+	config.Importance = types.StringValue(deployment.Importance)
+	config.PredictionEnvironmentID = types.StringValue(deployment.PredictionEnvironment.ID)
+	config.PredictionEnvironmentName = types.StringValue(deployment.PredictionEnvironment.Name)
+	config.PredictionEnvironmentPlatform = types.StringValue(deployment.PredictionEnvironment.Platform)
+	config.RegisteredModelID = types.StringValue(deployment.ModelPackage.RegisteredModelID)
+	config.RegisteredModelVersionID = types.StringValue(deployment.ModelPackage.ID)
+	config.RegisteredModelVersionName = types.StringValue(deployment.ModelPackage.Name)
+	config.PredictionURL = types.StringValue(client.PredictionURLForDeployment(deployment, d.provider.service.BaseURL()))
+	config.Tags = deploymentTagsToModel(deployment.Tags)
+	loadDeploymentSettingsIntoDataSourceModel(settings, &config)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func deploymentTagsDataSourceAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "The tags of the Deployment.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The name of the tag.",
+				},
+				"value": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The value of the tag.",
+				},
+			},
+		},
+	}
+}
+
+func deploymentTagsToModel(tags []client.Tag) []Tag {
+	result := make([]Tag, len(tags))
+	for i, tag := range tags {
+		result[i] = Tag{
+			Name:  types.StringValue(tag.Name),
+			Value: types.StringValue(tag.Value),
+		}
+	}
+	return result
+}
+
+func loadDeploymentSettingsIntoDataSourceModel(settings *client.DeploymentSettings, data *DeploymentDataSourceModel) {
+	if settings.ChallengerModels != nil {
+		data.ChallengerModelsSettings = &BasicDeploymentSetting{
+			Enabled: types.BoolValue(settings.ChallengerModels.Enabled),
+		}
+	}
+	if settings.SegmentAnalysis != nil {
+		data.SegmentAnalysisSettings = &SegmentAnalysisSettings{
+			Enabled: types.BoolValue(settings.SegmentAnalysis.Enabled),
+		}
+		if settings.SegmentAnalysis.Attributes != nil {
+			data.SegmentAnalysisSettings.Attributes = convertToTfStringList(*settings.SegmentAnalysis.Attributes)
+		}
+	}
+	if settings.BatchMonitoring != nil {
+		data.BatchMonitoringSettings = &BasicDeploymentSetting{
+			Enabled: types.BoolValue(settings.BatchMonitoring.Enabled),
+		}
+	}
+	if settings.TargetDrift != nil || settings.FeatureDrift != nil {
+		data.DriftTrackingSettings = &DriftTrackingSettings{
+			TargetDriftEnabled:  types.BoolValue(settings.TargetDrift != nil && settings.TargetDrift.Enabled),
+			FeatureDriftEnabled: types.BoolValue(settings.FeatureDrift != nil && settings.FeatureDrift.Enabled),
+			FeatureSelection:    types.StringNull(),
+		}
+		if settings.FeatureDrift != nil {
+			data.DriftTrackingSettings.FeatureSelection = types.StringPointerValue(settings.FeatureDrift.FeatureSelection)
+			if settings.FeatureDrift.TrackedFeatures != nil {
+				data.DriftTrackingSettings.TrackedFeatures = convertToTfStringList(*settings.FeatureDrift.TrackedFeatures)
+			}
+		}
+	}
+	if settings.AssociationID != nil {
+		data.AssociationIDSettings = &AssociationIDSettings{
+			AutoGenerateID:               types.BoolValue(settings.AssociationID.AutoGenerateID),
+			ColumnNames:                  convertToTfStringList(settings.AssociationID.ColumnNames),
+			RequiredInPredictionRequests: types.BoolValue(settings.AssociationID.RequiredInPredictionRequests),
+		}
+	}
+	if settings.PredictionsDataCollection != nil {
+		data.PredictionsDataCollectionSettings = &BasicDeploymentSetting{
+			Enabled: types.BoolValue(settings.PredictionsDataCollection.Enabled),
+		}
+	}
+	if settings.PredictionIntervals != nil {
+		data.PredictionIntervalsSettings = &PredictionIntervalsSettings{
+			Enabled: types.BoolValue(settings.PredictionIntervals.Enabled),
+		}
+		for _, percentile := range settings.PredictionIntervals.Percentiles {
+			data.PredictionIntervalsSettings.Percentiles = append(data.PredictionIntervalsSettings.Percentiles, types.Int64Value(percentile))
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	mock_client "github.com/datarobot-community/terraform-provider-datarobot/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestIntegrationDeploymentDataSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_client.NewMockService(ctrl)
+	defer HookGlobal(&NewService, func(c *client.Client) client.Service {
+		return mockService
+	})()
+
+	if globalTestCfg.ApiKey == "" {
+		t.Setenv(DataRobotApiKeyEnvVar, "fake")
+	}
+
+	id := uuid.NewString()
+	label := "deployment-ds-" + uuid.NewString()
+	deployment := &client.Deployment{
+		ID:         id,
+		Label:      label,
+		Status:     "active",
+		Importance: "HIGH",
+		ModelPackage: client.ModelPackage{
+			ID:                "model-package-id",
+			Name:              "v1",
+			RegisteredModelID: "registered-model-id",
+		},
+		PredictionEnvironment: client.PredictionEnvironment{
+			ID:       "prediction-environment-id",
+			Name:     "DataRobot Serverless",
+			Platform: "datarobotServerless",
+		},
+		Tags: []client.Tag{{Name: "team", Value: "platform"}},
+	}
+	featureSelection := "auto"
+	settings := &client.DeploymentSettings{
+		ChallengerModels: &client.BasicSetting{Enabled: true},
+		TargetDrift:      &client.BasicSetting{Enabled: true},
+		FeatureDrift:     &client.FeatureDriftSetting{Enabled: false, FeatureSelection: &featureSelection},
+		AssociationID: &client.AssociationIDSetting{
+			ColumnNames:                  []string{"id"},
+			RequiredInPredictionRequests: true,
+		},
+	}
+
+	mockService.EXPECT().BaseURL().Return("https://app.datarobot.com").AnyTimes()
+	mockService.EXPECT().GetDeployment(gomock.Any(), id).Return(deployment, nil).AnyTimes()
+	mockService.EXPECT().GetDeploymentSettings(gomock.Any(), id).Return(settings, nil).AnyTimes()
+	mockService.EXPECT().ListDeployments(gomock.Any(), &client.ListDeploymentsRequest{Search: label}).Return([]client.Deployment{
+		*deployment,
+		{ID: uuid.NewString(), Label: label + "-other"},
+	}, nil).AnyTimes()
+	mockService.EXPECT().ListDeployments(gomock.Any(), &client.ListDeploymentsRequest{Search: "duplicate"}).Return([]client.Deployment{
+		{ID: uuid.NewString(), Label: "duplicate"},
+		{ID: uuid.NewString(), Label: "duplicate"},
+	}, nil).AnyTimes()
+	mockService.EXPECT().ListDeployments(gomock.Any(), &client.ListDeploymentsRequest{Search: "invalid"}).Return([]client.Deployment{}, nil).AnyTimes()
+
+	dataSourceName := "data.datarobot_deployment.test"
+	checks := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttr(dataSourceName, "id", id),
+		resource.TestCheckResourceAttr(dataSourceName, "label", label),
+		resource.TestCheckResourceAttr(dataSourceName, "status", "active"),
+		resource.TestCheckResourceAttr(dataSourceName, "importance", "HIGH"),
+		resource.TestCheckResourceAttr(dataSourceName, "prediction_environment_id", "prediction-environment-id"),
+		resource.TestCheckResourceAttr(dataSourceName, "prediction_environment_name", "DataRobot Serverless"),
+		resource.TestCheckResourceAttr(dataSourceName, "registered_model_id", "registered-model-id"),
+		resource.TestCheckResourceAttr(dataSourceName, "registered_model_version_id", "model-package-id"),
+		resource.TestCheckResourceAttr(dataSourceName, "prediction_url", "https://app.datarobot.com/api/v2/deployments/"+id+"/predictions"),
+		resource.TestCheckResourceAttr(dataSourceName, "tags.#", "1"),
+		resource.TestCheckResourceAttr(dataSourceName, "tags.0.name", "team"),
+		resource.TestCheckResourceAttr(dataSourceName, "tags.0.value", "platform"),
+		resource.TestCheckResourceAttr(dataSourceName, "challenger_models_settings.enabled", "true"),
+		resource.TestCheckResourceAttr(dataSourceName, "drift_tracking_settings.target_drift_enabled", "true"),
+		resource.TestCheckResourceAttr(dataSourceName, "drift_tracking_settings.feature_drift_enabled", "false"),
+		resource.TestCheckResourceAttr(dataSourceName, "drift_tracking_settings.feature_selection", "auto"),
+		resource.TestCheckResourceAttr(dataSourceName, "association_id_settings.column_names.0", "id"),
+		resource.TestCheckResourceAttr(dataSourceName, "association_id_settings.required_in_prediction_requests", "true"),
+		resource.TestCheckNoResourceAttr(dataSourceName, "batch_monitoring_settings"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: deploymentDataSourceConfig("id", id),
+				Check:  checks,
+			},
+			{
+				Config: deploymentDataSourceConfig("label", label),
+				Check:  checks,
+			},
+			{
+				Config:      deploymentDataSourceConfig("label", "duplicate"),
+				ExpectError: regexp.MustCompile("Multiple Deployments found"),
+			},
+			{
+				Config:      deploymentDataSourceConfig("label", "invalid"),
+				ExpectError: regexp.MustCompile("Deployment not found"),
+			},
+		},
+	})
+}
+
+func deploymentDataSourceConfig(attribute, value string) string {
+	return fmt.Sprintf(`
+data "datarobot_deployment" "test" {
+	%s = "%s"
+}
+`, attribute, value)
+}
@@ -6,12 +6,15 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,6 +29,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DeploymentResource{}
 var _ resource.ResourceWithImportState = &DeploymentResource{}
+var _ resource.ResourceWithModifyPlan = &DeploymentResource{}
 
 const deploymentLogsSeparator = "----------------------------------------"
 
@@ -86,6 +90,28 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Required:            true,
 				MarkdownDescription: "The ID of the registered model version for this Deployment.",
 			},
+			"replacement_validation": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "How a change of `registered_model_version_id` is validated against the Deployment, for example its target type, features and runtime. " +
+					"The validation runs during `terraform plan` when the new version ID is known, and again before the model is replaced. " +
+					"`strict` (default) fails when a check does not pass, `warn` reports the checks that do not pass as warnings and replaces the model anyway, and `off` skips the validation.",
+				Default: stringdefault.StaticString(replacementValidationStrict),
+				Validators: []validator.String{
+					stringvalidator.OneOf(replacementValidationStrict, replacementValidationWarn, replacementValidationOff),
+				},
+			},
+			"promote_challenger_id": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The ID of a Challenger of the Deployment to promote to champion. When the value changes, the model of the Deployment is replaced " +
+					"with the registered model version of the Challenger, validated according to `replacement_validation`. " +
+					"`registered_model_version_id` keeps its configured value afterwards, so that the promoted model is not replaced back; " +
+					"it cannot change together with `promote_challenger_id`. Ignored when the Deployment is created.",
+			},
+			"promoted_registered_model_version_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the registered model version promoted by `promote_challenger_id`, while it is deployed and `registered_model_version_id` has not changed since.",
+			},
 			"prediction_environment_id": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The ID of the predication environment for this Deployment.",
@@ -99,6 +125,11 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "The list of Use Case IDs to add the Deployment to.",
 				ElementType:         types.StringType,
 			},
+			"use_case_ids_all": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of Use Case IDs the Deployment is added to: `use_case_ids` and the provider's `default_use_case_ids`.",
+				ElementType:         types.StringType,
+			},
 			"importance": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -577,6 +608,10 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionAttribute("Deployment"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -605,6 +640,16 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
+	if IsKnown(data.PromoteChallengerID) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("promote_challenger_id"),
+			"Challenger not promoted",
+			"promote_challenger_id is ignored when the Deployment is created, because the Deployment has no Challengers yet.")
+	}
+	data.PromotedModelVersionID = types.StringNull()
+
 	request := &client.CreateDeploymentFromModelPackageRequest{
 		ModelPackageID:          data.RegisteredModelVersionID.ValueString(),
 		PredictionEnvironmentID: data.PredictionEnvironmentID.ValueString(),
@@ -624,7 +669,7 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 	traceAPICall("CreateDeployment")
 	createResp, statusID, err := r.provider.service.CreateDeploymentFromModelPackage(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Deployment", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Deployment", err)
 		return
 	}
 	if statusID == "" {
@@ -663,7 +708,7 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 
 	err = r.updateDeploymentSettings(ctx, createResp.ID, data)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Deployment settings", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Deployment settings", err)
 		return
 	}
 
@@ -673,7 +718,7 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 	}
 
-	for _, useCaseID := range data.UseCaseIDs {
+	for _, useCaseID := range effectiveUseCaseIDs(data.UseCaseIDsAll, data.UseCaseIDs) {
 		traceAPICall("AddDeploymentToUseCase")
 		if err = addEntityToUseCase(
 			ctx,
@@ -682,7 +727,7 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 			"deployment",
 			deployment.ID,
 		); err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error adding Deployment to Use Case %s", useCaseID), err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error adding Deployment to Use Case %s", useCaseID), err)
 			return
 		}
 	}
@@ -699,6 +744,8 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -719,9 +766,17 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	data.Label = types.StringValue(deployment.Label)
-	data.RegisteredModelVersionID = types.StringValue(deployment.ModelPackage.ID)
+	if !IsKnown(data.PromotedModelVersionID) || deployment.ModelPackage.ID != data.PromotedModelVersionID.ValueString() {
+		// the promoted model was replaced since, outside of promote_challenger_id
+		data.RegisteredModelVersionID = types.StringValue(deployment.ModelPackage.ID)
+		data.PromotedModelVersionID = types.StringNull()
+	}
 	data.PredictionEnvironmentID = types.StringValue(deployment.PredictionEnvironment.ID)
 	data.Importance = types.StringValue(deployment.Importance)
+	if data.ReplacementValidation.IsNull() {
+		// not returned by the API, e.g. after import
+		data.ReplacementValidation = types.StringValue(replacementValidationStrict)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -735,6 +790,8 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state DeploymentResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -758,7 +815,7 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 				fmt.Sprintf("Deployment with ID %s is not found. Removing from state.", id))
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error updating Deployment", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Deployment", err)
 		}
 		return
 	}
@@ -774,80 +831,42 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 	// PUT /runtimeParameters/ returns 500 immediately after model replacement.
 	err = r.updateDeploymentRuntimeParameters(ctx, id, plan, state)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Deployment runtime parameters", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Deployment runtime parameters", err)
 		return
 	}
 
-	if plan.RegisteredModelVersionID != state.RegisteredModelVersionID {
-		// Ensure the deployment is active before model replacement,
-		// otherwise the replacement may hang or fail.
-		if err = r.ensureDeploymentActive(ctx, id); err != nil {
-			resp.Diagnostics.AddError("Error activating Deployment for model replacement", err.Error())
-			return
-		}
-
-		traceAPICall("ValidateDeploymentModelReplacement")
-		validateModelReplacementResp, err := r.provider.service.ValidateDeploymentModelReplacement(ctx, id, &client.ValidateDeployemntModelReplacementRequest{
-			ModelPackageID: plan.RegisteredModelVersionID.ValueString(),
-		})
-		if err != nil {
-			resp.Diagnostics.AddError("Error validating Deployment model replacement", err.Error())
-			return
-		}
-
-		if validateModelReplacementResp.Status != "passing" {
-			resp.Diagnostics.AddError("Invalid Deployment model replacement", validateModelReplacementResp.Message)
-			return
-		}
-
-		traceAPICall("UpdateDeploymentModel")
-		_, statusId, err := r.provider.service.UpdateDeploymentModel(ctx, id, &client.UpdateDeploymentModelRequest{
-			ModelPackageID: plan.RegisteredModelVersionID.ValueString(),
-			Reason:         "OTHER",
-		})
-		if err != nil {
-			resp.Diagnostics.AddError("Error replacing Deployment model", err.Error())
-			return
-		}
-		if statusId == "" {
-			resp.Diagnostics.AddError("Unable to find Deployment model replacement task", "Status ID is empty")
-		}
-
-		err = waitForModelReplacementTaskToComplete(ctx, r.provider.service, statusId)
-		if err != nil {
-			var taskFailedErr *TaskFailedError
-			baseMessage := err.Error()
-			if errors.As(err, &taskFailedErr) {
-				baseMessage = taskFailedErr.Message
+	mode := replacementValidationMode(plan.ReplacementValidation)
+	if IsKnown(plan.PromoteChallengerID) && plan.PromoteChallengerID != state.PromoteChallengerID {
+		versionID := plan.PromotedModelVersionID.ValueString()
+		if !IsKnown(plan.PromotedModelVersionID) {
+			traceAPICall("GetDeploymentChallenger")
+			challenger, err := r.provider.service.GetDeploymentChallenger(ctx, id, plan.PromoteChallengerID.ValueString())
+			if err != nil {
+				addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error getting Challenger with ID %s", plan.PromoteChallengerID.ValueString()), err)
+				return
 			}
-			resp.Diagnostics.AddError("Deployment model replacement task not completed", r.deploymentErrorWithLogs(ctx, id, baseMessage))
-			return
+			versionID = challenger.ModelPackage.ID
 		}
-
-		_, err = r.waitForDeploymentToBeReady(ctx, id)
-		if err != nil {
-			resp.Diagnostics.AddError("Deployment not ready after model replacement", err.Error())
+		if !r.replaceDeploymentModel(ctx, id, versionID, mode, &resp.Diagnostics) {
 			return
 		}
-
-		// Final ground-truth check with bounded retries: backend propagation can lag
-		// briefly after the deployment first returns to "active".
-		_, err = r.waitForDeploymentModelPackage(
-			ctx,
-			id,
-			plan.RegisteredModelVersionID.ValueString(),
-		)
-		if err != nil {
-			resp.Diagnostics.AddError("Deployment model replacement did not apply", err.Error())
+		plan.PromotedModelVersionID = types.StringValue(versionID)
+	} else if plan.RegisteredModelVersionID != state.RegisteredModelVersionID {
+		// the promoted version is deployed already
+		if plan.RegisteredModelVersionID != state.PromotedModelVersionID &&
+			!r.replaceDeploymentModel(ctx, id, plan.RegisteredModelVersionID.ValueString(), mode, &resp.Diagnostics) {
 			return
 		}
+		plan.PromotedModelVersionID = types.StringNull()
+	} else {
+		plan.PromotedModelVersionID = state.PromotedModelVersionID
 	}
 
 	// check if updating retraining settings
 	if plan.RetrainingSettings != nil && !reflect.DeepEqual(plan.RetrainingSettings, state.RetrainingSettings) {
 		err = r.updateRetrainingSettings(ctx, id, plan.RetrainingSettings)
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Deployment retraining settings", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Deployment retraining settings", err)
 			return
 		}
 	}
@@ -866,7 +885,7 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 	// deactivate Deployment if Resource Bundle is being updated
 	err = r.updateDeploymentSettingsInNotActiveState(ctx, id, plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Deployment settings in not active state", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Deployment settings in not active state", err)
 		return
 	}
 
@@ -878,7 +897,7 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	err = r.updateDeploymentSettings(ctx, id, plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Deployment settings", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Deployment settings", err)
 		return
 	}
 
@@ -887,10 +906,10 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		r.provider.service,
 		"deployment",
 		plan.ID.ValueString(),
-		state.UseCaseIDs,
-		plan.UseCaseIDs,
+		effectiveUseCaseIDs(state.UseCaseIDsAll, state.UseCaseIDs),
+		effectiveUseCaseIDs(plan.UseCaseIDsAll, plan.UseCaseIDs),
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating Use Cases for Deployment", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Use Cases for Deployment", err)
 		return
 	}
 
@@ -905,6 +924,12 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	if checkDeletionProtection(data.DeletionProtection, "Deployment", data.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	deploymentID := data.ID.ValueString()
 	tflog.Info(ctx, "Starting Deployment deletion", map[string]interface{}{
 		"deployment_id": deploymentID,
@@ -928,7 +953,7 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 	// (e.g. for serverless deployments that need to spin down compute resources).
 	// Prediction environments cannot be deleted while any deployment still references
 	// them, so we must wait here to avoid 409 Conflict errors on PE deletion.
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 	waitForGone := func() error {
 		_, err := r.provider.service.GetDeployment(ctx, deploymentID)
 		if err != nil {
@@ -965,7 +990,7 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 		})
 
 		// Retry with exponential backoff since deployment deletion might not be fully processed
-		expBackoff := getExponentialBackoff()
+		expBackoff := getExponentialBackoff(ctx)
 		deleteOperation := func() error {
 			traceAPICall("DeleteCustomModel")
 			err := r.provider.service.DeleteCustomModel(ctx, customModelID)
@@ -974,7 +999,7 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 					return nil // Already deleted - success
 				}
 				// Retry on 409 conflicts as the deployment might still be processing
-				if strings.Contains(err.Error(), "409 Conflict") || strings.Contains(err.Error(), "existing deployments") {
+				if errors.Is(err, &client.ConflictError{}) || strings.Contains(err.Error(), "existing deployments") {
 					tflog.Debug(ctx, "Custom model still has deployment references, retrying", map[string]interface{}{
 						"custom_model_id": customModelID,
 					})
@@ -1009,6 +1034,240 @@ func (r *DeploymentResource) ImportState(ctx context.Context, req resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *DeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDeletionProtection(ctx, req, resp, "Deployment", path.Root("prediction_environment_id"))
+	r.provider.modifyPlanUseCaseIDsAll(ctx, req, resp)
+	r.modifyPlanChallengerPromotion(ctx, req, resp)
+	r.modifyPlanModelReplacement(ctx, req, resp)
+}
+
+const (
+	replacementValidationStrict = "strict"
+	replacementValidationWarn   = "warn"
+	replacementValidationOff    = "off"
+)
+
+// replacementValidationMode returns the replacement_validation mode, strict while it is unknown.
+func replacementValidationMode(value types.String) string {
+	if !IsKnown(value) {
+		return replacementValidationStrict
+	}
+	return value.ValueString()
+}
+
+// modifyPlanModelReplacement validates a change of registered_model_version_id at plan time,
+// so that an incompatible model is reported before other resources are changed by the apply.
+func (r *DeploymentResource) modifyPlanModelReplacement(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		// Resource is being created or destroyed
+		return
+	}
+
+	var id, stateVersionID, promotedVersionID, planVersionID, mode types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("registered_model_version_id"), &stateVersionID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("promoted_registered_model_version_id"), &promotedVersionID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("registered_model_version_id"), &planVersionID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("replacement_validation"), &mode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !IsKnown(id) || !IsKnown(planVersionID) || planVersionID.Equal(stateVersionID) || planVersionID.Equal(promotedVersionID) {
+		return
+	}
+	if replacementValidationMode(mode) == replacementValidationOff {
+		return
+	}
+
+	validationDiags, err := r.validateModelReplacement(ctx, id.ValueString(), planVersionID.ValueString(), replacementValidationMode(mode))
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("registered_model_version_id"),
+			"Unable to validate Deployment model replacement",
+			fmt.Sprintf("The replacement is validated again during apply: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(validationDiags...)
+}
+
+// modifyPlanChallengerPromotion plans promoted_registered_model_version_id: the version of the
+// Challenger when promote_challenger_id changes, which is validated like a change of
+// registered_model_version_id, none when registered_model_version_id changes, and else the
+// version promoted before.
+func (r *DeploymentResource) modifyPlanChallengerPromotion(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		// Resource is being created or destroyed
+		return
+	}
+
+	var id, stateChallengerID, planChallengerID, stateVersionID, planVersionID, promotedVersionID, mode types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("promote_challenger_id"), &stateChallengerID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("promote_challenger_id"), &planChallengerID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("registered_model_version_id"), &stateVersionID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("registered_model_version_id"), &planVersionID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("promoted_registered_model_version_id"), &promotedVersionID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("replacement_validation"), &mode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case planChallengerID.IsUnknown():
+		promotedVersionID = types.StringUnknown()
+	case !planChallengerID.IsNull() && !planChallengerID.Equal(stateChallengerID):
+		if !planVersionID.Equal(stateVersionID) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("promote_challenger_id"),
+				"Conflicting Deployment model changes",
+				"registered_model_version_id and promote_challenger_id cannot change together: apply one change, then the other.")
+			return
+		}
+
+		traceAPICall("GetDeploymentChallenger")
+		challenger, err := r.provider.service.GetDeploymentChallenger(ctx, id.ValueString(), planChallengerID.ValueString())
+		if err != nil {
+			addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error getting Challenger with ID %s", planChallengerID.ValueString()), err)
+			return
+		}
+		promotedVersionID = types.StringValue(challenger.ModelPackage.ID)
+
+		if mode := replacementValidationMode(mode); mode != replacementValidationOff {
+			validationDiags, err := r.validateModelReplacement(ctx, id.ValueString(), challenger.ModelPackage.ID, mode)
+			if err != nil {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("promote_challenger_id"),
+					"Unable to validate Deployment model replacement",
+					fmt.Sprintf("The replacement is validated again during apply: %s", err))
+			}
+			resp.Diagnostics.Append(validationDiags...)
+		}
+	case !planVersionID.Equal(stateVersionID):
+		promotedVersionID = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("promoted_registered_model_version_id"), promotedVersionID)...)
+}
+
+// validateModelReplacement validates replacing the model of the Deployment with the registered
+// model version versionID. The checks that do not pass are returned as diagnostics of
+// registered_model_version_id: errors in strict mode and warnings in warn mode.
+func (r *DeploymentResource) validateModelReplacement(ctx context.Context, id, versionID, mode string) (diag.Diagnostics, error) {
+	traceAPICall("ValidateDeploymentModelReplacement")
+	result, err := r.provider.service.ValidateDeploymentModelReplacement(ctx, id, &client.ValidateDeployemntModelReplacementRequest{
+		ModelPackageID: versionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return modelReplacementDiagnostics(result, mode), nil
+}
+
+// modelReplacementDiagnostics reports each check of result that does not pass, sorted by name.
+// When no check explains a validation that does not pass, its overall message is reported.
+func modelReplacementDiagnostics(result *client.ValidateDeployemntModelReplacementResponse, mode string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if result == nil || result.Status == "passing" {
+		return diags
+	}
+
+	add := func(summary, detail string) {
+		if mode == replacementValidationWarn {
+			diags.AddAttributeWarning(path.Root("registered_model_version_id"), summary, detail)
+		} else {
+			diags.AddAttributeError(path.Root("registered_model_version_id"), summary, detail)
+		}
+	}
+
+	names := make([]string, 0, len(result.Checks))
+	for name, check := range result.Checks {
+		if check.Status != "passing" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		check := result.Checks[name]
+		add(
+			fmt.Sprintf("Deployment model replacement check %q is %s", name, check.Status),
+			check.Message)
+	}
+
+	if len(names) == 0 {
+		add("Invalid Deployment model replacement", result.Message)
+	}
+	return diags
+}
+
+// replaceDeploymentModel replaces the model of the Deployment with the registered model version
+// versionID, validated according to mode. It reports whether the model was replaced.
+func (r *DeploymentResource) replaceDeploymentModel(ctx context.Context, id, versionID, mode string, diags *diag.Diagnostics) bool {
+	// Ensure the deployment is active before model replacement,
+	// otherwise the replacement may hang or fail.
+	if err := r.ensureDeploymentActive(ctx, id); err != nil {
+		diags.AddError("Error activating Deployment for model replacement", err.Error())
+		return false
+	}
+
+	if mode != replacementValidationOff {
+		validationDiags, err := r.validateModelReplacement(ctx, id, versionID, mode)
+		if err != nil {
+			diags.AddError("Error validating Deployment model replacement", err.Error())
+			return false
+		}
+		diags.Append(validationDiags...)
+		if diags.HasError() {
+			return false
+		}
+	}
+
+	traceAPICall("UpdateDeploymentModel")
+	_, statusId, err := r.provider.service.UpdateDeploymentModel(ctx, id, &client.UpdateDeploymentModelRequest{
+		ModelPackageID: versionID,
+		Reason:         "OTHER",
+	})
+	if err != nil {
+		diags.AddError("Error replacing Deployment model", err.Error())
+		return false
+	}
+	if statusId == "" {
+		diags.AddError("Unable to find Deployment model replacement task", "Status ID is empty")
+		return false
+	}
+
+	err = waitForModelReplacementTaskToComplete(ctx, r.provider.service, statusId)
+	if err != nil {
+		var taskFailedErr *TaskFailedError
+		baseMessage := err.Error()
+		if errors.As(err, &taskFailedErr) {
+			baseMessage = taskFailedErr.Message
+		}
+		diags.AddError("Deployment model replacement task not completed", r.deploymentErrorWithLogs(ctx, id, baseMessage))
+		return false
+	}
+
+	_, err = r.waitForDeploymentToBeReady(ctx, id)
+	if err != nil {
+		diags.AddError("Deployment not ready after model replacement", err.Error())
+		return false
+	}
+
+	// Final ground-truth check with bounded retries: backend propagation can lag
+	// briefly after the deployment first returns to "active".
+	_, err = r.waitForDeploymentModelPackage(
+		ctx,
+		id,
+		versionID,
+	)
+	if err != nil {
+		diags.AddError("Deployment model replacement did not apply", err.Error())
+		return false
+	}
+
+	return true
+}
+
 func (r *DeploymentResource) waitForDeploymentToBeReady(ctx context.Context, id string) (*client.Deployment, error) {
 	return r.waitForDeploymentStatus(ctx, id, "active")
 }
@@ -1018,7 +1277,7 @@ func (r *DeploymentResource) waitForDeploymentModelPackage(
 	id string,
 	expectedModelPackageID string,
 ) (*client.Deployment, error) {
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	startTime := time.Now()
 	lastStatus := ""
@@ -1113,7 +1372,7 @@ func (r *DeploymentResource) waitForDeploymentModelPackage(
 }
 
 func (r *DeploymentResource) waitForDeploymentStatus(ctx context.Context, id string, status string) (*client.Deployment, error) {
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	startTime := time.Now()
 	lastStatus := ""
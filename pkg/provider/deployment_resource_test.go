@@ -12,8 +12,11 @@ import (
 	mock_client "github.com/datarobot-community/terraform-provider-datarobot/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -629,7 +632,8 @@ func TestUnitDeploymentModelReplacementSuccess(t *testing.T) {
 		Return(oldDeployment, nil)
 	mockService.EXPECT().
 		ValidateDeploymentModelReplacement(gomock.Any(), depID, gomock.Any()).
-		Return(&client.ValidateDeployemntModelReplacementResponse{Status: "passing"}, nil)
+		Return(&client.ValidateDeployemntModelReplacementResponse{Status: "passing"}, nil).
+		MinTimes(1) // validated at plan time and again before the replacement
 	mockService.EXPECT().
 		UpdateDeploymentModel(gomock.Any(), depID, gomock.Any()).
 		DoAndReturn(func(_ context.Context, _ string, _ *client.UpdateDeploymentModelRequest) (*client.Deployment, string, error) {
@@ -725,7 +729,8 @@ func TestUnitDeploymentModelReplacementMismatch(t *testing.T) {
 		Return(oldDeployment, nil)
 	mockService.EXPECT().
 		ValidateDeploymentModelReplacement(gomock.Any(), depID, gomock.Any()).
-		Return(&client.ValidateDeployemntModelReplacementResponse{Status: "passing"}, nil)
+		Return(&client.ValidateDeployemntModelReplacementResponse{Status: "passing"}, nil).
+		MinTimes(1) // validated at plan time and again before the replacement
 	mockService.EXPECT().
 		UpdateDeploymentModel(gomock.Any(), depID, gomock.Any()).
 		Return(nil, updateTaskID, nil)
@@ -764,6 +769,80 @@ resource "datarobot_deployment" "test_replacement" {
 `, pkgID, predEnvID)
 }
 
+func TestModelReplacementDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	failing := &client.ValidateDeployemntModelReplacementResponse{
+		Status:  "failing",
+		Message: "Model cannot be used for replacement.",
+		Checks: map[string]client.ModelReplacementCheck{
+			"targetType": {Status: "failing", Message: "Target type Regression does not match Binary."},
+			"features":   {Status: "warning", Message: "Feature age is missing."},
+			"runtime":    {Status: "passing", Message: "Runtime is compatible."},
+		},
+	}
+
+	t.Run("strict reports errors sorted by check", func(t *testing.T) {
+		diags := modelReplacementDiagnostics(failing, replacementValidationStrict)
+		if diags.ErrorsCount() != 2 || diags.WarningsCount() != 0 {
+			t.Fatalf("diagnostics = %v, want 2 errors", diags)
+		}
+		if !strings.Contains(diags[0].Summary(), `"features"`) || diags[1].Detail() != "Target type Regression does not match Binary." {
+			t.Fatalf("diagnostics = %v, want features then targetType", diags)
+		}
+	})
+
+	t.Run("warn reports warnings", func(t *testing.T) {
+		diags := modelReplacementDiagnostics(failing, replacementValidationWarn)
+		if diags.HasError() || diags.WarningsCount() != 2 {
+			t.Fatalf("diagnostics = %v, want 2 warnings", diags)
+		}
+	})
+
+	t.Run("overall message without checks", func(t *testing.T) {
+		diags := modelReplacementDiagnostics(&client.ValidateDeployemntModelReplacementResponse{
+			Status:  "failing",
+			Message: "Model cannot be used for replacement.",
+		}, replacementValidationStrict)
+		if diags.ErrorsCount() != 1 || diags[0].Detail() != "Model cannot be used for replacement." {
+			t.Fatalf("diagnostics = %v, want the overall message", diags)
+		}
+	})
+
+	t.Run("passing reports nothing", func(t *testing.T) {
+		diags := modelReplacementDiagnostics(&client.ValidateDeployemntModelReplacementResponse{Status: "passing"}, replacementValidationStrict)
+		if len(diags) != 0 {
+			t.Fatalf("diagnostics = %v, want none", diags)
+		}
+	})
+}
+
+func TestValidateModelReplacement(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockService := mock_client.NewMockService(ctrl)
+	resource := &DeploymentResource{provider: &Provider{service: mockService}}
+
+	mockService.EXPECT().
+		ValidateDeploymentModelReplacement(gomock.Any(), "dep-1", &client.ValidateDeployemntModelReplacementRequest{ModelPackageID: "pkg-2"}).
+		Return(&client.ValidateDeployemntModelReplacementResponse{
+			Status: "failing",
+			Checks: map[string]client.ModelReplacementCheck{"targetType": {Status: "failing", Message: "mismatch"}},
+		}, nil)
+	mockService.EXPECT().
+		ValidateDeploymentModelReplacement(gomock.Any(), "dep-1", gomock.Any()).
+		Return(nil, fmt.Errorf("boom"))
+
+	diags, err := resource.validateModelReplacement(context.Background(), "dep-1", "pkg-2", replacementValidationStrict)
+	if err != nil || diags.ErrorsCount() != 1 {
+		t.Fatalf("diagnostics = %v, err = %v; want one error", diags, err)
+	}
+	if _, err := resource.validateModelReplacement(context.Background(), "dep-1", "pkg-2", replacementValidationStrict); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
 func TestWaitForDeploymentModelPackageEventuallyConsistent(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -887,3 +966,111 @@ func TestWaitForDeploymentModelPackageReturnsPermanentGetDeploymentError(t *test
 		t.Fatalf("expected GetDeployment error to take precedence over stale status, got: %v", err)
 	}
 }
+
+func TestModifyPlanChallengerPromotion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &DeploymentResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	// values sets the attributes of a deployment plan or state; the others are null.
+	values := func(attributes map[string]types.String) tftypes.Value {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		for name, value := range attributes {
+			if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+				t.Fatalf("SetAttribute(%s): %v", name, diags)
+			}
+		}
+		return state.Raw
+	}
+	state := map[string]types.String{
+		"id":                                   types.StringValue("dep-1"),
+		"registered_model_version_id":          types.StringValue("pkg-1"),
+		"replacement_validation":               types.StringValue(replacementValidationWarn),
+		"promote_challenger_id":                types.StringValue("challenger-0"),
+		"promoted_registered_model_version_id": types.StringValue("pkg-0"),
+	}
+	with := func(changes map[string]types.String) map[string]types.String {
+		result := map[string]types.String{}
+		for name, value := range state {
+			result[name] = value
+		}
+		for name, value := range changes {
+			result[name] = value
+		}
+		result["promoted_registered_model_version_id"] = types.StringUnknown()
+		return result
+	}
+
+	tests := []struct {
+		name       string
+		plan       map[string]types.String
+		expect     func(*mock_client.MockService)
+		want       types.String
+		wantErrors int
+	}{
+		{
+			name: "promote_challenger_id changes",
+			plan: with(map[string]types.String{"promote_challenger_id": types.StringValue("challenger-1")}),
+			expect: func(m *mock_client.MockService) {
+				m.EXPECT().GetDeploymentChallenger(gomock.Any(), "dep-1", "challenger-1").
+					Return(&client.DeploymentChallenger{ID: "challenger-1", ModelPackage: client.ModelPackage{ID: "pkg-2"}}, nil)
+				m.EXPECT().ValidateDeploymentModelReplacement(gomock.Any(), "dep-1", &client.ValidateDeployemntModelReplacementRequest{ModelPackageID: "pkg-2"}).
+					Return(&client.ValidateDeployemntModelReplacementResponse{Status: "passing"}, nil)
+			},
+			want: types.StringValue("pkg-2"),
+		},
+		{
+			name: "registered_model_version_id changes",
+			plan: with(map[string]types.String{"registered_model_version_id": types.StringValue("pkg-0")}),
+			want: types.StringNull(),
+		},
+		{
+			name: "both change",
+			plan: with(map[string]types.String{
+				"promote_challenger_id":       types.StringValue("challenger-1"),
+				"registered_model_version_id": types.StringValue("pkg-3"),
+			}),
+			wantErrors: 1,
+		},
+		{
+			name: "neither changes",
+			plan: with(map[string]types.String{"replacement_validation": types.StringValue(replacementValidationStrict)}),
+			want: types.StringValue("pkg-0"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockService := mock_client.NewMockService(ctrl)
+			if tt.expect != nil {
+				tt.expect(mockService)
+			}
+			r := &DeploymentResource{provider: &Provider{service: mockService}}
+
+			req := fwresource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: values(state)},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: values(tt.plan)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.modifyPlanChallengerPromotion(ctx, req, resp)
+
+			if resp.Diagnostics.ErrorsCount() != tt.wantErrors {
+				t.Fatalf("diagnostics = %v, want %d errors", resp.Diagnostics, tt.wantErrors)
+			}
+			if tt.wantErrors > 0 {
+				return
+			}
+			var got types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("promoted_registered_model_version_id"), &got)...)
+			if !got.Equal(tt.want) {
+				t.Fatalf("promoted_registered_model_version_id = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -336,6 +336,9 @@ func (r *DeploymentRetrainingPolicyResource) Schema(ctx context.Context, req res
 				MarkdownDescription: "The ID of the use case to which the retraining policy belongs.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -362,6 +365,8 @@ func (r *DeploymentRetrainingPolicyResource) Create(ctx context.Context, req res
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if err := r.checkDeploymentRetrainingSettings(ctx, data); err != nil {
 		resp.Diagnostics.AddError("Error checking deployment retraining settings", err.Error())
 		return
@@ -376,7 +381,7 @@ func (r *DeploymentRetrainingPolicyResource) Create(ctx context.Context, req res
 	traceAPICall("CreateRetrainingPolicy")
 	deploymentRetrainingPolicy, err := r.provider.service.CreateRetrainingPolicy(ctx, data.DeploymentID.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Retraining Policy", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Retraining Policy", err)
 		return
 	}
 	data.ID = types.StringValue(deploymentRetrainingPolicy.ID)
@@ -393,6 +398,8 @@ func (r *DeploymentRetrainingPolicyResource) Read(ctx context.Context, req resou
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -437,6 +444,8 @@ func (r *DeploymentRetrainingPolicyResource) Update(ctx context.Context, req res
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if err := r.checkDeploymentRetrainingSettings(ctx, data); err != nil {
 		resp.Diagnostics.AddError("Error checking deployment retraining settings", err.Error())
 		return
@@ -451,7 +460,7 @@ func (r *DeploymentRetrainingPolicyResource) Update(ctx context.Context, req res
 	traceAPICall("UpdateDeploymentRetrainingPolicy")
 	_, err = r.provider.service.UpdateRetrainingPolicy(ctx, data.DeploymentID.ValueString(), data.ID.ValueString(), request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Retraining Policy", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Retraining Policy", err)
 		return
 	}
 
@@ -466,6 +475,8 @@ func (r *DeploymentRetrainingPolicyResource) Delete(ctx context.Context, req res
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteRetrainingPolicy")
 	err := r.provider.service.DeleteRetrainingPolicy(ctx, data.DeploymentID.ValueString(), data.ID.ValueString())
 	if err != nil {
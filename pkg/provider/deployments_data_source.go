@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DeploymentsDataSource{}
+
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &DeploymentsDataSource{}
+}
+
+type DeploymentsDataSource struct {
+	provider *Provider
+}
+
+func (d *DeploymentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+func (d *DeploymentsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the Deployments matching all of the given filters. Without filters, every Deployment the API key can access is listed.",
+
+		Attributes: map[string]schema.Attribute{
+			"importance": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "List only Deployments of this importance.",
+				Validators:          ImportanceValidators(),
+			},
+			"tags": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "List only Deployments that have all of these tags, given as a map of tag name to value.",
+			},
+			"prediction_environment_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "List only Deployments in this Prediction Environment.",
+			},
+			"use_case_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "List only Deployments added to this Use Case.",
+			},
+			"deployments": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Deployments matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the Deployment.",
+						},
+						"label": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The label of the Deployment.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the Deployment.",
+						},
+						"importance": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The importance of the Deployment.",
+						},
+						"prediction_environment_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the Prediction Environment of the Deployment.",
+						},
+						"registered_model_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the Registered Model of the current model package of the Deployment.",
+						},
+						"registered_model_version_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the Registered Model Version (model package) currently deployed.",
+						},
+						"prediction_url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The URL to request predictions from the Deployment.",
+						},
+						"tags": deploymentTagsDataSourceAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploymentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if d.provider, ok = req.ProviderData.(*Provider); !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please report this issue to the provider developers.", Provider{}, req.ProviderData),
+		)
+	}
+}
+
+func (d *DeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DeploymentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listReq := &client.ListDeploymentsRequest{}
+	if IsKnown(config.Importance) {
+		listReq.Importance = []string{config.Importance.ValueString()}
+	}
+	if IsKnown(config.Tags) {
+		tags := make(map[string]string, len(config.Tags.Elements()))
+		resp.Diagnostics.Append(config.Tags.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		listReq.TagKeys, listReq.TagValues = deploymentTagFilters(tags)
+	}
+
+	traceAPICall("ListDeployments")
+	deployments, err := d.provider.service.ListDeployments(ctx, listReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing Deployments", err.Error())
+		return
+	}
+
+	filter := deploymentsFilter{
+		importance:              config.Importance.ValueString(),
+		predictionEnvironmentID: config.PredictionEnvironmentID.ValueString(),
+	}
+	if IsKnown(config.UseCaseID) {
+		traceAPICall("ListUseCaseDeployments")
+		useCaseDeployments, err := d.provider.service.ListUseCaseDeployments(ctx, config.UseCaseID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error listing Deployments of Use Case %s", config.UseCaseID.ValueString()), err.Error())
+			return
+		}
+		filter.deploymentIDs = make(map[string]bool, len(useCaseDeployments))
+		for _, deployment := range useCaseDeployments {
+			filter.deploymentIDs[deployment.ID] = true
+		}
+	}
+
+	baseURL := d.provider.service.BaseURL()
+	config.Deployments = []DeploymentSummaryModel{}
+	for i := range deployments {
+		deployment := &deployments[i]
+		if !filter.matches(deployment) {
+			continue
+		}
+		config.Deployments = append(config.Deployments, DeploymentSummaryModel{
+			ID:                       types.StringValue(deployment.ID),
+			Label:                    types.StringValue(deployment.Label),
+			Status:                   types.StringValue(deployment.Status),
+			Importance:               types.StringValue(deployment.Importance),
+			PredictionEnvironmentID:  types.StringValue(deployment.PredictionEnvironment.ID),
+			RegisteredModelID:        types.StringValue(deployment.ModelPackage.RegisteredModelID),
+			RegisteredModelVersionID: types.StringValue(deployment.ModelPackage.ID),
+			PredictionURL:            types.StringValue(client.PredictionURLForDeployment(deployment, baseURL)),
+			Tags:                     deploymentTagsToModel(deployment.Tags),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// deploymentsFilter holds the filters that are applied to the listed deployments
+// rather than sent to the API. Empty fields do not filter.
+type deploymentsFilter struct {
+	importance              string
+	predictionEnvironmentID string
+	// deploymentIDs are the deployments of the use case filter, nil if unset.
+	deploymentIDs map[string]bool
+}
+
+func (f deploymentsFilter) matches(deployment *client.Deployment) bool {
+	if f.importance != "" && deployment.Importance != f.importance {
+		return false
+	}
+	if f.predictionEnvironmentID != "" && deployment.PredictionEnvironment.ID != f.predictionEnvironmentID {
+		return false
+	}
+	if f.deploymentIDs != nil && !f.deploymentIDs[deployment.ID] {
+		return false
+	}
+	return true
+}
+
+// deploymentTagFilters returns the tagKeys and tagValues query parameters for the
+// given tags, ordered by tag name so that requests are stable.
+func deploymentTagFilters(tags map[string]string) (keys, values []string) {
+	for name := range tags {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+	for _, name := range keys {
+		values = append(values, tags[name])
+	}
+	return keys, values
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	mock_client "github.com/datarobot-community/terraform-provider-datarobot/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestIntegrationDeploymentsDataSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_client.NewMockService(ctrl)
+	defer HookGlobal(&NewService, func(c *client.Client) client.Service {
+		return mockService
+	})()
+
+	if globalTestCfg.ApiKey == "" {
+		t.Setenv(DataRobotApiKeyEnvVar, "fake")
+	}
+
+	deployments := []client.Deployment{
+		{
+			ID:                    "dep-1",
+			Label:                 "in use case",
+			Importance:            "HIGH",
+			PredictionEnvironment: client.PredictionEnvironment{ID: "env-1"},
+			Tags:                  []client.Tag{{Name: "team", Value: "platform"}},
+		},
+		{
+			ID:                    "dep-2",
+			Label:                 "other environment",
+			Importance:            "HIGH",
+			PredictionEnvironment: client.PredictionEnvironment{ID: "env-2"},
+		},
+		{
+			ID:                    "dep-3",
+			Label:                 "not in use case",
+			Importance:            "HIGH",
+			PredictionEnvironment: client.PredictionEnvironment{ID: "env-1"},
+		},
+	}
+
+	mockService.EXPECT().BaseURL().Return("https://app.datarobot.com").AnyTimes()
+	mockService.EXPECT().ListDeployments(gomock.Any(), &client.ListDeploymentsRequest{
+		Importance: []string{"HIGH"},
+		TagKeys:    []string{"team"},
+		TagValues:  []string{"platform"},
+	}).Return(deployments, nil).AnyTimes()
+	mockService.EXPECT().ListUseCaseDeployments(gomock.Any(), "use-case-id").Return([]client.Deployment{
+		{ID: "dep-1"},
+		{ID: "dep-2"},
+	}, nil).AnyTimes()
+
+	dataSourceName := "data.datarobot_deployments.test"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "datarobot_deployments" "test" {
+	importance                = "HIGH"
+	tags                      = { team = "platform" }
+	prediction_environment_id = "env-1"
+	use_case_id               = "use-case-id"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.0.id", "dep-1"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.0.label", "in use case"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.0.prediction_url", "https://app.datarobot.com/api/v2/deployments/dep-1/predictions"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.0.tags.0.name", "team"),
+				),
+			},
+		},
+	})
+}
+
+func TestDeploymentsFilterMatches(t *testing.T) {
+	deployment := &client.Deployment{
+		ID:                    "dep-1",
+		Importance:            "LOW",
+		PredictionEnvironment: client.PredictionEnvironment{ID: "env-1"},
+	}
+
+	tests := []struct {
+		name   string
+		filter deploymentsFilter
+		want   bool
+	}{
+		{name: "no filters", filter: deploymentsFilter{}, want: true},
+		{name: "matching importance", filter: deploymentsFilter{importance: "LOW"}, want: true},
+		{name: "other importance", filter: deploymentsFilter{importance: "HIGH"}, want: false},
+		{name: "matching prediction environment", filter: deploymentsFilter{predictionEnvironmentID: "env-1"}, want: true},
+		{name: "other prediction environment", filter: deploymentsFilter{predictionEnvironmentID: "env-2"}, want: false},
+		{name: "in use case", filter: deploymentsFilter{deploymentIDs: map[string]bool{"dep-1": true}}, want: true},
+		{name: "not in use case", filter: deploymentsFilter{deploymentIDs: map[string]bool{"dep-2": true}}, want: false},
+		{name: "empty use case", filter: deploymentsFilter{deploymentIDs: map[string]bool{}}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.matches(deployment); got != tt.want {
+				t.Errorf("expected %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDeploymentTagFilters(t *testing.T) {
+	keys, values := deploymentTagFilters(map[string]string{"team": "platform", "env": "prod"})
+
+	if !reflect.DeepEqual(keys, []string{"env", "team"}) {
+		t.Errorf("expected keys ordered by name, got %v", keys)
+	}
+	if !reflect.DeepEqual(values, []string{"prod", "platform"}) {
+		t.Errorf("expected values in key order, got %v", values)
+	}
+}
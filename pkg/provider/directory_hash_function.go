@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &DirectoryHashFunction{}
+
+func NewDirectoryHashFunction() function.Function {
+	return &DirectoryHashFunction{}
+}
+
+// DirectoryHashFunction hashes the contents of a local directory.
+type DirectoryHashFunction struct{}
+
+func (f *DirectoryHashFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "directory_hash"
+}
+
+func (f *DirectoryHashFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Hashes the contents of a directory",
+		MarkdownDescription: "Hashes the contents of every file under `path`, following symlinks. " +
+			"The result matches the `folder_path_hash` that resources with a `folder_path` compute for the same directory, " +
+			"so it can drive `replace_triggered_by` or `triggers` when the directory changes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "path",
+				MarkdownDescription: "The path to the directory.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DirectoryHashFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var path string
+
+	resp.Error = req.Arguments.Get(ctx, &path)
+	if resp.Error != nil {
+		return
+	}
+
+	hash, err := computeFolderHash(ctx, types.StringValue(path), nil)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Could not hash directory %q: %s", path, err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, hash)
+}
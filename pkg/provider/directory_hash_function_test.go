@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDirectoryHashFunction(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "app.py"), []byte("print('hello')"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := computeFolderHash(context.Background(), types.StringValue(dir), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, funcErr := runFunction(t, NewDirectoryHashFunction(), types.StringValue(dir))
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+	if !result.Equal(expected) {
+		t.Errorf("expected the folder_path_hash %s, got %s", expected, result)
+	}
+
+	if _, funcErr = runFunction(t, NewDirectoryHashFunction(), types.StringValue(filepath.Join(dir, "missing"))); funcErr == nil {
+		t.Error("expected an error for a missing directory")
+	}
+}
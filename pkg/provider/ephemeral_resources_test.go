@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	mock_client "github.com/datarobot-community/terraform-provider-datarobot/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEphemeralResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	for _, newEphemeralResource := range (&Provider{}).EphemeralResources(ctx) {
+		ephemeralResource := newEphemeralResource()
+		metadataResponse := &ephemeral.MetadataResponse{}
+		ephemeralResource.Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "datarobot"}, metadataResponse)
+
+		schemaResponse := &ephemeral.SchemaResponse{}
+		ephemeralResource.Schema(ctx, ephemeral.SchemaRequest{}, schemaResponse)
+		if schemaResponse.Diagnostics.HasError() {
+			t.Fatalf("%s: Schema method diagnostics: %+v", metadataResponse.TypeName, schemaResponse.Diagnostics)
+		}
+
+		if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+			t.Fatalf("%s: Schema validation diagnostics: %+v", metadataResponse.TypeName, diagnostics)
+		}
+	}
+}
+
+func TestCredentialSecretEphemeralResourceOpen(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockService := mock_client.NewMockService(ctrl)
+	mockService.EXPECT().GetCredentialSecret(gomock.Any(), "credential-id").Return(&client.CredentialSecret{
+		ID:             "credential-id",
+		Name:           "example",
+		CredentialType: client.CredentialTypeGCP,
+		GCPKey: &client.GCPKey{
+			Type:       "service_account",
+			PrivateKey: "private-key",
+		},
+	}, nil)
+
+	ephemeralResource := &CredentialSecretEphemeralResource{provider: &Provider{service: mockService}}
+	schemaResponse := &ephemeral.SchemaResponse{}
+	ephemeralResource.Schema(ctx, ephemeral.SchemaRequest{}, schemaResponse)
+
+	objectType := schemaResponse.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["id"] = tftypes.NewValue(tftypes.String, "credential-id")
+
+	openResponse := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: schemaResponse.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+	ephemeralResource.Open(ctx, ephemeral.OpenRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResponse.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}, openResponse)
+	if openResponse.Diagnostics.HasError() {
+		t.Fatalf("Open diagnostics: %+v", openResponse.Diagnostics)
+	}
+
+	var data CredentialSecretEphemeralResourceModel
+	if diagnostics := openResponse.Result.Get(ctx, &data); diagnostics.HasError() {
+		t.Fatalf("Result diagnostics: %+v", diagnostics)
+	}
+	if data.CredentialType.ValueString() != client.CredentialTypeGCP {
+		t.Errorf("expected credential type %q, got %q", client.CredentialTypeGCP, data.CredentialType.ValueString())
+	}
+	if !data.Password.IsNull() || !data.ApiToken.IsNull() {
+		t.Errorf("expected secrets of other credential types to be null, got password %s and api token %s", data.Password, data.ApiToken)
+	}
+
+	var gcpKey client.GCPKey
+	if err := json.Unmarshal([]byte(data.GCPKey.ValueString()), &gcpKey); err != nil {
+		t.Fatalf("expected gcp_key to be JSON: %v", err)
+	}
+	if gcpKey.PrivateKey != "private-key" {
+		t.Errorf("expected private key %q, got %q", "private-key", gcpKey.PrivateKey)
+	}
+}
@@ -113,6 +113,9 @@ func (r *ExecutionEnvironmentResource) Schema(ctx context.Context, req resource.
 				MarkdownDescription: "The status of the Execution Environment version build.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -139,6 +142,8 @@ func (r *ExecutionEnvironmentResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	var dockerContextPath string
 	var fileContent []byte
 	var dockerImageContents []byte
@@ -170,7 +175,7 @@ func (r *ExecutionEnvironmentResource) Create(ctx context.Context, req resource.
 		UseCases:            useCases,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Execution Environment", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Execution Environment", err)
 		return
 	}
 
@@ -199,7 +204,7 @@ func (r *ExecutionEnvironmentResource) Create(ctx context.Context, req resource.
 
 	traceAPICall("CreateExecutionEnvironmentVersion")
 	if _, err := r.provider.service.CreateExecutionEnvironmentVersion(ctx, executionEnvironment.ID, createExecutionEnvironmentVersionRequest); err != nil {
-		resp.Diagnostics.AddError("Error creating Execution Environment Version", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Execution Environment Version", err)
 		return
 	}
 
@@ -223,6 +228,8 @@ func (r *ExecutionEnvironmentResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -287,6 +294,8 @@ func (r *ExecutionEnvironmentResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state ExecutionEnvironmentResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -332,7 +341,7 @@ func (r *ExecutionEnvironmentResource) Update(ctx context.Context, req resource.
 				fmt.Sprintf("Execution Environment with ID %s is not found. Removing from state.", plan.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error updating Execution Environment", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Execution Environment", err)
 		}
 		return
 	}
@@ -369,7 +378,7 @@ func (r *ExecutionEnvironmentResource) Update(ctx context.Context, req resource.
 		}
 
 		if _, err := r.provider.service.CreateExecutionEnvironmentVersion(ctx, executionEnvironment.ID, updateExecutionEnvironmentRequest); err != nil {
-			resp.Diagnostics.AddError("Error creating new Execution Environment Version", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating new Execution Environment Version", err)
 			return
 		}
 	}
@@ -393,6 +402,8 @@ func (r *ExecutionEnvironmentResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteExecutionEnvironment")
 	err := r.provider.service.DeleteExecutionEnvironment(ctx, data.ID.ValueString())
 	if err != nil {
@@ -504,7 +515,7 @@ func waitForExecutionEnvironmentToBeReady(ctx context.Context, service client.Se
 		return nil, err
 	}
 
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	operation := func() error {
 		traceAPICall("GetExecutionEnvironmentVersion")
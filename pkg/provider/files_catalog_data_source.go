@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &FilesCatalogDataSource{}
+
+func NewFilesCatalogDataSource() datasource.DataSource {
+	return &FilesCatalogDataSource{}
+}
+
+type FilesCatalogDataSource struct {
+	provider *Provider
+}
+
+func (d *FilesCatalogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_files_catalog"
+}
+
+func (d *FilesCatalogDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasourceschema.Schema{
+		MarkdownDescription: "Versions and files of a Files API catalog, such as one managed by `datarobot_files_catalog` in another module.",
+
+		Attributes: map[string]datasourceschema.Attribute{
+			"id": datasourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the catalog.",
+			},
+			"catalog_version_id": datasourceschema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The catalog version whose files are listed. Defaults to the latest version.",
+			},
+			"limit": datasourceschema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of versions to return, newest first. When omitted, all versions are returned.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"versions": datasourceschema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The versions of the catalog, newest first.",
+				NestedObject: datasourceschema.NestedAttributeObject{
+					Attributes: map[string]datasourceschema.Attribute{
+						"id": datasourceschema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the catalog version.",
+						},
+						"created_at": datasourceschema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the version was created.",
+						},
+						"num_files": datasourceschema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of files in the version.",
+						},
+						"size": datasourceschema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The total size of the files in the version, in bytes.",
+						},
+					},
+				},
+			},
+			"files": datasourceschema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The files of `catalog_version_id`, sorted by path.",
+				NestedObject: datasourceschema.NestedAttributeObject{
+					Attributes: map[string]datasourceschema.Attribute{
+						"path": datasourceschema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The path of the file in the catalog.",
+						},
+						"hash": datasourceschema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The checksum of the file reported by the catalog.",
+						},
+						"size": datasourceschema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The size of the file in bytes.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FilesCatalogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if d.provider, ok = req.ProviderData.(*Provider); !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please report this issue to the provider developers.", Provider{}, req.ProviderData),
+		)
+	}
+}
+
+func (d *FilesCatalogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config FilesCatalogDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogID := config.ID.ValueString()
+	limit := 0
+	if IsKnown(config.Limit) {
+		limit = int(config.Limit.ValueInt64())
+	}
+
+	traceAPICall("ListVersions")
+	versions, err := d.provider.service.FilesAPI().ListVersions(ctx, catalogID, limit)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error listing versions of Files Catalog with ID %s", catalogID), err.Error())
+		return
+	}
+	config.Versions = filesCatalogVersionModels(versions)
+
+	if !IsKnown(config.CatalogVersionID) {
+		if len(versions) == 0 {
+			resp.Diagnostics.AddError(
+				"Files Catalog has no versions",
+				fmt.Sprintf("Files Catalog with ID %s has no versions to list files of.", catalogID))
+			return
+		}
+		config.CatalogVersionID = types.StringValue(versions[0].ID)
+	}
+
+	catalogVersionID := config.CatalogVersionID.ValueString()
+	traceAPICall("AllFiles")
+	files, err := d.provider.service.FilesAPI().AllFiles(ctx, catalogID, catalogVersionID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error listing files of Files Catalog with ID %s version %s", catalogID, catalogVersionID),
+			err.Error())
+		return
+	}
+	config.Files = filesCatalogFileModels(files)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func filesCatalogVersionModels(versions []filesapi.CatalogVersion) []FilesCatalogVersionModel {
+	models := make([]FilesCatalogVersionModel, len(versions))
+	for i, version := range versions {
+		models[i] = FilesCatalogVersionModel{
+			ID:        types.StringValue(version.ID),
+			CreatedAt: types.StringValue(version.CreatedAt),
+			NumFiles:  types.Int64Value(int64(version.NumFiles)),
+			Size:      types.Int64Value(version.TotalSize),
+		}
+	}
+	return models
+}
+
+func filesCatalogFileModels(files map[string]filesapi.FileMeta) []FilesCatalogFileModel {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	models := make([]FilesCatalogFileModel, len(paths))
+	for i, path := range paths {
+		models[i] = FilesCatalogFileModel{
+			Path: types.StringValue(path),
+			Hash: types.StringValue(files[path].Hash),
+			Size: types.Int64Value(files[path].Size),
+		}
+	}
+	return models
+}
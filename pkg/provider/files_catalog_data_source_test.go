@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
+)
+
+func TestFilesCatalogFileModels(t *testing.T) {
+	t.Parallel()
+
+	models := filesCatalogFileModels(map[string]filesapi.FileMeta{
+		"lib/util.py": {Hash: "h2", Size: 2},
+		"main.py":     {Hash: "h1", Size: 1},
+	})
+
+	paths := make([]string, len(models))
+	for i, model := range models {
+		paths[i] = model.Path.ValueString()
+	}
+	if !sort.StringsAreSorted(paths) || len(paths) != 2 {
+		t.Fatalf("paths = %v, want 2 sorted paths", paths)
+	}
+	if models[1].Hash.ValueString() != "h1" || models[1].Size.ValueInt64() != 1 {
+		t.Fatalf("main.py = %+v, want hash h1 and size 1", models[1])
+	}
+}
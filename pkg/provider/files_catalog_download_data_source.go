@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &FilesCatalogDownloadDataSource{}
+
+func NewFilesCatalogDownloadDataSource() datasource.DataSource {
+	return &FilesCatalogDownloadDataSource{}
+}
+
+// FilesCatalogDownloadDataSource writes the files of a catalog version to a local
+// directory, for example to audit the code an artifact was built from.
+type FilesCatalogDownloadDataSource struct {
+	provider *Provider
+}
+
+func (d *FilesCatalogDownloadDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_files_catalog_download"
+}
+
+func (d *FilesCatalogDownloadDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasourceschema.Schema{
+		MarkdownDescription: "Downloads the files of a Files API catalog version to a local directory whenever the data source is read, including during `terraform plan`. " +
+			"Each file is verified against the size and SHA-256 checksum listed by the catalog before it replaces the local file. " +
+			"Files that already match the catalog are not downloaded again, and local files that are not in the catalog version are left untouched.",
+
+		Attributes: map[string]datasourceschema.Attribute{
+			"catalog_id": datasourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the catalog, such as the `id` of a `datarobot_files_catalog` or the `catalog_id` of an artifact's `code_ref`.",
+			},
+			"catalog_version_id": datasourceschema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The catalog version to download. Defaults to the latest version.",
+			},
+			"dir": datasourceschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The local directory the files are written to. It is created when missing.",
+			},
+			"file_manifest": datasourceschema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "SHA-256 hash of each downloaded file, keyed by its path relative to `dir`.",
+			},
+			"file_count": datasourceschema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of files in the catalog version.",
+			},
+			"size": datasourceschema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The total size of the files in the catalog version, in bytes.",
+			},
+		},
+	}
+}
+
+func (d *FilesCatalogDownloadDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if d.provider, ok = req.ProviderData.(*Provider); !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please report this issue to the provider developers.", Provider{}, req.ProviderData),
+		)
+	}
+}
+
+func (d *FilesCatalogDownloadDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config FilesCatalogDownloadDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogID := config.CatalogID.ValueString()
+	if !IsKnown(config.CatalogVersionID) {
+		traceAPICall("ListVersions")
+		versions, err := d.provider.service.FilesAPI().ListVersions(ctx, catalogID, 1)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error listing versions of Files Catalog with ID %s", catalogID), err.Error())
+			return
+		}
+		if len(versions) == 0 {
+			resp.Diagnostics.AddError(
+				"Files Catalog has no versions",
+				fmt.Sprintf("Files Catalog with ID %s has no versions to download.", catalogID))
+			return
+		}
+		config.CatalogVersionID = types.StringValue(versions[0].ID)
+	}
+
+	dir, err := filepath.Abs(config.Dir.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error resolving download directory", err.Error())
+		return
+	}
+
+	cache := d.provider.fileHashCache()
+	traceAPICall("PullVersion")
+	result, err := artifactsource.PullVersion(ctx, d.provider.service.FilesAPI(), artifactsource.PullOptions{
+		Dir:              dir,
+		CatalogID:        catalogID,
+		CatalogVersionID: config.CatalogVersionID.ValueString(),
+		HashCache:        cache,
+	})
+	saveHashCache(ctx, cache)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error downloading Files Catalog with ID %s version %s", catalogID, config.CatalogVersionID.ValueString()),
+			err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Downloaded Files Catalog version", map[string]any{
+		"catalog_id":         catalogID,
+		"catalog_version_id": result.CatalogVersionID,
+		"dir":                dir,
+		"files":              result.FileCount,
+		"downloaded_files":   result.Downloaded,
+		"total_bytes":        result.TotalBytes,
+	})
+	if len(result.Unverified) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Downloaded files not verified by checksum",
+			fmt.Sprintf("The catalog lists no SHA-256 checksum for %d file(s), so only their size was verified: %s.",
+				len(result.Unverified), artifactSourceDriftSummary(result.Unverified)))
+	}
+
+	config.FileManifest = artifactSourceManifestValue(result.FileHashes)
+	config.FileCount = types.Int64Value(int64(result.FileCount))
+	config.Size = types.Int64Value(result.TotalBytes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,437 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &FilesCatalogResource{}
+var _ resource.ResourceWithImportState = &FilesCatalogResource{}
+var _ resource.ResourceWithModifyPlan = &FilesCatalogResource{}
+
+func NewFilesCatalogResource() resource.Resource {
+	return &FilesCatalogResource{}
+}
+
+// FilesCatalogResource syncs a local directory to a Files API catalog that is not tied
+// to an artifact, so that several artifacts can share it and pin its versions.
+type FilesCatalogResource struct {
+	provider *Provider
+}
+
+func (r *FilesCatalogResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_files_catalog"
+}
+
+func (r *FilesCatalogResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Files API catalog synced from a local directory. Each apply that changes the files of `dir` uploads only the added and modified files, deletes the removed ones and produces a new catalog version. " +
+			"Reference `catalog_version_id` from the `image_build_config.code_ref` of artifacts to share one catalog between several artifacts.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the catalog.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"catalog_version_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The catalog version produced by the last upload of `dir`. Changes whenever the files of `dir` change.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dir": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path to the local directory whose files are uploaded to the catalog.",
+			},
+			"dir_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 fingerprint of the `dir` contents not excluded by `ignore_file` or `ignore_patterns`, used to detect changes and skip the upload when unchanged.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_manifest": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "SHA-256 hash of each file uploaded from `dir`, keyed by its path in the catalog, as of the last upload. Compared with the catalog to detect remote drift.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pending_changes": pendingChangesAttribute("`dir`"),
+			"ignore_patterns": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Paths under `dir` to exclude from the upload, in `.gitignore` syntax (for example `node_modules/`, `*.pyc`, `!keep.pyc`). Applied after the patterns of `ignore_file`.",
+			},
+			"ignore_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a file of ignore patterns in `.gitignore` syntax, such as `.drignore` or `.gitignore`. Relative paths are resolved against `dir`.",
+			},
+			"detect_remote_drift": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "When `true` (default), refresh lists the files of `catalog_version_id` and compares them with `file_manifest`. Files added, removed or modified outside Terraform mark the catalog as changed, so the next apply restores them from `dir`. Set to `false` to skip the listing for very large catalogs.",
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *FilesCatalogResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if r.provider, ok = req.ProviderData.(*Provider); !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please report this issue to the provider developers.", Provider{}, req.ProviderData),
+		)
+	}
+}
+
+func (r *FilesCatalogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FilesCatalogResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
+	if err := r.pushFilesCatalog(ctx, &data, nil); err != nil {
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Files Catalog", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FilesCatalogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FilesCatalogResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
+	if data.ID.IsNull() || data.ID.IsUnknown() {
+		return
+	}
+
+	traceAPICall("ListVersions")
+	versions, err := r.provider.service.FilesAPI().ListVersions(ctx, data.ID.ValueString(), 1)
+	if err != nil {
+		if errors.Is(err, filesapi.ErrNotFound) {
+			resp.Diagnostics.AddWarning(
+				"Files Catalog not found",
+				fmt.Sprintf("Files Catalog with ID %s is not found. Removing from state.", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error getting Files Catalog with ID %s", data.ID.ValueString()),
+				err.Error())
+		}
+		return
+	}
+	if !IsKnown(data.CatalogVersionID) && len(versions) > 0 {
+		// An imported catalog starts from the files of its latest version, so that
+		// the first apply only uploads the files that differ from dir.
+		if err := r.importFilesCatalogVersion(ctx, &data, versions[0].ID); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error getting Files Catalog with ID %s", data.ID.ValueString()),
+				err.Error())
+			return
+		}
+	}
+
+	data.PendingChanges = types.ObjectNull(pendingChangesAttrTypes)
+
+	drifted, err := r.detectFilesCatalogDrift(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to check files catalog for remote drift", err.Error())
+	} else if len(drifted) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Files catalog changed outside Terraform",
+			fmt.Sprintf("%d file(s) in the catalog differ from the last upload of dir: %s. "+
+				"The next apply restores them from dir; set detect_remote_drift = false to skip this check.",
+				len(drifted), artifactSourceDriftSummary(drifted)))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FilesCatalogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FilesCatalogResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
+	if plan.FileManifest.IsUnknown() || plan.CatalogVersionID.IsUnknown() {
+		if err := r.pushFilesCatalog(ctx, &plan, &state); err != nil {
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Files Catalog", err)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FilesCatalogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FilesCatalogResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
+	traceAPICall("DeleteCatalog")
+	if err := r.provider.service.FilesAPI().DeleteCatalog(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error deleting Files Catalog with ID %s", data.ID.ValueString()),
+			err.Error(),
+		)
+	}
+}
+
+func (r *FilesCatalogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *FilesCatalogResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan FilesCatalogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *FilesCatalogResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &FilesCatalogResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !IsKnown(plan.Dir) {
+		// The files are only known at apply time.
+		plan.DirHash = types.StringUnknown()
+		plan.CatalogVersionID = types.StringUnknown()
+		plan.FileManifest = types.MapUnknown(types.StringType)
+		plan.PendingChanges = types.ObjectNull(pendingChangesAttrTypes)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	dirHash, err := filesCatalogDirHash(ctx, &plan, r.provider.fileHashCache())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dir"),
+			"Error calculating directory hash",
+			err.Error(),
+		)
+		return
+	}
+	plan.DirHash = dirHash
+
+	pendingChanges, changes, err := filesCatalogPendingChanges(ctx, r.provider.fileHashCache(), &plan, state)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dir"),
+			"Error previewing directory changes",
+			err.Error(),
+		)
+		return
+	}
+
+	if filesCatalogNeedsUpload(state, pendingChanges, changes) {
+		plan.CatalogVersionID = types.StringUnknown()
+		plan.FileManifest = types.MapUnknown(types.StringType)
+		plan.PendingChanges = pendingChanges
+		addPendingChangesWarning(&resp.Diagnostics, "dir", changes)
+	} else {
+		plan.PendingChanges = state.PendingChanges
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// pushFilesCatalog uploads dir to the catalog of state, or to a new catalog without
+// state, and records the new version and manifest in data. Only the files that differ
+// from the file_manifest of state are uploaded or deleted.
+func (r *FilesCatalogResource) pushFilesCatalog(ctx context.Context, data, state *FilesCatalogResourceModel) error {
+	dir := data.Dir.ValueString()
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("resolve directory %q: %w", dir, err)
+	}
+
+	ignore, err := localSourceIgnore(data.Dir, data.IgnoreFile, data.IgnorePatterns)
+	if err != nil {
+		return err
+	}
+
+	opts := artifactsource.Options{
+		Dir:       absDir,
+		Ignore:    ignore,
+		HashCache: r.provider.fileHashCache(),
+		Limits:    r.provider.artifactUploadLimits(),
+	}
+	if state != nil {
+		opts.CatalogID = state.ID.ValueString()
+		opts.CatalogVersionID = state.CatalogVersionID.ValueString()
+		opts.BaseFiles = manifestFromMapValue(state.FileManifest)
+	}
+
+	traceAPICall("PushDirectory")
+	result, err := artifactsource.PushDirectory(ctx, r.provider.service.FilesAPI(), opts)
+	if err != nil {
+		return fmt.Errorf("upload %s: %w", dir, err)
+	}
+
+	data.ID = types.StringValue(result.CatalogID)
+	data.CatalogVersionID = types.StringValue(result.CatalogVersionID)
+	data.FileManifest = artifactSourceManifestValue(result.FileHashes)
+	if !IsKnown(data.DirHash) {
+		if data.DirHash, err = filesCatalogDirHash(ctx, data, r.provider.fileHashCache()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importFilesCatalogVersion records catalogVersionID and its files in data.
+func (r *FilesCatalogResource) importFilesCatalogVersion(ctx context.Context, data *FilesCatalogResourceModel, catalogVersionID string) error {
+	traceAPICall("AllFiles")
+	remote, err := r.provider.service.FilesAPI().AllFiles(ctx, data.ID.ValueString(), catalogVersionID)
+	if err != nil {
+		return fmt.Errorf("list files of catalog %s version %s: %w", data.ID.ValueString(), catalogVersionID, err)
+	}
+	data.CatalogVersionID = types.StringValue(catalogVersionID)
+	data.FileManifest = remoteManifestValue(remote)
+	return nil
+}
+
+// detectFilesCatalogDrift lists the files of catalog_version_id and compares them with
+// file_manifest. When they diverge, file_manifest is replaced by the files of the catalog,
+// so the next apply uploads the drifted files and deletes the files added remotely.
+// The drifted paths are returned.
+func (r *FilesCatalogResource) detectFilesCatalogDrift(ctx context.Context, data *FilesCatalogResourceModel) ([]string, error) {
+	if !IsKnown(data.FileManifest) || !IsKnown(data.CatalogVersionID) {
+		return nil, nil
+	}
+	if IsKnown(data.DetectRemoteDrift) && !data.DetectRemoteDrift.ValueBool() {
+		return nil, nil
+	}
+
+	catalogID := data.ID.ValueString()
+	catalogVersionID := data.CatalogVersionID.ValueString()
+
+	traceAPICall("AllFiles")
+	remote, err := r.provider.service.FilesAPI().AllFiles(ctx, catalogID, catalogVersionID)
+	if err != nil {
+		return nil, fmt.Errorf("list files of catalog %s version %s: %w", catalogID, catalogVersionID, err)
+	}
+
+	drifted := artifactsource.RemoteDrift(manifestFromMapValue(data.FileManifest), remote)
+	if len(drifted) > 0 {
+		data.FileManifest = remoteManifestValue(remote)
+	}
+	return drifted, nil
+}
+
+// filesCatalogNeedsUpload reports whether the plan uploads dir: on create, while the
+// pending changes are unknown, and when the files of dir differ from file_manifest.
+func filesCatalogNeedsUpload(state *FilesCatalogResourceModel, pendingChanges types.Object, changes fileChanges) bool {
+	if state == nil || !IsKnown(state.CatalogVersionID) {
+		return true
+	}
+	return pendingChanges.IsNull() || !changes.IsEmpty()
+}
+
+// filesCatalogDirHash fingerprints dir with its ignore rules applied. The hash is
+// unknown while the rules are.
+func filesCatalogDirHash(ctx context.Context, data *FilesCatalogResourceModel, cache *artifactsource.HashCache) (types.String, error) {
+	if !localSourceIgnoreKnown(data.IgnoreFile, data.IgnorePatterns) {
+		return types.StringUnknown(), nil
+	}
+	ignore, err := localSourceIgnore(data.Dir, data.IgnoreFile, data.IgnorePatterns)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return computeFolderHashIgnoring(ctx, data.Dir, ignore, cache)
+}
+
+// filesCatalogPendingChanges previews the files the next upload of dir pushes relative
+// to the file_manifest of state. It is null while the ignore rules are unknown.
+func filesCatalogPendingChanges(ctx context.Context, cache *artifactsource.HashCache, plan, state *FilesCatalogResourceModel) (types.Object, fileChanges, error) {
+	if !localSourceIgnoreKnown(plan.IgnoreFile, plan.IgnorePatterns) {
+		return types.ObjectNull(pendingChangesAttrTypes), fileChanges{}, nil
+	}
+	ignore, err := localSourceIgnore(plan.Dir, plan.IgnoreFile, plan.IgnorePatterns)
+	if err != nil {
+		return types.ObjectNull(pendingChangesAttrTypes), fileChanges{}, err
+	}
+	local, err := artifactsource.ScanManifest(plan.Dir.ValueString(), ignore, cache)
+	if err != nil {
+		return types.ObjectNull(pendingChangesAttrTypes), fileChanges{}, err
+	}
+
+	base := types.MapNull(types.StringType)
+	if state != nil {
+		base = state.FileManifest
+	}
+	changes := diffFileChanges(manifestFromMapValue(base), local)
+	value, err := changes.objectValue(ctx)
+	return value, changes, err
+}
+
+// remoteManifestValue converts the files of a catalog version to a file_manifest. A
+// checksum that is not a SHA-256 hash never matches a local file, so such files are
+// uploaded again by the next apply.
+func remoteManifestValue(remote map[string]filesapi.FileMeta) types.Map {
+	elements := make(map[string]attr.Value, len(remote))
+	for path, meta := range remote {
+		elements[path] = types.StringValue(strings.ToLower(meta.Hash))
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
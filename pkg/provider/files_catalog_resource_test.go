@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client/filesapi"
+	mock_client "github.com/datarobot-community/terraform-provider-datarobot/mock"
+	"github.com/golang/mock/gomock"
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPushFilesCatalog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("creates catalog without state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+		filesAPI := newSyncTestFilesAPI()
+		mockService.EXPECT().FilesAPI().Return(filesAPI)
+
+		resource := &FilesCatalogResource{provider: &Provider{service: mockService}}
+		dir := writeArtifactSourceTree(t, map[string]string{"main.py": "a", "lib/util.py": "b"})
+		data := &FilesCatalogResourceModel{
+			Dir:          types.StringValue(dir),
+			DirHash:      types.StringUnknown(),
+			FileManifest: types.MapUnknown(types.StringType),
+		}
+
+		if err := resource.pushFilesCatalog(context.Background(), data, nil); err != nil {
+			t.Fatalf("pushFilesCatalog() error = %v", err)
+		}
+		if data.ID.ValueString() != "cat-new" || data.CatalogVersionID.ValueString() == "" {
+			t.Fatalf("id = %s, catalog_version_id = %s; want a new catalog", data.ID, data.CatalogVersionID)
+		}
+		if got := manifestFromMapValue(data.FileManifest); len(got) != 2 || got["lib/util.py"].Hash != testSHA256Hex("b") {
+			t.Fatalf("file_manifest = %v, want hashes of main.py and lib/util.py", got)
+		}
+		if !IsKnown(data.DirHash) {
+			t.Fatal("expected dir_hash to be computed after upload")
+		}
+	})
+
+	t.Run("pushes only changes relative to state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+		filesAPI := newSyncTestFilesAPI()
+		filesAPI.catalogID = "cat-1"
+		filesAPI.version = 1
+		mockService.EXPECT().FilesAPI().Return(filesAPI)
+
+		resource := &FilesCatalogResource{provider: &Provider{service: mockService}}
+		dir := writeArtifactSourceTree(t, map[string]string{"main.py": "v2", "same.py": "same"})
+		state := &FilesCatalogResourceModel{
+			ID:               types.StringValue("cat-1"),
+			CatalogVersionID: types.StringValue(syncTestVersionID(1)),
+			FileManifest: artifactSourceManifestValue(artifactsource.Manifest{
+				"main.py":    {Hash: testSHA256Hex("v1")},
+				"same.py":    {Hash: testSHA256Hex("same")},
+				"removed.py": {Hash: testSHA256Hex("gone")},
+			}),
+		}
+		data := &FilesCatalogResourceModel{
+			Dir:          types.StringValue(dir),
+			DirHash:      types.StringValue("dir-hash"),
+			FileManifest: types.MapUnknown(types.StringType),
+		}
+
+		if err := resource.pushFilesCatalog(context.Background(), data, state); err != nil {
+			t.Fatalf("pushFilesCatalog() error = %v", err)
+		}
+		if filesAPI.createCatalogCalls != 0 || data.ID.ValueString() != "cat-1" {
+			t.Fatalf("create catalog calls = %d, id = %s; want the catalog of state", filesAPI.createCatalogCalls, data.ID)
+		}
+		if _, ok := filesAPI.stagedFiles["main.py"]; !ok || len(filesAPI.stagedFiles) != 1 {
+			t.Fatalf("staged files = %v, want only main.py", filesAPI.stagedFiles)
+		}
+		if !reflect.DeepEqual(filesAPI.deletedFiles, []string{"removed.py"}) {
+			t.Fatalf("deleted files = %v, want [removed.py]", filesAPI.deletedFiles)
+		}
+		if data.CatalogVersionID.ValueString() == syncTestVersionID(1) {
+			t.Fatal("expected a new catalog version")
+		}
+		if data.DirHash.ValueString() != "dir-hash" {
+			t.Fatalf("dir_hash = %s, want the planned hash", data.DirHash)
+		}
+	})
+
+	t.Run("upload failure is returned", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+		filesAPI := newSyncTestFilesAPI()
+		filesAPI.uploadErr = errors.New("boom")
+		mockService.EXPECT().FilesAPI().Return(filesAPI)
+
+		resource := &FilesCatalogResource{provider: &Provider{service: mockService}}
+		data := &FilesCatalogResourceModel{
+			Dir:          types.StringValue(writeArtifactSourceTree(t, map[string]string{"main.py": "a"})),
+			FileManifest: types.MapUnknown(types.StringType),
+		}
+
+		if err := resource.pushFilesCatalog(context.Background(), data, nil); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+func TestDetectFilesCatalogDrift(t *testing.T) {
+	t.Parallel()
+
+	newModel := func(detect types.Bool) *FilesCatalogResourceModel {
+		return &FilesCatalogResourceModel{
+			ID:                types.StringValue("cat-1"),
+			CatalogVersionID:  types.StringValue("ver-1"),
+			Dir:               types.StringValue(t.TempDir()),
+			DirHash:           types.StringValue("dir-hash"),
+			FileManifest:      artifactSourceManifestValue(artifactsource.Manifest{"main.py": {Hash: testSHA256Hex("v1")}}),
+			PendingChanges:    types.ObjectNull(pendingChangesAttrTypes),
+			DetectRemoteDrift: detect,
+		}
+	}
+
+	t.Run("in sync keeps file_manifest", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+		filesAPI := newSyncTestFilesAPI()
+		filesAPI.remoteFiles = map[string]filesapi.FileMeta{"main.py": {Hash: testSHA256Hex("v1"), Size: 2}}
+		mockService.EXPECT().FilesAPI().Return(filesAPI)
+
+		resource := &FilesCatalogResource{provider: &Provider{service: mockService}}
+		data := newModel(types.BoolValue(true))
+		manifest := data.FileManifest
+
+		drifted, err := resource.detectFilesCatalogDrift(context.Background(), data)
+		if err != nil {
+			t.Fatalf("detectFilesCatalogDrift() error = %v", err)
+		}
+		if len(drifted) != 0 || !data.FileManifest.Equal(manifest) {
+			t.Fatalf("drifted = %v, file_manifest = %s; want no drift", drifted, data.FileManifest)
+		}
+	})
+
+	t.Run("remote changes replace file_manifest", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+		filesAPI := newSyncTestFilesAPI()
+		filesAPI.remoteFiles = map[string]filesapi.FileMeta{
+			"main.py":  {Hash: testSHA256Hex("edited in UI"), Size: 12},
+			"extra.py": {Hash: "d41d8cd98f00b204e9800998ecf8427e", Size: 0},
+		}
+		mockService.EXPECT().FilesAPI().Return(filesAPI)
+
+		resource := &FilesCatalogResource{provider: &Provider{service: mockService}}
+		data := newModel(types.BoolNull())
+
+		drifted, err := resource.detectFilesCatalogDrift(context.Background(), data)
+		if err != nil {
+			t.Fatalf("detectFilesCatalogDrift() error = %v", err)
+		}
+		if !reflect.DeepEqual(drifted, []string{"extra.py", "main.py"}) {
+			t.Fatalf("drifted = %v, want [extra.py main.py]", drifted)
+		}
+		want := artifactsource.Manifest{
+			"main.py":  {Hash: testSHA256Hex("edited in UI")},
+			"extra.py": {Hash: "d41d8cd98f00b204e9800998ecf8427e"},
+		}
+		if got := manifestFromMapValue(data.FileManifest); !reflect.DeepEqual(got, want) {
+			t.Fatalf("file_manifest = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("disabled skips listing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockService := mock_client.NewMockService(ctrl)
+
+		resource := &FilesCatalogResource{provider: &Provider{service: mockService}}
+		drifted, err := resource.detectFilesCatalogDrift(context.Background(), newModel(types.BoolValue(false)))
+		if err != nil || drifted != nil {
+			t.Fatalf("drifted = %v, err = %v; want no check", drifted, err)
+		}
+	})
+}
+
+func TestFilesCatalogNeedsUpload(t *testing.T) {
+	t.Parallel()
+
+	dir := writeArtifactSourceTree(t, map[string]string{"main.py": "v1"})
+	inSync := &FilesCatalogResourceModel{
+		ID:               types.StringValue("cat-1"),
+		CatalogVersionID: types.StringValue("ver-1"),
+		FileManifest:     artifactSourceManifestValue(artifactsource.Manifest{"main.py": {Hash: testSHA256Hex("v1")}}),
+	}
+	plan := &FilesCatalogResourceModel{Dir: types.StringValue(dir)}
+
+	tests := []struct {
+		name  string
+		state *FilesCatalogResourceModel
+		edit  func(t *testing.T)
+		want  bool
+	}{
+		{name: "create", state: nil, want: true},
+		{name: "unchanged", state: inSync, want: false},
+		{
+			name:  "no catalog version",
+			state: &FilesCatalogResourceModel{ID: types.StringValue("cat-1"), CatalogVersionID: types.StringNull(), FileManifest: inSync.FileManifest},
+			want:  true,
+		},
+		{
+			name:  "renamed file",
+			state: inSync,
+			edit: func(t *testing.T) {
+				if err := os.Rename(filepath.Join(dir, "main.py"), filepath.Join(dir, "app.py")); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.edit != nil {
+				tt.edit(t)
+			}
+			pendingChanges, changes, err := filesCatalogPendingChanges(context.Background(), nil, plan, tt.state)
+			if err != nil {
+				t.Fatalf("filesCatalogPendingChanges() error = %v", err)
+			}
+			if got := filesCatalogNeedsUpload(tt.state, pendingChanges, changes); got != tt.want {
+				t.Fatalf("filesCatalogNeedsUpload() = %v, want %v (changes %+v)", got, tt.want, changes)
+			}
+		})
+	}
+}
+
+func TestFilesCatalogApplyKeepsPlannedPendingChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockService := mock_client.NewMockService(ctrl)
+	mockService.EXPECT().FilesAPI().Return(newSyncTestFilesAPI()).AnyTimes()
+
+	r := &FilesCatalogResource{provider: &Provider{service: mockService}}
+	schemaResp := &tfresource.SchemaResponse{}
+	r.Schema(ctx, tfresource.SchemaRequest{}, schemaResp)
+	resourceSchema := schemaResp.Schema
+	nullState := tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}
+
+	dir := writeArtifactSourceTree(t, map[string]string{"main.py": "v1"})
+	proposed := FilesCatalogResourceModel{
+		ID:                types.StringUnknown(),
+		CatalogVersionID:  types.StringUnknown(),
+		Dir:               types.StringValue(dir),
+		DirHash:           types.StringUnknown(),
+		FileManifest:      types.MapUnknown(types.StringType),
+		PendingChanges:    types.ObjectUnknown(pendingChangesAttrTypes),
+		IgnoreFile:        types.StringNull(),
+		DetectRemoteDrift: types.BoolValue(true),
+	}
+	fillTestTimeouts(&proposed.Timeouts)
+
+	plan := testFilesCatalogPlan(t, r, resourceSchema, nullState, proposed)
+	createResp := &tfresource.CreateResponse{State: nullState}
+	r.Create(ctx, tfresource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() diagnostics = %v", createResp.Diagnostics)
+	}
+	assertAppliedMatchesPlan(t, plan, createResp.State)
+
+	var created FilesCatalogResourceModel
+	if diags := createResp.State.Get(ctx, &created); diags.HasError() {
+		t.Fatalf("State.Get() diagnostics = %v", diags)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "main.py"), []byte("v2"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	plan = testFilesCatalogPlan(t, r, resourceSchema, createResp.State, created)
+	updateResp := &tfresource.UpdateResponse{State: createResp.State}
+	r.Update(ctx, tfresource.UpdateRequest{Plan: plan, State: createResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update() diagnostics = %v", updateResp.Diagnostics)
+	}
+	assertAppliedMatchesPlan(t, plan, updateResp.State)
+}
+
+// testFilesCatalogPlan runs ModifyPlan on proposed and checks that it plans an upload.
+func testFilesCatalogPlan(t *testing.T, r *FilesCatalogResource, resourceSchema schema.Schema, state tfsdk.State, proposed FilesCatalogResourceModel) tfsdk.Plan {
+	t.Helper()
+
+	ctx := context.Background()
+	plan := tfsdk.Plan{Schema: resourceSchema}
+	if diags := plan.Set(ctx, &proposed); diags.HasError() {
+		t.Fatalf("Plan.Set() diagnostics = %v", diags)
+	}
+	resp := &tfresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, tfresource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan() diagnostics = %v", resp.Diagnostics)
+	}
+
+	var planned FilesCatalogResourceModel
+	if diags := resp.Plan.Get(ctx, &planned); diags.HasError() {
+		t.Fatalf("Plan.Get() diagnostics = %v", diags)
+	}
+	if planned.PendingChanges.IsNull() || planned.PendingChanges.IsUnknown() {
+		t.Fatalf("pending_changes = %s, want the planned upload", planned.PendingChanges)
+	}
+	return resp.Plan
+}
+
+// assertAppliedMatchesPlan fails like Terraform's "Provider produced inconsistent
+// result after apply" check when a value known in plan differs in state.
+func assertAppliedMatchesPlan(t *testing.T, plan tfsdk.Plan, state tfsdk.State) {
+	t.Helper()
+
+	err := tftypes.Walk(plan.Raw, func(p *tftypes.AttributePath, planned tftypes.Value) (bool, error) {
+		if !planned.IsFullyKnown() {
+			return true, nil
+		}
+		applied, _, err := tftypes.WalkAttributePath(state.Raw, p)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", p, err)
+		}
+		if value, ok := applied.(tftypes.Value); !ok || !value.Equal(planned) {
+			t.Errorf("%s: planned %s, applied %v", p, planned, applied)
+		}
+		return false, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -22,6 +22,7 @@ import (
 var _ resource.Resource = &GoogleCloudCredentialResource{}
 var _ resource.ResourceWithImportState = &GoogleCloudCredentialResource{}
 var _ resource.ResourceWithModifyPlan = &GoogleCloudCredentialResource{}
+var _ resource.ResourceWithConfigValidators = &GoogleCloudCredentialResource{}
 
 func NewGoogleCloudCredentialResource() resource.Resource {
 	return &GoogleCloudCredentialResource{}
@@ -63,16 +64,21 @@ func (r *GoogleCloudCredentialResource) Schema(ctx context.Context, req resource
 			},
 			"gcp_key_file": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The file that has the GCP key. Cannot be used with `gcp_key`.",
+				MarkdownDescription: "The file that has the GCP key. Cannot be used with `gcp_key` or `gcp_key_wo`.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"gcp_key_wo":         writeOnlyAttribute("gcp_key"),
+			"gcp_key_wo_version": writeOnlyVersionAttribute("gcp_key", true),
 			"gcp_key_file_hash": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The hash of the GCP key file contents.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -99,6 +105,9 @@ func (r *GoogleCloudCredentialResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
+	data.GCPKeyWO = writeOnlyValue(ctx, req.Config, "gcp_key_wo", &resp.Diagnostics)
 	gcpKey, err := r.getGCPKey(data)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting GCP key", err.Error())
@@ -112,8 +121,7 @@ func (r *GoogleCloudCredentialResource) Create(ctx context.Context, req resource
 		GCPKey:         &gcpKey,
 	})
 	if err != nil {
-		errMessage := checkCredentialNameAlreadyExists(err, data.Name.ValueString())
-		resp.Diagnostics.AddError("Error creating Google Cloud Credential", errMessage)
+		addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Google Cloud Credential", err, data.Name.ValueString(), "Credential")
 		return
 	}
 
@@ -129,6 +137,8 @@ func (r *GoogleCloudCredentialResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -161,6 +171,8 @@ func (r *GoogleCloudCredentialResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("UpdateGoogleCloudCredential")
 	_, err := r.provider.service.UpdateCredential(ctx,
 		data.ID.ValueString(),
@@ -174,8 +186,7 @@ func (r *GoogleCloudCredentialResource) Update(ctx context.Context, req resource
 				fmt.Sprintf("Google Cloud Credential with ID %s is not found. Removing from state.", data.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			errMessage := checkCredentialNameAlreadyExists(err, data.Name.ValueString())
-			resp.Diagnostics.AddError("Error updating Google Cloud Credential", errMessage)
+			addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Google Cloud Credential", err, data.Name.ValueString(), "Credential")
 		}
 		return
 	}
@@ -191,6 +202,8 @@ func (r *GoogleCloudCredentialResource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteGoogleCloudCredential")
 	err := r.provider.service.DeleteCredential(ctx, data.ID.ValueString())
 	if err != nil {
@@ -210,6 +223,7 @@ func (r GoogleCloudCredentialResource) ConfigValidators(ctx context.Context) []r
 		resourcevalidator.Conflicting(
 			path.MatchRoot("gcp_key"),
 			path.MatchRoot("gcp_key_file"),
+			path.MatchRoot("gcp_key_wo"),
 		),
 	}
 }
@@ -260,8 +274,8 @@ func (r GoogleCloudCredentialResource) ModifyPlan(ctx context.Context, req resou
 
 func (r *GoogleCloudCredentialResource) getGCPKey(data GoogleCloudCredentialResourceModel) (gcpKey client.GCPKey, err error) {
 	var gcpKeyBytes []byte
-	if IsKnown(data.GCPKey) {
-		gcpKeyBytes = []byte(data.GCPKey.ValueString())
+	if IsKnown(data.GCPKey) || IsKnown(data.GCPKeyWO) {
+		gcpKeyBytes = []byte(secretValue(data.GCPKey, data.GCPKeyWO))
 	} else {
 		if gcpKeyBytes, err = r.getGCPKeyFromFile(data.GCPKeyFile.ValueString()); err != nil {
 			return
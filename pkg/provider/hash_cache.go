@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// hashCacheFileName is the file of the hash cache in the Terraform data directory.
+const hashCacheFileName = "datarobot-hash-cache.json"
+
+// defaultHashCachePath is the hash cache in the data directory of the working directory,
+// `.terraform` unless TF_DATA_DIR is set. Terraform starts the provider in the working
+// directory, so a relative TF_DATA_DIR resolves the same way as for Terraform.
+func defaultHashCachePath() string {
+	dataDir := os.Getenv("TF_DATA_DIR")
+	if dataDir == "" {
+		dataDir = ".terraform"
+	}
+	return filepath.Join(dataDir, hashCacheFileName)
+}
+
+// openHashCache returns the hash cache configured by hash_cache and hash_cache_path,
+// or nil when it is disabled. A cache that cannot be loaded or stored is reported as a
+// warning: it only makes plans faster, so the provider continues without it.
+func openHashCache(data ProviderModel) (*artifactsource.HashCache, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	cachePath := data.HashCachePath.ValueString()
+	if cachePath == "" {
+		if !data.HashCache.ValueBool() {
+			return nil, diags
+		}
+		cachePath = defaultHashCachePath()
+	}
+
+	cache, err := artifactsource.OpenHashCache(cachePath)
+	if err != nil {
+		diags.AddWarning("Unable to load file hash cache",
+			"Every file is hashed again and the cache is rewritten: "+err.Error())
+	}
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0o755); err != nil {
+		diags.AddWarning("Unable to use file hash cache",
+			"Files are hashed without the cache: "+err.Error())
+		return nil, diags
+	}
+	return cache, diags
+}
+
+// fileHashCache is the hash cache of the provider, nil when it is disabled or the
+// provider is not configured yet.
+func (p *Provider) fileHashCache() *artifactsource.HashCache {
+	if p == nil {
+		return nil
+	}
+	return p.hashCache
+}
+
+// saveHashCache stores the hashes added to cache. A failure only costs hashing the
+// files again, so it is logged instead of failing the operation.
+func saveHashCache(ctx context.Context, cache *artifactsource.HashCache) {
+	if err := cache.Save(); err != nil {
+		tflog.Warn(ctx, "Unable to save file hash cache", map[string]any{
+			"path":  cache.Path(),
+			"error": err.Error(),
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOpenHashCache(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		cache, diags := openHashCache(ProviderModel{})
+		if cache != nil || diags.HasError() {
+			t.Fatalf("cache = %v, diags = %v; want no cache", cache, diags)
+		}
+	})
+
+	t.Run("defaults to the Terraform data directory", func(t *testing.T) {
+		dataDir := t.TempDir()
+		t.Setenv("TF_DATA_DIR", dataDir)
+
+		cache, diags := openHashCache(ProviderModel{HashCache: types.BoolValue(true)})
+		if cache == nil || len(diags) != 0 {
+			t.Fatalf("cache = %v, diags = %v; want a cache", cache, diags)
+		}
+		if want := filepath.Join(dataDir, hashCacheFileName); cache.Path() != want {
+			t.Fatalf("path = %q, want %q", cache.Path(), want)
+		}
+	})
+
+	t.Run("path enables the cache", func(t *testing.T) {
+		cachePath := filepath.Join(t.TempDir(), "cache", "hashes.json")
+
+		cache, diags := openHashCache(ProviderModel{HashCachePath: types.StringValue(cachePath)})
+		if cache == nil || len(diags) != 0 {
+			t.Fatalf("cache = %v, diags = %v; want a cache", cache, diags)
+		}
+		if cache.Path() != cachePath {
+			t.Fatalf("path = %q, want %q", cache.Path(), cachePath)
+		}
+	})
+}
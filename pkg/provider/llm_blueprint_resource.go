@@ -170,6 +170,9 @@ func (r *LLMBlueprintResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -196,6 +199,8 @@ func (r *LLMBlueprintResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	createLLMBlueprintRequest := &client.CreateLLMBlueprintRequest{
 		Name:             data.Name.ValueString(),
 		Description:      data.Description.ValueString(),
@@ -236,7 +241,7 @@ func (r *LLMBlueprintResource) Create(ctx context.Context, req resource.CreateRe
 	traceAPICall("CreateLLMBlueprint")
 	createResp, err := r.provider.service.CreateLLMBlueprint(ctx, createLLMBlueprintRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating LLM Blueprint", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating LLM Blueprint", err)
 		return
 	}
 
@@ -252,6 +257,8 @@ func (r *LLMBlueprintResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -294,6 +301,8 @@ func (r *LLMBlueprintResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("UpdateLLMBlueprint")
 	_, err := r.provider.service.UpdateLLMBlueprint(ctx,
 		data.ID.ValueString(),
@@ -308,7 +317,7 @@ func (r *LLMBlueprintResource) Update(ctx context.Context, req resource.UpdateRe
 				fmt.Sprintf("LLM Blueprint with ID %s is not found. Removing from state.", data.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error updating LLM Blueprint", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating LLM Blueprint", err)
 		}
 		return
 	}
@@ -324,6 +333,8 @@ func (r *LLMBlueprintResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteLLMBlueprint")
 	err := r.provider.service.DeleteLLMBlueprint(ctx, data.ID.ValueString())
 	if err != nil {
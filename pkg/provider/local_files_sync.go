@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// localFilesSync is the upload of folder_path and files relative to the file_manifest
+// of the last apply: only added and modified files are uploaded, and the previous
+// contents of modified and removed files are deleted remotely.
+type localFilesSync struct {
+	// Manifest maps the path of every local file to the SHA-256 hash of its contents.
+	Manifest types.Map
+	// Uploads are the added and modified files, or every file on a full sync.
+	Uploads []client.FileInfo
+	// Replaced are the paths of the modified and removed files.
+	Replaced []string
+	// Full is set when there is no prior manifest: every file is uploaded and every
+	// previously uploaded file is replaced.
+	Full bool
+}
+
+// IsEmpty reports whether the remote files are already up to date.
+func (s *localFilesSync) IsEmpty() bool {
+	return !s.Full && len(s.Uploads) == 0 && len(s.Replaced) == 0
+}
+
+// planLocalFilesSync hashes folder_path and files and diffs them against priorManifest.
+// Only the files that are uploaded are read into memory.
+func planLocalFilesSync(ctx context.Context, cache *artifactsource.HashCache, folderPath types.String, files types.Dynamic, priorManifest types.Map) (*localFilesSync, error) {
+	sources, err := localFileSources(folderPath, files)
+	if err != nil {
+		return nil, err
+	}
+
+	local, err := localFilesManifest(ctx, sources, cache)
+	if err != nil {
+		return nil, err
+	}
+
+	localPaths := make(map[string]string, len(sources))
+	hashes := make(map[string]string, len(sources))
+	for _, source := range sources {
+		localPaths[source.PathInModel] = source.LocalPath
+		hashes[source.PathInModel] = local[source.PathInModel].Hash
+	}
+
+	manifest, diags := types.MapValueFrom(ctx, types.StringType, hashes)
+	if diags.HasError() {
+		return nil, errors.New(diags.Errors()[0].Detail())
+	}
+
+	sync := &localFilesSync{Manifest: manifest, Full: !IsKnown(priorManifest)}
+
+	base := make(artifactsource.Manifest)
+	if !sync.Full {
+		priorHashes := make(map[string]string, len(priorManifest.Elements()))
+		if diags = priorManifest.ElementsAs(ctx, &priorHashes, false); diags.HasError() {
+			return nil, errors.New(diags.Errors()[0].Detail())
+		}
+		for pathInModel, hash := range priorHashes {
+			base[pathInModel] = artifactsource.FileMeta{Hash: hash}
+		}
+	}
+
+	plan := artifactsource.DiffPushOnly(base, local)
+	for _, pathInModel := range plan.Uploads {
+		var fileInfo client.FileInfo
+		if fileInfo, err = getFileInfo(localPaths[pathInModel], pathInModel); err != nil {
+			return nil, err
+		}
+		sync.Uploads = append(sync.Uploads, fileInfo)
+		if _, ok := base[pathInModel]; ok {
+			sync.Replaced = append(sync.Replaced, pathInModel)
+		}
+	}
+	sync.Replaced = append(sync.Replaced, plan.Deletes...)
+
+	return sync, nil
+}
+
+// replacedItemIDs returns the IDs of the locally uploaded items that the sync replaces.
+func (s *localFilesSync) replacedItemIDs(items []client.FileItem) []string {
+	replaced := make(map[string]bool, len(s.Replaced))
+	for _, pathInModel := range s.Replaced {
+		replaced[pathInModel] = true
+	}
+
+	ids := make([]string, 0)
+	for _, item := range items {
+		if item.FileSource == "local" && (s.Full || replaced[item.FilePath]) {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids
+}
+
+// localFilesManifestValue is the file_manifest of folder_path and files.
+func localFilesManifestValue(ctx context.Context, cache *artifactsource.HashCache, folderPath types.String, files types.Dynamic) (types.Map, error) {
+	sources, err := localFileSources(folderPath, files)
+	if err != nil {
+		return types.MapNull(types.StringType), err
+	}
+	local, err := localFilesManifest(ctx, sources, cache)
+	if err != nil {
+		return types.MapNull(types.StringType), err
+	}
+
+	hashes := make(map[string]string, len(local))
+	for pathInModel, entry := range local {
+		hashes[pathInModel] = entry.Hash
+	}
+	manifest, diags := types.MapValueFrom(ctx, types.StringType, hashes)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), errors.New(diags.Errors()[0].Detail())
+	}
+	return manifest, nil
+}
+
+// localFilesManifest hashes the files of sources by the path each one is uploaded to.
+// The hashes of unchanged files are read from cache, which may be nil.
+func localFilesManifest(ctx context.Context, sources []FileTuple, cache *artifactsource.HashCache) (artifactsource.Manifest, error) {
+	localPaths := make([]string, len(sources))
+	for i, source := range sources {
+		localPaths[i] = source.LocalPath
+	}
+	hashes, err := cache.HashFiles(localPaths)
+	if err != nil {
+		return nil, err
+	}
+	saveHashCache(ctx, cache)
+
+	manifest := make(artifactsource.Manifest, len(sources))
+	for i, source := range sources {
+		info, err := os.Stat(source.LocalPath)
+		if err != nil {
+			return nil, err
+		}
+		manifest[source.PathInModel] = artifactsource.FileMeta{Hash: hashes[i], Size: info.Size()}
+	}
+	return manifest, nil
+}
+
+// localFileSources lists the files of folder_path and files with the path each one is
+// uploaded to.
+func localFileSources(folderPath types.String, files types.Dynamic) (sources []FileTuple, err error) {
+	sources = make([]FileTuple, 0)
+
+	if IsKnown(folderPath) {
+		folder := folderPath.ValueString()
+		if err = WalkSymlinkSafe(folder, func(path string, info os.FileInfo, innerErr error) error {
+			if innerErr != nil {
+				return innerErr
+			}
+			if info.IsDir() {
+				return nil
+			}
+
+			pathInModel := strings.TrimPrefix(path, folder)
+			pathInModel = strings.TrimPrefix(pathInModel, string(filepath.Separator))
+			sources = append(sources, FileTuple{
+				LocalPath:   path,
+				PathInModel: strings.TrimSpace(pathInModel),
+			})
+
+			return nil
+		}); err != nil {
+			return
+		}
+	}
+
+	if IsKnown(files) && files.UnderlyingValue() != nil && IsKnown(files.UnderlyingValue()) {
+		var fileTuples []FileTuple
+		fileTuples, err = formatFiles(files)
+		if err != nil {
+			return
+		}
+
+		for _, file := range fileTuples {
+			sources = append(sources, FileTuple{
+				LocalPath:   file.LocalPath,
+				PathInModel: strings.TrimSpace(file.PathInModel),
+			})
+		}
+	}
+
+	return
+}
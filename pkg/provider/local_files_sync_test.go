@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPlanLocalFilesSync(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("uploads every file without a prior manifest", func(t *testing.T) {
+		dir := writeArtifactSourceTree(t, map[string]string{"main.py": "v1", "lib/util.py": "u1"})
+
+		sync, err := planLocalFilesSync(ctx, nil, types.StringValue(dir), types.DynamicNull(), types.MapNull(types.StringType))
+		if err != nil {
+			t.Fatalf("planLocalFilesSync() error = %v", err)
+		}
+		if !sync.Full || sync.IsEmpty() {
+			t.Fatal("expected a full sync")
+		}
+		if got := uploadedPaths(sync); !reflect.DeepEqual(got, []string{filepath.Join("lib", "util.py"), "main.py"}) {
+			t.Fatalf("uploads = %v", got)
+		}
+		if len(sync.Manifest.Elements()) != 2 {
+			t.Fatalf("manifest = %v, want 2 entries", sync.Manifest)
+		}
+	})
+
+	t.Run("uploads only changed files", func(t *testing.T) {
+		dir := writeArtifactSourceTree(t, map[string]string{"main.py": "v1", "model.bin": "weights", "old.py": "old"})
+		first, err := planLocalFilesSync(ctx, nil, types.StringValue(dir), types.DynamicNull(), types.MapNull(types.StringType))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, "main.py"), []byte("v2"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "new.py"), []byte("new"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Remove(filepath.Join(dir, "old.py")); err != nil {
+			t.Fatal(err)
+		}
+
+		sync, err := planLocalFilesSync(ctx, nil, types.StringValue(dir), types.DynamicNull(), first.Manifest)
+		if err != nil {
+			t.Fatalf("planLocalFilesSync() error = %v", err)
+		}
+		if sync.Full {
+			t.Fatal("expected an incremental sync")
+		}
+		if got := uploadedPaths(sync); !reflect.DeepEqual(got, []string{"main.py", "new.py"}) {
+			t.Fatalf("uploads = %v, want [main.py new.py]", got)
+		}
+		if !reflect.DeepEqual(sync.Replaced, []string{"main.py", "old.py"}) {
+			t.Fatalf("replaced = %v, want [main.py old.py]", sync.Replaced)
+		}
+	})
+
+	t.Run("unchanged tree is empty", func(t *testing.T) {
+		dir := writeArtifactSourceTree(t, map[string]string{"main.py": "v1"})
+		first, err := planLocalFilesSync(ctx, nil, types.StringValue(dir), types.DynamicNull(), types.MapNull(types.StringType))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sync, err := planLocalFilesSync(ctx, nil, types.StringValue(dir), types.DynamicNull(), first.Manifest)
+		if err != nil {
+			t.Fatalf("planLocalFilesSync() error = %v", err)
+		}
+		if !sync.IsEmpty() {
+			t.Fatalf("expected no changes, got uploads %v and replaced %v", uploadedPaths(sync), sync.Replaced)
+		}
+		if !sync.Manifest.Equal(first.Manifest) {
+			t.Fatal("expected the same manifest")
+		}
+	})
+
+	t.Run("files are keyed by their path in the model", func(t *testing.T) {
+		dir := writeArtifactSourceTree(t, map[string]string{"local.py": "v1"})
+		files := types.DynamicValue(types.TupleValueMust(
+			[]attr.Type{types.TupleType{ElemTypes: []attr.Type{types.StringType, types.StringType}}},
+			[]attr.Value{types.TupleValueMust(
+				[]attr.Type{types.StringType, types.StringType},
+				[]attr.Value{types.StringValue(filepath.Join(dir, "local.py")), types.StringValue("custom.py")},
+			)},
+		))
+
+		sync, err := planLocalFilesSync(ctx, nil, types.StringNull(), files, types.MapNull(types.StringType))
+		if err != nil {
+			t.Fatalf("planLocalFilesSync() error = %v", err)
+		}
+		if _, ok := sync.Manifest.Elements()["custom.py"]; !ok {
+			t.Fatalf("manifest = %v, want custom.py", sync.Manifest)
+		}
+	})
+}
+
+func TestLocalFilesSyncReplacedItemIDs(t *testing.T) {
+	t.Parallel()
+
+	items := []client.FileItem{
+		{ID: "item-1", FilePath: "main.py", FileSource: "local"},
+		{ID: "item-2", FilePath: "keep.py", FileSource: "local"},
+		{ID: "item-3", FilePath: "main.py", FileSource: "remote"},
+	}
+
+	incremental := &localFilesSync{Replaced: []string{"main.py"}}
+	if got := incremental.replacedItemIDs(items); !reflect.DeepEqual(got, []string{"item-1"}) {
+		t.Errorf("incremental replacedItemIDs() = %v, want [item-1]", got)
+	}
+
+	full := &localFilesSync{Full: true}
+	if got := full.replacedItemIDs(items); !reflect.DeepEqual(got, []string{"item-1", "item-2"}) {
+		t.Errorf("full replacedItemIDs() = %v, want [item-1 item-2]", got)
+	}
+}
+
+func uploadedPaths(sync *localFilesSync) []string {
+	paths := make([]string, 0, len(sync.Uploads))
+	for _, file := range sync.Uploads {
+		paths = append(paths, file.Path)
+	}
+	return paths
+}
@@ -77,6 +77,9 @@ func (r *MemorySpaceResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -102,6 +105,8 @@ func (r *MemorySpaceResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	enabled, err := isAnyFeatureFlagEnabled(ctx, r.provider.service, memorySpaceFeatureFlags)
 	if err != nil {
 		resp.Diagnostics.AddError("Error checking feature flags", err.Error())
@@ -136,7 +141,7 @@ func (r *MemorySpaceResource) Create(ctx context.Context, req resource.CreateReq
 	traceAPICall("CreateMemorySpace")
 	createResp, err := r.provider.service.CreateMemorySpace(ctx, apiReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Memory Space", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Memory Space", err)
 		return
 	}
 	data.ID = types.StringValue(createResp.MemorySpaceID)
@@ -152,6 +157,8 @@ func (r *MemorySpaceResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -206,6 +213,8 @@ func (r *MemorySpaceResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	// An attribute the config no longer sets must be sent as null to clear it. An
 	// empty string would clear the text fields by coincidence, but llm_base_url is
 	// parsed as a URL and rejects "" with 422.
@@ -244,6 +253,8 @@ func (r *MemorySpaceResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteMemorySpace")
 	err := r.provider.service.DeleteMemorySpace(ctx, data.ID.ValueString())
 	if err != nil {
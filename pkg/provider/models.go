@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -11,7 +12,13 @@ const (
 	DataRobotApiKeyEnvVar       string = "DATAROBOT_API_TOKEN"
 	DataRobotEndpointEnvVar     string = "DATAROBOT_ENDPOINT"
 	DataRobotTraceContextEnvVar string = "DATAROBOT_TRACE_CONTEXT"
+	DataRobotConfigFileEnvVar   string = "DATAROBOT_CONFIG_FILE"
+	DataRobotProfileEnvVar      string = "DATAROBOT_PROFILE"
 	TimeoutMinutesEnvVar        string = "DATAROBOT_TIMEOUT_MINUTES"
+	UploadConcurrencyEnvVar     string = "DATAROBOT_UPLOAD_CONCURRENCY"
+	StageUploadMaxFilesEnvVar   string = "DATAROBOT_STAGE_UPLOAD_MAX_FILES"
+	StageUploadMaxBytesEnvVar   string = "DATAROBOT_STAGE_UPLOAD_MAX_BYTES"
+	ZipUploadTimeoutEnvVar      string = "DATAROBOT_ZIP_UPLOAD_TIMEOUT"
 	UserAgent                   string = "DataRobotTerraformClient"
 
 	PromptRuntimeParameterName string = "PROMPT_COLUMN_NAME"
@@ -19,59 +26,73 @@ const (
 
 // NotebookResourceModel describes the notebook resource.
 type NotebookResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	FilePath  types.String `tfsdk:"file_path"`
-	FileHash  types.String `tfsdk:"file_hash"`
-	UseCaseID types.String `tfsdk:"use_case_id"`
-	URL       types.String `tfsdk:"url"`
+	ID        types.String   `tfsdk:"id"`
+	Name      types.String   `tfsdk:"name"`
+	FilePath  types.String   `tfsdk:"file_path"`
+	FileHash  types.String   `tfsdk:"file_hash"`
+	UseCaseID types.String   `tfsdk:"use_case_id"`
+	URL       types.String   `tfsdk:"url"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // UseCaseResourceModel describes the resource data model.
 type UseCaseResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // MemorySpaceResourceModel describes the memory space resource.
 type MemorySpaceResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Description        types.String `tfsdk:"description"`
-	LLMModelName       types.String `tfsdk:"llm_model_name"`
-	LLMBaseURL         types.String `tfsdk:"llm_base_url"`
-	CustomInstructions types.String `tfsdk:"custom_instructions"`
+	ID                 types.String   `tfsdk:"id"`
+	Description        types.String   `tfsdk:"description"`
+	LLMModelName       types.String   `tfsdk:"llm_model_name"`
+	LLMBaseURL         types.String   `tfsdk:"llm_base_url"`
+	CustomInstructions types.String   `tfsdk:"custom_instructions"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 // RemoteRepositoryResourceModel describes the remote repository resource.
 type RemoteRepositoryResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Name                types.String `tfsdk:"name"`
-	Description         types.String `tfsdk:"description"`
-	Location            types.String `tfsdk:"location"`
-	SourceType          types.String `tfsdk:"source_type"`
-	PersonalAccessToken types.String `tfsdk:"personal_access_token"`
+	ID                           types.String `tfsdk:"id"`
+	Name                         types.String `tfsdk:"name"`
+	Description                  types.String `tfsdk:"description"`
+	Location                     types.String `tfsdk:"location"`
+	SourceType                   types.String `tfsdk:"source_type"`
+	PersonalAccessToken          types.String `tfsdk:"personal_access_token"`
+	PersonalAccessTokenWO        types.String `tfsdk:"personal_access_token_wo"`
+	PersonalAccessTokenWOVersion types.Int64  `tfsdk:"personal_access_token_wo_version"`
 
 	// optional fields for S3 remote repositories
-	AWSAccessKeyID     types.String `tfsdk:"aws_access_key_id"`
-	AWSSecretAccessKey types.String `tfsdk:"aws_secret_access_key"`
-	AWSSessionToken    types.String `tfsdk:"aws_session_token"`
+	AWSAccessKeyID              types.String   `tfsdk:"aws_access_key_id"`
+	AWSSecretAccessKey          types.String   `tfsdk:"aws_secret_access_key"`
+	AWSSecretAccessKeyWO        types.String   `tfsdk:"aws_secret_access_key_wo"`
+	AWSSecretAccessKeyWOVersion types.Int64    `tfsdk:"aws_secret_access_key_wo_version"`
+	AWSSessionToken             types.String   `tfsdk:"aws_session_token"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // DatasetFromFileResourceModel describes the datasource uploaded from a file.
 type DatasetFromFileResourceModel struct {
-	ID         types.String   `tfsdk:"id"`
-	FilePath   types.String   `tfsdk:"file_path"`
-	FileHash   types.String   `tfsdk:"file_hash"`
-	Name       types.String   `tfsdk:"name"`
-	UseCaseIDs []types.String `tfsdk:"use_case_ids"`
+	ID                 types.String   `tfsdk:"id"`
+	FilePath           types.String   `tfsdk:"file_path"`
+	FileHash           types.String   `tfsdk:"file_hash"`
+	Name               types.String   `tfsdk:"name"`
+	UseCaseIDs         []types.String `tfsdk:"use_case_ids"`
+	UseCaseIDsAll      types.List     `tfsdk:"use_case_ids_all"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 type DatasetFromURLResourceModel struct {
-	ID         types.String   `tfsdk:"id"`
-	URL        types.String   `tfsdk:"url"`
-	Name       types.String   `tfsdk:"name"`
-	UseCaseIDs []types.String `tfsdk:"use_case_ids"`
+	ID                 types.String   `tfsdk:"id"`
+	URL                types.String   `tfsdk:"url"`
+	Name               types.String   `tfsdk:"name"`
+	UseCaseIDs         []types.String `tfsdk:"use_case_ids"`
+	UseCaseIDsAll      types.List     `tfsdk:"use_case_ids_all"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 type DatasetFromDatasourceResourceModel struct {
@@ -84,17 +105,21 @@ type DatasetFromDatasourceResourceModel struct {
 	SampleSizeRows            types.Int64    `tfsdk:"sample_size_rows"`
 	Categories                []types.String `tfsdk:"categories"`
 	UseCaseIDs                []types.String `tfsdk:"use_case_ids"`
+	UseCaseIDsAll             types.List     `tfsdk:"use_case_ids_all"`
+	DeletionProtection        types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // DatastoreResourceModel describes the datastore resource.
 type DatastoreResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	DataStoreType types.String `tfsdk:"data_store_type"`
-	CanonicalName types.String `tfsdk:"canonical_name"`
-	DriverID      types.String `tfsdk:"driver_id"`
-	JDBCUrl       types.String `tfsdk:"jdbc_url"`
-	Fields        []types.Map  `tfsdk:"fields"`
-	ConnectorID   types.String `tfsdk:"connector_id"`
+	ID            types.String   `tfsdk:"id"`
+	DataStoreType types.String   `tfsdk:"data_store_type"`
+	CanonicalName types.String   `tfsdk:"canonical_name"`
+	DriverID      types.String   `tfsdk:"driver_id"`
+	JDBCUrl       types.String   `tfsdk:"jdbc_url"`
+	Fields        []types.Map    `tfsdk:"fields"`
+	ConnectorID   types.String   `tfsdk:"connector_id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // DatasourceResourceModel describes the datasource resource.
@@ -103,6 +128,7 @@ type DatasourceResourceModel struct {
 	DataSourceType types.String          `tfsdk:"data_source_type"`
 	CanonicalName  types.String          `tfsdk:"canonical_name"`
 	Params         DatasourceParamsModel `tfsdk:"params"`
+	Timeouts       timeouts.Value        `tfsdk:"timeouts"`
 }
 
 type DatasourceParamsModel struct {
@@ -124,6 +150,8 @@ type VectorDatabaseResourceModel struct {
 	UseCaseID          types.String             `tfsdk:"use_case_id"`
 	DatasetID          types.String             `tfsdk:"dataset_id"`
 	ChunkingParameters *ChunkingParametersModel `tfsdk:"chunking_parameters"`
+	DeletionProtection types.Bool               `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value           `tfsdk:"timeouts"`
 }
 
 // ChunkingParametersModel represents the chunking parameters nested attribute.
@@ -140,24 +168,26 @@ type ChunkingParametersModel struct {
 
 // CustomModelFromVectorDatabaseResourceModel describes a custom model packaged from a vector database.
 type CustomModelFromVectorDatabaseResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	VersionID           types.String `tfsdk:"version_id"`
-	VectorDatabaseID    types.String `tfsdk:"vector_database_id"`
-	Name                types.String `tfsdk:"name"`
-	Description         types.String `tfsdk:"description"`
-	ResourceBundleID    types.String `tfsdk:"resource_bundle_id"`
-	Replicas            types.Int64  `tfsdk:"replicas"`
-	NetworkEgressPolicy types.String `tfsdk:"network_egress_policy"`
-	MemoryMB            types.Int64  `tfsdk:"memory_mb"`
+	ID                  types.String   `tfsdk:"id"`
+	VersionID           types.String   `tfsdk:"version_id"`
+	VectorDatabaseID    types.String   `tfsdk:"vector_database_id"`
+	Name                types.String   `tfsdk:"name"`
+	Description         types.String   `tfsdk:"description"`
+	ResourceBundleID    types.String   `tfsdk:"resource_bundle_id"`
+	Replicas            types.Int64    `tfsdk:"replicas"`
+	NetworkEgressPolicy types.String   `tfsdk:"network_egress_policy"`
+	MemoryMB            types.Int64    `tfsdk:"memory_mb"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 // PlaygroundResourceModel describes the playground associated to a use case.
 type PlaygroundResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	UseCaseID      types.String `tfsdk:"use_case_id"`
-	PlaygroundType types.String `tfsdk:"playground_type"`
+	ID             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Description    types.String   `tfsdk:"description"`
+	UseCaseID      types.String   `tfsdk:"use_case_id"`
+	PlaygroundType types.String   `tfsdk:"playground_type"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 // LLMBlueprintResourceModel describes the LLM blueprint resource.
@@ -172,6 +202,7 @@ type LLMBlueprintResourceModel struct {
 	LLMSettings            *LLMSettings            `tfsdk:"llm_settings"`
 	PromptType             types.String            `tfsdk:"prompt_type"`
 	CustomModelLLMSettings *CustomModelLLMSettings `tfsdk:"custom_model_llm_settings"`
+	Timeouts               timeouts.Value          `tfsdk:"timeouts"`
 }
 
 type VectorDatabaseSettings struct {
@@ -208,6 +239,8 @@ type CustomModelResourceModel struct {
 	FolderPathHash                 types.String                    `tfsdk:"folder_path_hash"`
 	Files                          types.Dynamic                   `tfsdk:"files"`
 	FilesHashes                    types.List                      `tfsdk:"files_hashes"`
+	FileManifest                   types.Map                       `tfsdk:"file_manifest"`
+	PendingChanges                 types.Object                    `tfsdk:"pending_changes"`
 	TargetName                     types.String                    `tfsdk:"target_name"`
 	TargetType                     types.String                    `tfsdk:"target_type"`
 	PositiveClassLabel             types.String                    `tfsdk:"positive_class_label"`
@@ -229,7 +262,10 @@ type CustomModelResourceModel struct {
 	NetworkAccess                  types.String                    `tfsdk:"network_access"`
 	ResourceBundleID               types.String                    `tfsdk:"resource_bundle_id"`
 	UseCaseIDs                     []types.String                  `tfsdk:"use_case_ids"`
+	UseCaseIDsAll                  types.List                      `tfsdk:"use_case_ids_all"`
 	Tags                           types.Set                       `tfsdk:"tags"`
+	TagsAll                        types.Set                       `tfsdk:"tags_all"`
+	Timeouts                       timeouts.Value                  `tfsdk:"timeouts"`
 }
 
 type FileTuple struct {
@@ -303,54 +339,61 @@ type GuardCostInfo struct {
 
 // CustomModelLLMValidationResourceModel describes the custom model LLM validation resource.
 type CustomModelLLMValidationResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	DeploymentID      types.String `tfsdk:"deployment_id"`
-	ModelID           types.String `tfsdk:"model_id"`
-	Name              types.String `tfsdk:"name"`
-	PredictionTimeout types.Int64  `tfsdk:"prediction_timeout"`
-	PromptColumnName  types.String `tfsdk:"prompt_column_name"`
-	TargetColumnName  types.String `tfsdk:"target_column_name"`
-	ChatModelID       types.String `tfsdk:"chat_model_id"`
-	UseCaseID         types.String `tfsdk:"use_case_id"`
+	ID                types.String   `tfsdk:"id"`
+	DeploymentID      types.String   `tfsdk:"deployment_id"`
+	ModelID           types.String   `tfsdk:"model_id"`
+	Name              types.String   `tfsdk:"name"`
+	PredictionTimeout types.Int64    `tfsdk:"prediction_timeout"`
+	PromptColumnName  types.String   `tfsdk:"prompt_column_name"`
+	TargetColumnName  types.String   `tfsdk:"target_column_name"`
+	ChatModelID       types.String   `tfsdk:"chat_model_id"`
+	UseCaseID         types.String   `tfsdk:"use_case_id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 // CustomJobResourceModel describes the custom job resource.
 type CustomJobResourceModel struct {
-	ID                     types.String  `tfsdk:"id"`
-	Name                   types.String  `tfsdk:"name"`
-	Description            types.String  `tfsdk:"description"`
-	JobType                types.String  `tfsdk:"job_type"`
-	EnvironmentID          types.String  `tfsdk:"environment_id"`
-	EnvironmentVersionID   types.String  `tfsdk:"environment_version_id"`
-	RuntimeParameterValues types.List    `tfsdk:"runtime_parameter_values"`
-	FolderPath             types.String  `tfsdk:"folder_path"`
-	FolderPathHash         types.String  `tfsdk:"folder_path_hash"`
-	Files                  types.Dynamic `tfsdk:"files"`
-	FilesHashes            types.List    `tfsdk:"files_hashes"`
-	EgressNetworkPolicy    types.String  `tfsdk:"egress_network_policy"`
-	ResourceBundleID       types.String  `tfsdk:"resource_bundle_id"`
-	Schedule               *Schedule     `tfsdk:"schedule"`
-	ScheduleID             types.String  `tfsdk:"schedule_id"`
+	ID                     types.String   `tfsdk:"id"`
+	Name                   types.String   `tfsdk:"name"`
+	Description            types.String   `tfsdk:"description"`
+	JobType                types.String   `tfsdk:"job_type"`
+	EnvironmentID          types.String   `tfsdk:"environment_id"`
+	EnvironmentVersionID   types.String   `tfsdk:"environment_version_id"`
+	RuntimeParameterValues types.List     `tfsdk:"runtime_parameter_values"`
+	FolderPath             types.String   `tfsdk:"folder_path"`
+	FolderPathHash         types.String   `tfsdk:"folder_path_hash"`
+	Files                  types.Dynamic  `tfsdk:"files"`
+	FilesHashes            types.List     `tfsdk:"files_hashes"`
+	FileManifest           types.Map      `tfsdk:"file_manifest"`
+	PendingChanges         types.Object   `tfsdk:"pending_changes"`
+	EgressNetworkPolicy    types.String   `tfsdk:"egress_network_policy"`
+	ResourceBundleID       types.String   `tfsdk:"resource_bundle_id"`
+	Schedule               *Schedule      `tfsdk:"schedule"`
+	ScheduleID             types.String   `tfsdk:"schedule_id"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 type CustomMetricJobResourceModel struct {
-	ID                     types.String  `tfsdk:"id"`
-	Name                   types.String  `tfsdk:"name"`
-	Description            types.String  `tfsdk:"description"`
-	EnvironmentID          types.String  `tfsdk:"environment_id"`
-	EnvironmentVersionID   types.String  `tfsdk:"environment_version_id"`
-	RuntimeParameterValues types.List    `tfsdk:"runtime_parameter_values"`
-	FolderPath             types.String  `tfsdk:"folder_path"`
-	FolderPathHash         types.String  `tfsdk:"folder_path_hash"`
-	Files                  types.Dynamic `tfsdk:"files"`
-	FilesHashes            types.List    `tfsdk:"files_hashes"`
-	EgressNetworkPolicy    types.String  `tfsdk:"egress_network_policy"`
-	ResourceBundleID       types.String  `tfsdk:"resource_bundle_id"`
-	Directionality         types.String  `tfsdk:"directionality"`
-	Units                  types.String  `tfsdk:"units"`
-	Type                   types.String  `tfsdk:"type"`
-	TimeStep               types.String  `tfsdk:"time_step"`
-	IsModelSpecific        types.Bool    `tfsdk:"is_model_specific"`
+	ID                     types.String   `tfsdk:"id"`
+	Name                   types.String   `tfsdk:"name"`
+	Description            types.String   `tfsdk:"description"`
+	EnvironmentID          types.String   `tfsdk:"environment_id"`
+	EnvironmentVersionID   types.String   `tfsdk:"environment_version_id"`
+	RuntimeParameterValues types.List     `tfsdk:"runtime_parameter_values"`
+	FolderPath             types.String   `tfsdk:"folder_path"`
+	FolderPathHash         types.String   `tfsdk:"folder_path_hash"`
+	Files                  types.Dynamic  `tfsdk:"files"`
+	FilesHashes            types.List     `tfsdk:"files_hashes"`
+	FileManifest           types.Map      `tfsdk:"file_manifest"`
+	PendingChanges         types.Object   `tfsdk:"pending_changes"`
+	EgressNetworkPolicy    types.String   `tfsdk:"egress_network_policy"`
+	ResourceBundleID       types.String   `tfsdk:"resource_bundle_id"`
+	Directionality         types.String   `tfsdk:"directionality"`
+	Units                  types.String   `tfsdk:"units"`
+	Type                   types.String   `tfsdk:"type"`
+	TimeStep               types.String   `tfsdk:"time_step"`
+	IsModelSpecific        types.Bool     `tfsdk:"is_model_specific"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 type CustomMetricFromJobResourceModel struct {
@@ -366,6 +409,7 @@ type CustomMetricFromJobResourceModel struct {
 	SampleCount        *ColumnNameValue         `tfsdk:"sample_count"`
 	Schedule           *Schedule                `tfsdk:"schedule"`
 	ParameterOverrides types.List               `tfsdk:"parameter_overrides"`
+	Timeouts           timeouts.Value           `tfsdk:"timeouts"`
 }
 
 type CustomMetricResourceModel struct {
@@ -383,6 +427,7 @@ type CustomMetricResourceModel struct {
 	Value           *ColumnNameValue         `tfsdk:"value"`
 	SampleCount     *ColumnNameValue         `tfsdk:"sample_count"`
 	Batch           *ColumnNameValue         `tfsdk:"batch"`
+	Timeouts        timeouts.Value           `tfsdk:"timeouts"`
 }
 
 type MetricTimestampSpoofing struct {
@@ -403,7 +448,11 @@ type RegisteredModelResourceModel struct {
 	Description          types.String   `tfsdk:"description"`
 	CustomModelVersionId types.String   `tfsdk:"custom_model_version_id"`
 	UseCaseIDs           []types.String `tfsdk:"use_case_ids"`
+	UseCaseIDsAll        types.List     `tfsdk:"use_case_ids_all"`
 	Tags                 types.Set      `tfsdk:"tags"`
+	TagsAll              types.Set      `tfsdk:"tags_all"`
+	DeletionProtection   types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 type RegisteredModelFromLeaderboardResourceModel struct {
@@ -417,6 +466,8 @@ type RegisteredModelFromLeaderboardResourceModel struct {
 	ComputeAllTsIntervals         types.Bool     `tfsdk:"compute_all_ts_intervals"`
 	DistributionPredictionModelID types.String   `tfsdk:"distribution_prediction_model_id"`
 	UseCaseIDs                    []types.String `tfsdk:"use_case_ids"`
+	UseCaseIDsAll                 types.List     `tfsdk:"use_case_ids_all"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
 }
 
 type Tag struct {
@@ -443,6 +494,54 @@ type PredictionEnvironmentResourceModel struct {
 	ManagedBy              types.String   `tfsdk:"managed_by"`
 	CredentialID           types.String   `tfsdk:"credential_id"`
 	DatastoreID            types.String   `tfsdk:"datastore_id"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+// DeploymentDataSourceModel describes the deployment data source.
+type DeploymentDataSourceModel struct {
+	ID                            types.String `tfsdk:"id"`
+	Label                         types.String `tfsdk:"label"`
+	Status                        types.String `tfsdk:"status"`
+	Importance                    types.String `tfsdk:"importance"`
+	PredictionEnvironmentID       types.String `tfsdk:"prediction_environment_id"`
+	PredictionEnvironmentName     types.String `tfsdk:"prediction_environment_name"`
+	PredictionEnvironmentPlatform types.String `tfsdk:"prediction_environment_platform"`
+	RegisteredModelID             types.String `tfsdk:"registered_model_id"`
+	RegisteredModelVersionID      types.String `tfsdk:"registered_model_version_id"`
+	RegisteredModelVersionName    types.String `tfsdk:"registered_model_version_name"`
+	PredictionURL                 types.String `tfsdk:"prediction_url"`
+	Tags                          []Tag        `tfsdk:"tags"`
+
+	// settings
+	ChallengerModelsSettings          *BasicDeploymentSetting      `tfsdk:"challenger_models_settings"`
+	SegmentAnalysisSettings           *SegmentAnalysisSettings     `tfsdk:"segment_analysis_settings"`
+	BatchMonitoringSettings           *BasicDeploymentSetting      `tfsdk:"batch_monitoring_settings"`
+	DriftTrackingSettings             *DriftTrackingSettings       `tfsdk:"drift_tracking_settings"`
+	AssociationIDSettings             *AssociationIDSettings       `tfsdk:"association_id_settings"`
+	PredictionsDataCollectionSettings *BasicDeploymentSetting      `tfsdk:"predictions_data_collection_settings"`
+	PredictionIntervalsSettings       *PredictionIntervalsSettings `tfsdk:"prediction_intervals_settings"`
+}
+
+// DeploymentsDataSourceModel describes the deployment list data source.
+type DeploymentsDataSourceModel struct {
+	Importance              types.String             `tfsdk:"importance"`
+	Tags                    types.Map                `tfsdk:"tags"`
+	PredictionEnvironmentID types.String             `tfsdk:"prediction_environment_id"`
+	UseCaseID               types.String             `tfsdk:"use_case_id"`
+	Deployments             []DeploymentSummaryModel `tfsdk:"deployments"`
+}
+
+// DeploymentSummaryModel describes a deployment listed by the deployment list data source.
+type DeploymentSummaryModel struct {
+	ID                       types.String `tfsdk:"id"`
+	Label                    types.String `tfsdk:"label"`
+	Status                   types.String `tfsdk:"status"`
+	Importance               types.String `tfsdk:"importance"`
+	PredictionEnvironmentID  types.String `tfsdk:"prediction_environment_id"`
+	RegisteredModelID        types.String `tfsdk:"registered_model_id"`
+	RegisteredModelVersionID types.String `tfsdk:"registered_model_version_id"`
+	PredictionURL            types.String `tfsdk:"prediction_url"`
+	Tags                     []Tag        `tfsdk:"tags"`
 }
 
 // DeploymentResourceModel describes the deployment resource.
@@ -450,10 +549,14 @@ type DeploymentResourceModel struct {
 	ID                       types.String   `tfsdk:"id"`
 	Label                    types.String   `tfsdk:"label"`
 	RegisteredModelVersionID types.String   `tfsdk:"registered_model_version_id"`
+	ReplacementValidation    types.String   `tfsdk:"replacement_validation"`
+	PromoteChallengerID      types.String   `tfsdk:"promote_challenger_id"`
+	PromotedModelVersionID   types.String   `tfsdk:"promoted_registered_model_version_id"`
 	PredictionEnvironmentID  types.String   `tfsdk:"prediction_environment_id"`
 	Importance               types.String   `tfsdk:"importance"`
 	RuntimeParameterValues   types.List     `tfsdk:"runtime_parameter_values"`
 	UseCaseIDs               []types.String `tfsdk:"use_case_ids"`
+	UseCaseIDsAll            types.List     `tfsdk:"use_case_ids_all"`
 
 	// settings
 	PredictionsByForecastDateSettings *PredictionsByForecastDateSettings `tfsdk:"predictions_by_forecast_date_settings"`
@@ -471,6 +574,8 @@ type DeploymentResourceModel struct {
 	PredictionsSettings               *PredictionsSettings               `tfsdk:"predictions_settings"`
 	FeatureCacheSettings              *FeatureCacheSettings              `tfsdk:"feature_cache_settings"`
 	RetrainingSettings                *RetrainingSettings                `tfsdk:"retraining_settings"`
+	DeletionProtection                types.Bool                         `tfsdk:"deletion_protection"`
+	Timeouts                          timeouts.Value                     `tfsdk:"timeouts"`
 }
 
 type BasicDeploymentSetting struct {
@@ -605,6 +710,41 @@ type RetrainingSettings struct {
 	PredictionEnvironmentID types.String `tfsdk:"prediction_environment_id"`
 }
 
+// DeploymentChallengerResourceModel describes the deployment challenger resource.
+type DeploymentChallengerResourceModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	DeploymentID             types.String   `tfsdk:"deployment_id"`
+	RegisteredModelVersionID types.String   `tfsdk:"registered_model_version_id"`
+	PredictionEnvironmentID  types.String   `tfsdk:"prediction_environment_id"`
+	Name                     types.String   `tfsdk:"name"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+}
+
+// DeploymentChallengersDataSourceModel describes the deployment challengers data source.
+type DeploymentChallengersDataSourceModel struct {
+	DeploymentID types.String                       `tfsdk:"deployment_id"`
+	Start        types.String                       `tfsdk:"start"`
+	End          types.String                       `tfsdk:"end"`
+	Champion     *DeploymentModelMetricsModel       `tfsdk:"champion"`
+	Challengers  []DeploymentChallengerMetricsModel `tfsdk:"challengers"`
+}
+
+// DeploymentModelMetricsModel describes the accuracy metrics of the champion model of a deployment.
+type DeploymentModelMetricsModel struct {
+	RegisteredModelVersionID types.String `tfsdk:"registered_model_version_id"`
+	Metrics                  types.Map    `tfsdk:"metrics"`
+}
+
+// DeploymentChallengerMetricsModel describes a challenger listed by the deployment challengers data source.
+type DeploymentChallengerMetricsModel struct {
+	ID                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	RegisteredModelVersionID types.String `tfsdk:"registered_model_version_id"`
+	PredictionEnvironmentID  types.String `tfsdk:"prediction_environment_id"`
+	Metrics                  types.Map    `tfsdk:"metrics"`
+	MetricsPercentChange     types.Map    `tfsdk:"metrics_percent_change"`
+}
+
 // DeploymentRetrainingPolicyResourceModel describes the deployment retraining policy resource.
 type DeploymentRetrainingPolicyResourceModel struct {
 	ID                     types.String       `tfsdk:"id"`
@@ -620,6 +760,7 @@ type DeploymentRetrainingPolicyResourceModel struct {
 	TimeSeriesOptions      *TimeSeriesOptions `tfsdk:"time_series_options"`
 	Trigger                *Trigger           `tfsdk:"trigger"`
 	UseCaseID              types.String       `tfsdk:"use_case_id"`
+	Timeouts               timeouts.Value     `tfsdk:"timeouts"`
 }
 
 type AutopilotOptions struct {
@@ -663,33 +804,37 @@ type Trigger struct {
 }
 
 type NotificationPolicyResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	ChannelID         types.String `tfsdk:"channel_id"`
-	ChannelScope      types.String `tfsdk:"channel_scope"`
-	RelatedEntityID   types.String `tfsdk:"related_entity_id"`
-	RelatedEntityType types.String `tfsdk:"related_entity_type"`
-	Active            types.Bool   `tfsdk:"active"`
-	EventGroup        types.String `tfsdk:"event_group"`
-	EventType         types.String `tfsdk:"event_type"`
-	MaximalFrequency  types.String `tfsdk:"maximal_frequency"`
-}
-
-type NotificationChannelResourceModel struct {
 	ID                types.String   `tfsdk:"id"`
 	Name              types.String   `tfsdk:"name"`
-	ChannelType       types.String   `tfsdk:"channel_type"`
-	ContentType       types.String   `tfsdk:"content_type"`
-	CustomHeaders     []CustomHeader `tfsdk:"custom_headers"`
-	DREntities        []DREntity     `tfsdk:"dr_entities"`
-	EmailAddress      types.String   `tfsdk:"email_address"`
-	LanguageCode      types.String   `tfsdk:"language_code"`
-	PayloadUrl        types.String   `tfsdk:"payload_url"`
+	ChannelID         types.String   `tfsdk:"channel_id"`
+	ChannelScope      types.String   `tfsdk:"channel_scope"`
 	RelatedEntityID   types.String   `tfsdk:"related_entity_id"`
 	RelatedEntityType types.String   `tfsdk:"related_entity_type"`
-	SecretToken       types.String   `tfsdk:"secret_token"`
-	ValidateSsl       types.Bool     `tfsdk:"validate_ssl"`
-	VerificationCode  types.String   `tfsdk:"verification_code"`
+	Active            types.Bool     `tfsdk:"active"`
+	EventGroup        types.String   `tfsdk:"event_group"`
+	EventType         types.String   `tfsdk:"event_type"`
+	MaximalFrequency  types.String   `tfsdk:"maximal_frequency"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+type NotificationChannelResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	Name                 types.String   `tfsdk:"name"`
+	ChannelType          types.String   `tfsdk:"channel_type"`
+	ContentType          types.String   `tfsdk:"content_type"`
+	CustomHeaders        []CustomHeader `tfsdk:"custom_headers"`
+	DREntities           []DREntity     `tfsdk:"dr_entities"`
+	EmailAddress         types.String   `tfsdk:"email_address"`
+	LanguageCode         types.String   `tfsdk:"language_code"`
+	PayloadUrl           types.String   `tfsdk:"payload_url"`
+	RelatedEntityID      types.String   `tfsdk:"related_entity_id"`
+	RelatedEntityType    types.String   `tfsdk:"related_entity_type"`
+	SecretToken          types.String   `tfsdk:"secret_token"`
+	SecretTokenWO        types.String   `tfsdk:"secret_token_wo"`
+	SecretTokenWOVersion types.Int64    `tfsdk:"secret_token_wo_version"`
+	ValidateSsl          types.Bool     `tfsdk:"validate_ssl"`
+	VerificationCode     types.String   `tfsdk:"verification_code"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 type CustomHeader struct {
@@ -705,15 +850,16 @@ type DREntity struct {
 // QAApplicationResourceModel describes the Q&A application resource.
 
 type QAApplicationResourceModel struct {
-	ID                       types.String `tfsdk:"id"`
-	SourceID                 types.String `tfsdk:"source_id"`
-	SourceVersionID          types.String `tfsdk:"source_version_id"`
-	Name                     types.String `tfsdk:"name"`
-	DeploymentID             types.String `tfsdk:"deployment_id"`
-	ApplicationUrl           types.String `tfsdk:"application_url"`
-	ExternalAccessEnabled    types.Bool   `tfsdk:"external_access_enabled"`
-	ExternalAccessRecipients types.List   `tfsdk:"external_access_recipients"`
-	AllowAutoStopping        types.Bool   `tfsdk:"allow_auto_stopping"`
+	ID                       types.String   `tfsdk:"id"`
+	SourceID                 types.String   `tfsdk:"source_id"`
+	SourceVersionID          types.String   `tfsdk:"source_version_id"`
+	Name                     types.String   `tfsdk:"name"`
+	DeploymentID             types.String   `tfsdk:"deployment_id"`
+	ApplicationUrl           types.String   `tfsdk:"application_url"`
+	ExternalAccessEnabled    types.Bool     `tfsdk:"external_access_enabled"`
+	ExternalAccessRecipients types.List     `tfsdk:"external_access_recipients"`
+	AllowAutoStopping        types.Bool     `tfsdk:"allow_auto_stopping"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ApplicationSourceResourceModel struct {
@@ -726,9 +872,12 @@ type ApplicationSourceResourceModel struct {
 	FolderPathHash           types.String          `tfsdk:"folder_path_hash"`
 	Files                    types.Dynamic         `tfsdk:"files"`
 	FilesHashes              types.List            `tfsdk:"files_hashes"`
+	FileManifest             types.Map             `tfsdk:"file_manifest"`
+	PendingChanges           types.Object          `tfsdk:"pending_changes"`
 	Resources                basetypes.ObjectValue `tfsdk:"resources"`
 	RuntimeParameterValues   types.List            `tfsdk:"runtime_parameter_values"`
 	RequiredKeyScopeLevel    types.String          `tfsdk:"required_key_scope_level"`
+	Timeouts                 timeouts.Value        `tfsdk:"timeouts"`
 }
 
 type ApplicationSourceFromTemplateResourceModel struct {
@@ -742,8 +891,11 @@ type ApplicationSourceFromTemplateResourceModel struct {
 	FolderPathHash           types.String          `tfsdk:"folder_path_hash"`
 	Files                    types.Dynamic         `tfsdk:"files"`
 	FilesHashes              types.List            `tfsdk:"files_hashes"`
+	FileManifest             types.Map             `tfsdk:"file_manifest"`
+	PendingChanges           types.Object          `tfsdk:"pending_changes"`
 	Resources                basetypes.ObjectValue `tfsdk:"resources"`
 	RuntimeParameterValues   types.List            `tfsdk:"runtime_parameter_values"`
+	Timeouts                 timeouts.Value        `tfsdk:"timeouts"`
 }
 
 type CustomApplicationResourceModel struct {
@@ -757,7 +909,9 @@ type CustomApplicationResourceModel struct {
 	AllowAutoStopping        types.Bool            `tfsdk:"allow_auto_stopping"`
 	Resources                basetypes.ObjectValue `tfsdk:"resources"`
 	UseCaseIDs               []types.String        `tfsdk:"use_case_ids"`
+	UseCaseIDsAll            types.List            `tfsdk:"use_case_ids_all"`
 	RequiredKeyScopeLevel    types.String          `tfsdk:"required_key_scope_level"`
+	Timeouts                 timeouts.Value        `tfsdk:"timeouts"`
 }
 
 type CustomApplicationFromEnvironmentResourceModel struct {
@@ -771,48 +925,94 @@ type CustomApplicationFromEnvironmentResourceModel struct {
 	AllowAutoStopping        types.Bool            `tfsdk:"allow_auto_stopping"`
 	Resources                basetypes.ObjectValue `tfsdk:"resources"`
 	UseCaseIDs               []types.String        `tfsdk:"use_case_ids"`
+	UseCaseIDsAll            types.List            `tfsdk:"use_case_ids_all"`
 	RequiredKeyScopeLevel    types.String          `tfsdk:"required_key_scope_level"`
+	Timeouts                 timeouts.Value        `tfsdk:"timeouts"`
 }
 
 // CredentialResourceModel describes the credential resource.
 type ApiTokenCredentialResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	ApiToken    types.String `tfsdk:"api_token"`
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	ApiToken          types.String   `tfsdk:"api_token"`
+	ApiTokenWO        types.String   `tfsdk:"api_token_wo"`
+	ApiTokenWOVersion types.Int64    `tfsdk:"api_token_wo_version"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 type BasicCredentialResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	User        types.String `tfsdk:"user"`
-	Password    types.String `tfsdk:"password"`
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	User              types.String   `tfsdk:"user"`
+	Password          types.String   `tfsdk:"password"`
+	PasswordWO        types.String   `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64    `tfsdk:"password_wo_version"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 type GoogleCloudCredentialResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	GCPKey         types.String `tfsdk:"gcp_key"`
-	GCPKeyFile     types.String `tfsdk:"gcp_key_file"`
-	GCPKeyFileHash types.String `tfsdk:"gcp_key_file_hash"`
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	GCPKey          types.String   `tfsdk:"gcp_key"`
+	GCPKeyWO        types.String   `tfsdk:"gcp_key_wo"`
+	GCPKeyWOVersion types.Int64    `tfsdk:"gcp_key_wo_version"`
+	GCPKeyFile      types.String   `tfsdk:"gcp_key_file"`
+	GCPKeyFileHash  types.String   `tfsdk:"gcp_key_file_hash"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AwsCredentialResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Description        types.String `tfsdk:"description"`
-	AWSAccessKeyID     types.String `tfsdk:"aws_access_key_id"`
-	AWSSecretAccessKey types.String `tfsdk:"aws_secret_access_key"`
-	AWSSessionToken    types.String `tfsdk:"aws_session_token"`
-	ConfigID           types.String `tfsdk:"config_id"`
+	ID                          types.String   `tfsdk:"id"`
+	Name                        types.String   `tfsdk:"name"`
+	Description                 types.String   `tfsdk:"description"`
+	AWSAccessKeyID              types.String   `tfsdk:"aws_access_key_id"`
+	AWSSecretAccessKey          types.String   `tfsdk:"aws_secret_access_key"`
+	AWSSecretAccessKeyWO        types.String   `tfsdk:"aws_secret_access_key_wo"`
+	AWSSecretAccessKeyWOVersion types.Int64    `tfsdk:"aws_secret_access_key_wo_version"`
+	AWSSessionToken             types.String   `tfsdk:"aws_session_token"`
+	AWSSessionTokenWO           types.String   `tfsdk:"aws_session_token_wo"`
+	AWSSessionTokenWOVersion    types.Int64    `tfsdk:"aws_session_token_wo_version"`
+	ConfigID                    types.String   `tfsdk:"config_id"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AzureCredentialResourceModel struct {
+	ID                             types.String   `tfsdk:"id"`
+	Name                           types.String   `tfsdk:"name"`
+	Description                    types.String   `tfsdk:"description"`
+	AzureConnectionString          types.String   `tfsdk:"azure_connection_string"`
+	AzureConnectionStringWO        types.String   `tfsdk:"azure_connection_string_wo"`
+	AzureConnectionStringWOVersion types.Int64    `tfsdk:"azure_connection_string_wo_version"`
+	Timeouts                       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ApiTokenEphemeralResourceModel describes the API token ephemeral resource.
+type ApiTokenEphemeralResourceModel struct {
+	Name          types.String `tfsdk:"name"`
+	ExpiresIn     types.String `tfsdk:"expires_in"`
+	Scope         types.String `tfsdk:"scope"`
+	RevokeOnClose types.Bool   `tfsdk:"revoke_on_close"`
+	ID            types.String `tfsdk:"id"`
+	Token         types.String `tfsdk:"token"`
+	ExpireAt      types.String `tfsdk:"expire_at"`
+}
+
+// CredentialSecretEphemeralResourceModel describes the credential secret ephemeral resource.
+type CredentialSecretEphemeralResourceModel struct {
 	ID                    types.String `tfsdk:"id"`
 	Name                  types.String `tfsdk:"name"`
-	Description           types.String `tfsdk:"description"`
+	CredentialType        types.String `tfsdk:"credential_type"`
+	User                  types.String `tfsdk:"user"`
+	Password              types.String `tfsdk:"password"`
+	Token                 types.String `tfsdk:"token"`
+	ApiToken              types.String `tfsdk:"api_token"`
+	AWSAccessKeyID        types.String `tfsdk:"aws_access_key_id"`
+	AWSSecretAccessKey    types.String `tfsdk:"aws_secret_access_key"`
+	AWSSessionToken       types.String `tfsdk:"aws_session_token"`
 	AzureConnectionString types.String `tfsdk:"azure_connection_string"`
+	GCPKey                types.String `tfsdk:"gcp_key"`
 }
 
 // ExecutionEnvironmentDataSourceModel describes the execution environment data source resource.
@@ -839,6 +1039,7 @@ type ExecutionEnvironmentResourceModel struct {
 	DockerImageHash     types.String   `tfsdk:"docker_image_hash"`
 	DockerImageUri      types.String   `tfsdk:"docker_image_uri"`
 	BuildStatus         types.String   `tfsdk:"build_status"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 // BatchPredictionJobModel describes the batch prediction job resource.
@@ -869,6 +1070,7 @@ type BatchPredictionJobDefinitionResourceModel struct {
 	ThresholdHigh               types.Float64       `tfsdk:"threshold_high"`
 	ThresholdLow                types.Float64       `tfsdk:"threshold_low"`
 	TimeseriesSettings          *TimeseriesSettings `tfsdk:"timeseries_settings"`
+	Timeouts                    timeouts.Value      `tfsdk:"timeouts"`
 }
 
 type Schedule struct {
@@ -952,48 +1154,62 @@ type CustomjobScheduleListModel struct {
 }
 
 type AppOAuthResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	OrgID          types.String `tfsdk:"org_id"`
-	Type           types.String `tfsdk:"type"`
-	ClientID       types.String `tfsdk:"client_id"`
-	ClientSecret   types.String `tfsdk:"client_secret"`
-	SecureConfigID types.String `tfsdk:"secure_config_id"`
-	Status         types.String `tfsdk:"status"`
+	ID                    types.String   `tfsdk:"id"`
+	Name                  types.String   `tfsdk:"name"`
+	OrgID                 types.String   `tfsdk:"org_id"`
+	Type                  types.String   `tfsdk:"type"`
+	ClientID              types.String   `tfsdk:"client_id"`
+	ClientSecret          types.String   `tfsdk:"client_secret"`
+	ClientSecretWO        types.String   `tfsdk:"client_secret_wo"`
+	ClientSecretWOVersion types.Int64    `tfsdk:"client_secret_wo_version"`
+	SecureConfigID        types.String   `tfsdk:"secure_config_id"`
+	Status                types.String   `tfsdk:"status"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// AppOAuthTokenEphemeralResourceModel describes the OAuth access token ephemeral resource.
+type AppOAuthTokenEphemeralResourceModel struct {
+	ProviderID  types.String `tfsdk:"provider_id"`
+	AccessToken types.String `tfsdk:"access_token"`
+	TokenType   types.String `tfsdk:"token_type"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
 }
 
 // UserMCPToolMetadataResourceModel describes the user MCP tool metadata resource.
 type UserMCPToolMetadataResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Type               types.String `tfsdk:"type"`
-	CreatedAt          types.String `tfsdk:"created_at"`
-	UserId             types.String `tfsdk:"user_id"`
-	UserName           types.String `tfsdk:"user_name"`
-	MCPServerVersionID types.String `tfsdk:"mcp_server_version_id"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Type               types.String   `tfsdk:"type"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	UserId             types.String   `tfsdk:"user_id"`
+	UserName           types.String   `tfsdk:"user_name"`
+	MCPServerVersionID types.String   `tfsdk:"mcp_server_version_id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 // UserMCPPromptMetadataResourceModel describes the user MCP prompt metadata resource.
 type UserMCPPromptMetadataResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Type               types.String `tfsdk:"type"`
-	CreatedAt          types.String `tfsdk:"created_at"`
-	UserId             types.String `tfsdk:"user_id"`
-	UserName           types.String `tfsdk:"user_name"`
-	MCPServerVersionID types.String `tfsdk:"mcp_server_version_id"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Type               types.String   `tfsdk:"type"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	UserId             types.String   `tfsdk:"user_id"`
+	UserName           types.String   `tfsdk:"user_name"`
+	MCPServerVersionID types.String   `tfsdk:"mcp_server_version_id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 // UserMCPResourceMetadataResourceModel describes the user MCP prompt metadata resource.
 type UserMCPResourceMetadataResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Type               types.String `tfsdk:"type"`
-	Uri                types.String `tfsdk:"uri"`
-	CreatedAt          types.String `tfsdk:"created_at"`
-	UserId             types.String `tfsdk:"user_id"`
-	UserName           types.String `tfsdk:"user_name"`
-	MCPServerVersionID types.String `tfsdk:"mcp_server_version_id"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Type               types.String   `tfsdk:"type"`
+	Uri                types.String   `tfsdk:"uri"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	UserId             types.String   `tfsdk:"user_id"`
+	UserName           types.String   `tfsdk:"user_name"`
+	MCPServerVersionID types.String   `tfsdk:"mcp_server_version_id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ArtifactResourceModel describes the Workload API artifact resource.
@@ -1007,13 +1223,21 @@ type ArtifactResourceModel struct {
 	ArtifactRepositoryID types.String         `tfsdk:"artifact_repository_id"`
 	Source               *ArtifactSourceModel `tfsdk:"source"`
 	Spec                 *ArtifactSpecModel   `tfsdk:"spec"`
+	Timeouts             timeouts.Value       `tfsdk:"timeouts"`
 }
 
 // ArtifactSourceModel describes a local source tree uploaded to Files API.
 type ArtifactSourceModel struct {
-	Dir          types.String `tfsdk:"dir"`
-	DirHash      types.String `tfsdk:"dir_hash"`
-	WaitForBuild types.Bool   `tfsdk:"wait_for_build"`
+	Dir                  types.String   `tfsdk:"dir"`
+	DirHash              types.String   `tfsdk:"dir_hash"`
+	FileManifest         types.Map      `tfsdk:"file_manifest"`
+	PendingChanges       types.Object   `tfsdk:"pending_changes"`
+	IgnorePatterns       []types.String `tfsdk:"ignore_patterns"`
+	IgnoreFile           types.String   `tfsdk:"ignore_file"`
+	DetectRemoteDrift    types.Bool     `tfsdk:"detect_remote_drift"`
+	WaitForBuild         types.Bool     `tfsdk:"wait_for_build"`
+	RetainVersions       types.Int64    `tfsdk:"retain_versions"`
+	RetainVersionsDryRun types.Bool     `tfsdk:"retain_versions_dry_run"`
 }
 
 type ArtifactSpecModel struct {
@@ -1189,15 +1413,17 @@ type ArtifactSeccompProfileModel struct {
 
 // WorkloadResourceModel describes the Workload API workload resource.
 type WorkloadResourceModel struct {
-	ID          types.String         `tfsdk:"id"`
-	Name        types.String         `tfsdk:"name"`
-	Description types.String         `tfsdk:"description"`
-	Importance  types.String         `tfsdk:"importance"`
-	Type        types.String         `tfsdk:"type"`
-	ArtifactID  types.String         `tfsdk:"artifact_id"`
-	Endpoint    types.String         `tfsdk:"endpoint"`
-	Status      types.String         `tfsdk:"status"`
-	Runtime     WorkloadRuntimeModel `tfsdk:"runtime"`
+	ID                 types.String         `tfsdk:"id"`
+	Name               types.String         `tfsdk:"name"`
+	Description        types.String         `tfsdk:"description"`
+	Importance         types.String         `tfsdk:"importance"`
+	Type               types.String         `tfsdk:"type"`
+	ArtifactID         types.String         `tfsdk:"artifact_id"`
+	Endpoint           types.String         `tfsdk:"endpoint"`
+	Status             types.String         `tfsdk:"status"`
+	Runtime            WorkloadRuntimeModel `tfsdk:"runtime"`
+	DeletionProtection types.Bool           `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value       `tfsdk:"timeouts"`
 }
 
 type WorkloadRuntimeModel struct {
@@ -1206,8 +1432,43 @@ type WorkloadRuntimeModel struct {
 }
 
 type WorkloadReplacementPolicyModel struct {
-	WarmupMinutes         types.Int64 `tfsdk:"warmup_minutes"`
-	KeepOldVersionMinutes types.Int64 `tfsdk:"keep_old_version_minutes"`
+	WarmupMinutes         types.Int64                    `tfsdk:"warmup_minutes"`
+	KeepOldVersionMinutes types.Int64                    `tfsdk:"keep_old_version_minutes"`
+	Steps                 []WorkloadReplacementStepModel `tfsdk:"steps"`
+	HealthGate            *WorkloadHealthGateModel       `tfsdk:"health_gate"`
+	RollbackOnFailure     types.Bool                     `tfsdk:"rollback_on_failure"`
+}
+
+type WorkloadReplacementStepModel struct {
+	TrafficPercent types.Int64 `tfsdk:"traffic_percent"`
+	PauseMinutes   types.Int64 `tfsdk:"pause_minutes"`
+}
+
+type WorkloadHealthGateModel struct {
+	MaxUnhealthyMinutes types.Int64 `tfsdk:"max_unhealthy_minutes"`
+}
+
+// WorkloadLogsDataSourceModel describes the workload logs data source.
+type WorkloadLogsDataSourceModel struct {
+	WorkloadID types.String            `tfsdk:"workload_id"`
+	Container  types.String            `tfsdk:"container"`
+	TailLines  types.Int64             `tfsdk:"tail_lines"`
+	Logs       []WorkloadLogEntryModel `tfsdk:"logs"`
+	Events     []WorkloadEventModel    `tfsdk:"events"`
+}
+
+type WorkloadLogEntryModel struct {
+	Timestamp types.String `tfsdk:"timestamp"`
+	Container types.String `tfsdk:"container"`
+	Level     types.String `tfsdk:"level"`
+	Message   types.String `tfsdk:"message"`
+}
+
+type WorkloadEventModel struct {
+	Timestamp types.String `tfsdk:"timestamp"`
+	Type      types.String `tfsdk:"type"`
+	Reason    types.String `tfsdk:"reason"`
+	Message   types.String `tfsdk:"message"`
 }
 
 type WorkloadGroupRuntimeModel struct {
@@ -1251,6 +1512,7 @@ type QuotaResourceModel struct {
 	ResourceType types.String     `tfsdk:"resource_type"`
 	ResourceID   types.String     `tfsdk:"resource_id"`
 	DefaultRules []QuotaRuleModel `tfsdk:"default_rules"`
+	Timeouts     timeouts.Value   `tfsdk:"timeouts"`
 }
 
 type QuotaRuleModel struct {
@@ -1258,3 +1520,47 @@ type QuotaRuleModel struct {
 	Limit  types.Int64  `tfsdk:"limit"`
 	Window types.String `tfsdk:"window"`
 }
+
+// FilesCatalogResourceModel describes a Files API catalog synced from a local directory.
+type FilesCatalogResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	CatalogVersionID  types.String   `tfsdk:"catalog_version_id"`
+	Dir               types.String   `tfsdk:"dir"`
+	DirHash           types.String   `tfsdk:"dir_hash"`
+	FileManifest      types.Map      `tfsdk:"file_manifest"`
+	PendingChanges    types.Object   `tfsdk:"pending_changes"`
+	IgnorePatterns    []types.String `tfsdk:"ignore_patterns"`
+	IgnoreFile        types.String   `tfsdk:"ignore_file"`
+	DetectRemoteDrift types.Bool     `tfsdk:"detect_remote_drift"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+type FilesCatalogDataSourceModel struct {
+	ID               types.String               `tfsdk:"id"`
+	CatalogVersionID types.String               `tfsdk:"catalog_version_id"`
+	Limit            types.Int64                `tfsdk:"limit"`
+	Versions         []FilesCatalogVersionModel `tfsdk:"versions"`
+	Files            []FilesCatalogFileModel    `tfsdk:"files"`
+}
+
+type FilesCatalogVersionModel struct {
+	ID        types.String `tfsdk:"id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	NumFiles  types.Int64  `tfsdk:"num_files"`
+	Size      types.Int64  `tfsdk:"size"`
+}
+
+type FilesCatalogFileModel struct {
+	Path types.String `tfsdk:"path"`
+	Hash types.String `tfsdk:"hash"`
+	Size types.Int64  `tfsdk:"size"`
+}
+
+type FilesCatalogDownloadDataSourceModel struct {
+	CatalogID        types.String `tfsdk:"catalog_id"`
+	CatalogVersionID types.String `tfsdk:"catalog_version_id"`
+	Dir              types.String `tfsdk:"dir"`
+	FileManifest     types.Map    `tfsdk:"file_manifest"`
+	FileCount        types.Int64  `tfsdk:"file_count"`
+	Size             types.Int64  `tfsdk:"size"`
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NextVersionLabelFunction{}
+
+func NewNextVersionLabelFunction() function.Function {
+	return &NextVersionLabelFunction{}
+}
+
+// NextVersionLabelFunction computes the version label that follows a given one.
+type NextVersionLabelFunction struct{}
+
+func (f *NextVersionLabelFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "next_version_label"
+}
+
+func (f *NextVersionLabelFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Computes the next version label",
+		MarkdownDescription: "Computes the version label that follows `latest`, the same way the provider labels new application source versions: " +
+			"`v10` becomes `v11`, `5` becomes `v6`, and an empty or non-numeric label becomes `v1`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "latest",
+				MarkdownDescription: "The current version label.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NextVersionLabelFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var latest string
+
+	resp.Error = req.Arguments.Get(ctx, &latest)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, nextLabelFromLatest(latest))
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNextVersionLabelFunction(t *testing.T) {
+	t.Parallel()
+
+	for latest, expected := range map[string]string{
+		"v10": "v11",
+		"5":   "v6",
+		"abc": "v1",
+		"":    "v1",
+	} {
+		result, funcErr := runFunction(t, NewNextVersionLabelFunction(), types.StringValue(latest))
+		if funcErr != nil {
+			t.Fatalf("%q: unexpected error: %s", latest, funcErr)
+		}
+		if !result.Equal(types.StringValue(expected)) {
+			t.Errorf("%q: expected %q, got %s", latest, expected, result)
+		}
+	}
+}
@@ -81,6 +81,9 @@ and update _only_ when that changes. If the remote Notebook changes, the provide
 				MarkdownDescription: "The Use Case ID to add the Notebook to.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -108,6 +111,8 @@ func (r *NotebookResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	// Get use case ID if specified
 	var useCaseID string
 	if !plan.UseCaseID.IsNull() {
@@ -145,6 +150,8 @@ func (r *NotebookResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx = withReadTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("GetNotebook")
 	notebook, err := r.provider.service.GetNotebook(ctx, state.ID.ValueString())
 	if err != nil {
@@ -213,6 +220,8 @@ func (r *NotebookResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -254,7 +263,7 @@ func (r *NotebookResource) Update(ctx context.Context, req resource.UpdateReques
 		traceAPICall("UpdateNotebook")
 		notebookResponse, err := r.provider.service.UpdateNotebook(ctx, state.ID.ValueString(), plan.UseCaseID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating notebook", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating notebook", err)
 			return
 		}
 		// Update the state with the plan
@@ -262,6 +271,8 @@ func (r *NotebookResource) Update(ctx context.Context, req resource.UpdateReques
 		state.URL = types.StringValue(notebookResponse.URL)
 	}
 
+	state.Timeouts = plan.Timeouts
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -274,6 +285,8 @@ func (r *NotebookResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, state.Timeouts, &resp.Diagnostics)
+
 	// Delete the notebook
 	traceAPICall("DeleteNotebook")
 	err := r.provider.service.DeleteNotebook(ctx, state.ID.ValueString())
@@ -6,18 +6,22 @@ import (
 	"fmt"
 
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NotificationChannelResource{}
 var _ resource.ResourceWithImportState = &NotificationChannelResource{}
+var _ resource.ResourceWithConfigValidators = &NotificationChannelResource{}
 
 func NewNotificationChannelResource() resource.Resource {
 	return &NotificationChannelResource{}
@@ -119,6 +123,8 @@ func (r *NotificationChannelResource) Schema(ctx context.Context, req resource.S
 				Optional:            true,
 				MarkdownDescription: "The secret token to be used for the Notification Channel.",
 			},
+			"secret_token_wo":         writeOnlyAttribute("secret_token"),
+			"secret_token_wo_version": writeOnlyVersionAttribute("secret_token", false),
 			"validate_ssl": schema.BoolAttribute{
 				Optional:            true,
 				MarkdownDescription: "Defines if validate ssl or not in the Notification Channel.",
@@ -128,6 +134,9 @@ func (r *NotificationChannelResource) Schema(ctx context.Context, req resource.S
 				MarkdownDescription: "Required if the channel type is email.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -154,6 +163,8 @@ func (r *NotificationChannelResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	request := &client.CreateNotificationChannelRequest{
 		Name:              data.Name.ValueString(),
 		ChannelType:       data.ChannelType.ValueString(),
@@ -161,7 +172,7 @@ func (r *NotificationChannelResource) Create(ctx context.Context, req resource.C
 		RelatedEntityID:   data.RelatedEntityID.ValueString(),
 		RelatedEntityType: data.RelatedEntityType.ValueString(),
 		PayloadUrl:        StringValuePointerOptional(data.PayloadUrl),
-		SecretToken:       StringValuePointerOptional(data.SecretToken),
+		SecretToken:       r.secretToken(ctx, req.Config, data, &resp.Diagnostics),
 		ValidateSsl:       BoolValuePointerOptional(data.ValidateSsl),
 		VerificationCode:  StringValuePointerOptional(data.VerificationCode),
 	}
@@ -191,7 +202,7 @@ func (r *NotificationChannelResource) Create(ctx context.Context, req resource.C
 	traceAPICall("CreateNotificationChannel")
 	createResp, err := r.provider.service.CreateNotificationChannel(ctx, request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Notification Channel", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Notification Channel", err)
 		return
 	}
 	data.ID = types.StringValue(createResp.ID)
@@ -207,6 +218,8 @@ func (r *NotificationChannelResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -238,7 +251,10 @@ func (r *NotificationChannelResource) Read(ctx context.Context, req resource.Rea
 	data.LanguageCode = types.StringPointerValue(notificationChannel.LanguageCode)
 	data.EmailAddress = types.StringPointerValue(notificationChannel.EmailAddress)
 	data.PayloadUrl = types.StringPointerValue(notificationChannel.PayloadUrl)
-	data.SecretToken = types.StringPointerValue(notificationChannel.SecretToken)
+	// the secret token is not tracked in state when its write-only variant is used
+	if data.SecretTokenWOVersion.IsNull() {
+		data.SecretToken = types.StringPointerValue(notificationChannel.SecretToken)
+	}
 	data.ValidateSsl = types.BoolPointerValue(notificationChannel.ValidateSsl)
 	if notificationChannel.CustomHeaders != nil {
 		data.CustomHeaders = make([]CustomHeader, len(*notificationChannel.CustomHeaders))
@@ -270,12 +286,14 @@ func (r *NotificationChannelResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	request := &client.UpdateNotificationChannelRequest{
 		Name:             StringValuePointerOptional(data.Name),
 		ChannelType:      StringValuePointerOptional(data.ChannelType),
 		ContentType:      StringValuePointerOptional(data.ContentType),
 		PayloadUrl:       StringValuePointerOptional(data.PayloadUrl),
-		SecretToken:      StringValuePointerOptional(data.SecretToken),
+		SecretToken:      r.secretToken(ctx, req.Config, data, &resp.Diagnostics),
 		ValidateSsl:      BoolValuePointerOptional(data.ValidateSsl),
 		VerificationCode: StringValuePointerOptional(data.VerificationCode),
 	}
@@ -316,7 +334,7 @@ func (r *NotificationChannelResource) Update(ctx context.Context, req resource.U
 				fmt.Sprintf("Notification Channel with ID %s is not found. Removing from state.", data.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error updating Notification Channel", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Notification Channel", err)
 		}
 		return
 	}
@@ -332,6 +350,8 @@ func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteNotificationChannel")
 	err := r.provider.service.DeleteNotificationChannel(
 		ctx,
@@ -349,3 +369,24 @@ func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.D
 func (r *NotificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r NotificationChannelResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("secret_token"),
+			path.MatchRoot("secret_token_wo"),
+		),
+	}
+}
+
+func (r *NotificationChannelResource) secretToken(
+	ctx context.Context,
+	config tfsdk.Config,
+	data NotificationChannelResourceModel,
+	diags *diag.Diagnostics,
+) *string {
+	if IsKnown(data.SecretToken) {
+		return data.SecretToken.ValueStringPointer()
+	}
+	return StringValuePointerOptional(writeOnlyValue(ctx, config, "secret_token_wo", diags))
+}
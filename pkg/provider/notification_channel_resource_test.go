@@ -8,7 +8,11 @@ import (
 	"testing"
 
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	mock_client "github.com/datarobot-community/terraform-provider-datarobot/mock"
+	"github.com/golang/mock/gomock"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -127,6 +131,76 @@ func TestNotificationChannelResourceSchema(t *testing.T) {
 	}
 }
 
+func TestNotificationChannelResourceReadSecretToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		secretToken    types.String
+		writeOnlyVer   types.Int64
+		wantSecretNull bool
+	}{
+		{name: "imported", secretToken: types.StringNull(), writeOnlyVer: types.Int64Null()},
+		{name: "changed outside Terraform", secretToken: types.StringValue("old-token"), writeOnlyVer: types.Int64Null()},
+		{name: "write-only", secretToken: types.StringNull(), writeOnlyVer: types.Int64Value(1), wantSecretNull: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			secretToken := "new-token"
+			ctrl := gomock.NewController(t)
+			mockService := mock_client.NewMockService(ctrl)
+			mockService.EXPECT().GetNotificationChannel(gomock.Any(), "deployment", "deployment-1", "channel-1").Return(&client.NotificationChannel{
+				ID:                "channel-1",
+				Name:              "webhook",
+				ChannelType:       "Webhook",
+				RelatedEntityID:   "deployment-1",
+				RelatedEntityType: "deployment",
+				SecretToken:       &secretToken,
+			}, nil)
+
+			r := &NotificationChannelResource{provider: &Provider{service: mockService}}
+			schemaResponse := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+			data := NotificationChannelResourceModel{
+				ID:                   types.StringValue("channel-1"),
+				Name:                 types.StringValue("webhook"),
+				ChannelType:          types.StringValue("Webhook"),
+				RelatedEntityID:      types.StringValue("deployment-1"),
+				RelatedEntityType:    types.StringValue("deployment"),
+				SecretToken:          tt.secretToken,
+				SecretTokenWO:        types.StringNull(),
+				SecretTokenWOVersion: tt.writeOnlyVer,
+			}
+			fillTestTimeouts(&data.Timeouts)
+			state := tfsdk.State{Schema: schemaResponse.Schema}
+			if diags := state.Set(ctx, &data); diags.HasError() {
+				t.Fatalf("State.Set() diagnostics = %v", diags)
+			}
+
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() diagnostics = %v", resp.Diagnostics)
+			}
+
+			var got NotificationChannelResourceModel
+			if diags := resp.State.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("State.Get() diagnostics = %v", diags)
+			}
+			if tt.wantSecretNull {
+				if !got.SecretToken.IsNull() {
+					t.Fatalf("secret_token = %s, want null", got.SecretToken)
+				}
+			} else if got.SecretToken.ValueString() != "new-token" {
+				t.Fatalf("secret_token = %s, want the token of the API", got.SecretToken)
+			}
+		})
+	}
+}
+
 func notificationChannelResourceConfig(
 	name,
 	channelType,
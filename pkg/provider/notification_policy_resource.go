@@ -96,6 +96,9 @@ func (r *NotificationPolicyResource) Schema(ctx context.Context, req resource.Sc
 				MarkdownDescription: "The maximal frequency between policy runs in ISO 8601 duration string.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -122,6 +125,8 @@ func (r *NotificationPolicyResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateNotificationPolicy")
 	createResp, err := r.provider.service.CreateNotificationPolicy(ctx, &client.CreateNotificationPolicyRequest{
 		Name:              data.Name.ValueString(),
@@ -135,7 +140,7 @@ func (r *NotificationPolicyResource) Create(ctx context.Context, req resource.Cr
 		MaximalFrequency:  StringValuePointerOptional(data.MaximalFrequency),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Notification Policy", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Notification Policy", err)
 		return
 	}
 	data.ID = types.StringValue(createResp.ID)
@@ -151,6 +156,8 @@ func (r *NotificationPolicyResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -199,6 +206,8 @@ func (r *NotificationPolicyResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("UpdateNotificationPolicy")
 	_, err := r.provider.service.UpdateNotificationPolicy(
 		ctx,
@@ -221,7 +230,7 @@ func (r *NotificationPolicyResource) Update(ctx context.Context, req resource.Up
 				fmt.Sprintf("Notification Policy with ID %s is not found. Removing from state.", data.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error updating Notification Policy", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Notification Policy", err)
 		}
 		return
 	}
@@ -237,6 +246,8 @@ func (r *NotificationPolicyResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteNotificationPolicy")
 	err := r.provider.service.DeleteNotificationPolicy(
 		ctx,
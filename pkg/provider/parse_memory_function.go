@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseMemoryFunction{}
+
+func NewParseMemoryFunction() function.Function {
+	return &ParseMemoryFunction{}
+}
+
+// ParseMemoryFunction converts a human-readable memory string to bytes.
+type ParseMemoryFunction struct{}
+
+func (f *ParseMemoryFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_memory"
+}
+
+func (f *ParseMemoryFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a memory string to bytes",
+		MarkdownDescription: "Converts a human-readable memory string (e.g. `4GB`, `512MB`, `4096Mi`) or a raw integer string to a number of bytes. " +
+			"SI units (`KB`, `MB`, `GB`, `TB`) are 1000-based and IEC units (`Ki`/`KiB`, `Mi`/`MiB`, `Gi`/`GiB`, `Ti`/`TiB`) are 1024-based, " +
+			"the same as the `memory` and `gpu_memory` resource allocations of `datarobot_workload` containers.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "The memory string to convert.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *ParseMemoryFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	bytes, err := parseMemoryBytes(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, bytes)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction calls a provider function with the given arguments and returns its result.
+func runFunction(t *testing.T, f function.Function, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	ctx := context.Background()
+	definitionResponse := &function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, definitionResponse)
+	if definitionResponse.Diagnostics.HasError() {
+		t.Fatalf("Definition diagnostics: %+v", definitionResponse.Diagnostics)
+	}
+
+	resultData, funcErr := definitionResponse.Definition.Return.NewResultData(ctx)
+	if funcErr != nil {
+		t.Fatalf("NewResultData error: %s", funcErr)
+	}
+
+	runResponse := &function.RunResponse{Result: resultData}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, runResponse)
+	return runResponse.Result.Value(), runResponse.Error
+}
+
+func TestParseMemoryFunction(t *testing.T) {
+	t.Parallel()
+
+	result, funcErr := runFunction(t, NewParseMemoryFunction(), types.StringValue("4Gi"))
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+	if !result.Equal(types.Int64Value(4 << 30)) {
+		t.Errorf("expected %d, got %s", int64(4<<30), result)
+	}
+
+	if _, funcErr = runFunction(t, NewParseMemoryFunction(), types.StringValue("4 parsecs")); funcErr == nil {
+		t.Error("expected an error for an unknown unit")
+	}
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var pendingChangesAttrTypes = map[string]attr.Type{
+	"added":    types.ListType{ElemType: types.StringType},
+	"modified": types.ListType{ElemType: types.StringType},
+	"deleted":  types.ListType{ElemType: types.StringType},
+}
+
+// pendingChangesAttribute is the computed preview of the files an apply pushes.
+// It is set in ModifyPlan and cleared on refresh, so it only shows in plans.
+func pendingChangesAttribute(filesDescription string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed: true,
+		MarkdownDescription: "The changes of " + filesDescription + " that the planned apply uploads, relative to `file_manifest`. " +
+			"Set during plan and cleared on refresh; null when the files are unknown at plan time.",
+		Attributes: map[string]schema.Attribute{
+			"added": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Paths of the files that are uploaded for the first time.",
+			},
+			"modified": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Paths of the files whose contents changed.",
+			},
+			"deleted": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Paths of the files that are removed.",
+			},
+		},
+	}
+}
+
+// fileChanges are the files an apply pushes relative to the manifest of the last apply.
+type fileChanges struct {
+	Added    []string
+	Modified []string
+	Deleted  []string
+	// UploadBytes is the total size of the added and modified files.
+	UploadBytes int64
+}
+
+func diffFileChanges(base, local artifactsource.Manifest) fileChanges {
+	plan := artifactsource.DiffPushOnly(base, local)
+
+	changes := fileChanges{
+		Added:    make([]string, 0),
+		Modified: make([]string, 0),
+		Deleted:  plan.Deletes,
+	}
+	if changes.Deleted == nil {
+		changes.Deleted = make([]string, 0)
+	}
+	for _, path := range plan.Uploads {
+		if _, ok := base[path]; ok {
+			changes.Modified = append(changes.Modified, path)
+		} else {
+			changes.Added = append(changes.Added, path)
+		}
+		changes.UploadBytes += local[path].Size
+	}
+	return changes
+}
+
+func (c fileChanges) IsEmpty() bool {
+	return len(c.Added) == 0 && len(c.Modified) == 0 && len(c.Deleted) == 0
+}
+
+func (c fileChanges) objectValue(ctx context.Context) (types.Object, error) {
+	value, diags := types.ObjectValueFrom(ctx, pendingChangesAttrTypes, struct {
+		Added    []string `tfsdk:"added"`
+		Modified []string `tfsdk:"modified"`
+		Deleted  []string `tfsdk:"deleted"`
+	}{c.Added, c.Modified, c.Deleted})
+	if diags.HasError() {
+		return types.ObjectNull(pendingChangesAttrTypes), errors.New(diags.Errors()[0].Detail())
+	}
+	return value, nil
+}
+
+// addPendingChangesWarning summarizes the changes in a plan warning, so that reviewers
+// see which files the apply pushes without reading the pending_changes lists.
+func addPendingChangesWarning(diags *diag.Diagnostics, subject string, changes fileChanges) {
+	if changes.IsEmpty() {
+		return
+	}
+	diags.AddWarning(
+		fmt.Sprintf("Pending file changes for %s", subject),
+		fmt.Sprintf("Apply uploads %d added and %d modified file(s), %s in total, and deletes %d file(s). "+
+			"See pending_changes in the plan for the paths.",
+			len(changes.Added), len(changes.Modified), formatByteSize(changes.UploadBytes), len(changes.Deleted)),
+	)
+}
+
+// formatByteSize formats a size in bytes with a 1024-based unit.
+func formatByteSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+// previewLocalFileChanges is planLocalFilesPendingChanges for ModifyPlan: the changes are
+// summarized in a plan warning and an error is added to diags.
+func previewLocalFileChanges(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	cache *artifactsource.HashCache,
+	folderPath types.String,
+	files types.Dynamic,
+	priorManifest types.Map,
+) types.Object {
+	value, changes, err := planLocalFilesPendingChanges(ctx, cache, folderPath, files, priorManifest)
+	if err != nil {
+		diags.AddError("Error previewing file changes", err.Error())
+		return value
+	}
+	addPendingChangesWarning(diags, "folder_path and files", changes)
+	return value
+}
+
+// planLocalFilesPendingChanges previews the changes of folder_path and files relative
+// to priorManifest. It is null while the local files are unknown.
+func planLocalFilesPendingChanges(
+	ctx context.Context,
+	cache *artifactsource.HashCache,
+	folderPath types.String,
+	files types.Dynamic,
+	priorManifest types.Map,
+) (types.Object, fileChanges, error) {
+	if folderPath.IsUnknown() || files.IsUnknown() ||
+		(files.UnderlyingValue() != nil && files.UnderlyingValue().IsUnknown()) {
+		return types.ObjectNull(pendingChangesAttrTypes), fileChanges{}, nil
+	}
+
+	sources, err := localFileSources(folderPath, files)
+	if err != nil {
+		return types.ObjectNull(pendingChangesAttrTypes), fileChanges{}, err
+	}
+	local, err := localFilesManifest(ctx, sources, cache)
+	if err != nil {
+		return types.ObjectNull(pendingChangesAttrTypes), fileChanges{}, err
+	}
+
+	changes := diffFileChanges(manifestFromMapValue(priorManifest), local)
+	value, err := changes.objectValue(ctx)
+	return value, changes, err
+}
+
+// manifestFromMapValue converts a file_manifest map of hashes to a manifest. A null or
+// unknown map is an empty manifest.
+func manifestFromMapValue(value types.Map) artifactsource.Manifest {
+	manifest := make(artifactsource.Manifest, len(value.Elements()))
+	for path, element := range value.Elements() {
+		if hash, ok := element.(types.String); ok {
+			manifest[path] = artifactsource.FileMeta{Hash: hash.ValueString()}
+		}
+	}
+	return manifest
+}
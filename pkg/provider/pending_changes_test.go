@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDiffFileChanges(t *testing.T) {
+	t.Parallel()
+
+	base := artifactsource.Manifest{
+		"keep.py":    {Hash: "same"},
+		"changed.py": {Hash: "old"},
+		"removed.py": {Hash: "gone"},
+	}
+	local := artifactsource.Manifest{
+		"keep.py":    {Hash: "same", Size: 100},
+		"changed.py": {Hash: "new", Size: 10},
+		"added.py":   {Hash: "add", Size: 5},
+	}
+
+	changes := diffFileChanges(base, local)
+	if !reflect.DeepEqual(changes.Added, []string{"added.py"}) {
+		t.Errorf("added = %v", changes.Added)
+	}
+	if !reflect.DeepEqual(changes.Modified, []string{"changed.py"}) {
+		t.Errorf("modified = %v", changes.Modified)
+	}
+	if !reflect.DeepEqual(changes.Deleted, []string{"removed.py"}) {
+		t.Errorf("deleted = %v", changes.Deleted)
+	}
+	if changes.UploadBytes != 15 {
+		t.Errorf("upload bytes = %d, want 15", changes.UploadBytes)
+	}
+
+	if !diffFileChanges(local, local).IsEmpty() {
+		t.Error("expected no changes for an unchanged manifest")
+	}
+}
+
+func TestFormatByteSize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[int64]string{
+		0:                      "0 B",
+		1023:                   "1023 B",
+		1024:                   "1.0 KiB",
+		1536:                   "1.5 KiB",
+		5 * 1024 * 1024:        "5.0 MiB",
+		3 * 1024 * 1024 * 1024: "3.0 GiB",
+	}
+	for size, want := range tests {
+		if got := formatByteSize(size); got != want {
+			t.Errorf("formatByteSize(%d) = %q, want %q", size, got, want)
+		}
+	}
+}
+
+func TestPreviewLocalFileChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("lists every file without a prior manifest", func(t *testing.T) {
+		dir := writeArtifactSourceTree(t, map[string]string{"main.py": "v1", "lib/util.py": "u1"})
+
+		var diags diag.Diagnostics
+		value := previewLocalFileChanges(ctx, &diags, nil, types.StringValue(dir), types.DynamicNull(), types.MapNull(types.StringType))
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		added := pendingChangesPaths(t, value, "added")
+		if !reflect.DeepEqual(added, []string{filepath.Join("lib", "util.py"), "main.py"}) {
+			t.Fatalf("added = %v", added)
+		}
+		if len(diags.Warnings()) != 1 || !strings.Contains(diags.Warnings()[0].Detail(), "2 added and 0 modified file(s), 4 B in total") {
+			t.Fatalf("warnings = %v", diags.Warnings())
+		}
+	})
+
+	t.Run("diffs against the prior manifest", func(t *testing.T) {
+		dir := writeArtifactSourceTree(t, map[string]string{"main.py": "v1", "old.py": "old"})
+		prior, err := localFilesManifestValue(ctx, nil, types.StringValue(dir), types.DynamicNull())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, "main.py"), []byte("v2"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Remove(filepath.Join(dir, "old.py")); err != nil {
+			t.Fatal(err)
+		}
+
+		var diags diag.Diagnostics
+		value := previewLocalFileChanges(ctx, &diags, nil, types.StringValue(dir), types.DynamicNull(), prior)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if got := pendingChangesPaths(t, value, "added"); len(got) != 0 {
+			t.Fatalf("added = %v, want none", got)
+		}
+		if got := pendingChangesPaths(t, value, "modified"); !reflect.DeepEqual(got, []string{"main.py"}) {
+			t.Fatalf("modified = %v", got)
+		}
+		if got := pendingChangesPaths(t, value, "deleted"); !reflect.DeepEqual(got, []string{"old.py"}) {
+			t.Fatalf("deleted = %v", got)
+		}
+	})
+
+	t.Run("unchanged files do not warn", func(t *testing.T) {
+		dir := writeArtifactSourceTree(t, map[string]string{"main.py": "v1"})
+		prior, err := localFilesManifestValue(ctx, nil, types.StringValue(dir), types.DynamicNull())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var diags diag.Diagnostics
+		value := previewLocalFileChanges(ctx, &diags, nil, types.StringValue(dir), types.DynamicNull(), prior)
+		if value.IsNull() || len(diags) != 0 {
+			t.Fatalf("value = %v, diags = %v; want empty lists without warnings", value, diags)
+		}
+	})
+
+	t.Run("unknown folder path is null", func(t *testing.T) {
+		var diags diag.Diagnostics
+		value := previewLocalFileChanges(ctx, &diags, nil, types.StringUnknown(), types.DynamicNull(), types.MapNull(types.StringType))
+		if !value.IsNull() || len(diags) != 0 {
+			t.Fatalf("value = %v, diags = %v; want null", value, diags)
+		}
+	})
+}
+
+func pendingChangesPaths(t *testing.T, value types.Object, name string) []string {
+	t.Helper()
+
+	list, ok := value.Attributes()[name].(types.List)
+	if !ok {
+		t.Fatalf("pending_changes.%s is not a list: %v", name, value)
+	}
+	paths := make([]string, 0, len(list.Elements()))
+	for _, element := range list.Elements() {
+		paths = append(paths, element.(types.String).ValueString())
+	}
+	return paths
+}
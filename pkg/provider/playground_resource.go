@@ -71,6 +71,9 @@ func (r *PlaygroundResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -97,6 +100,8 @@ func (r *PlaygroundResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	// Get the playground type, defaulting to "rag" if not set
 	playgroundType := "rag"
 	if !data.PlaygroundType.IsNull() && !data.PlaygroundType.IsUnknown() {
@@ -111,7 +116,7 @@ func (r *PlaygroundResource) Create(ctx context.Context, req resource.CreateRequ
 		PlaygroundType: playgroundType,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Playground", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Playground", err)
 		return
 	}
 	data.ID = types.StringValue(createResp.ID)
@@ -127,6 +132,8 @@ func (r *PlaygroundResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -168,6 +175,8 @@ func (r *PlaygroundResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("UpdatePlayground")
 	_, err := r.provider.service.UpdatePlayground(ctx,
 		data.ID.ValueString(),
@@ -182,7 +191,7 @@ func (r *PlaygroundResource) Update(ctx context.Context, req resource.UpdateRequ
 				fmt.Sprintf("Playground with ID %s is not found. Removing from state.", data.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error updating Playground", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Playground", err)
 		}
 		return
 	}
@@ -198,6 +207,8 @@ func (r *PlaygroundResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeletePlayground")
 	err := r.provider.service.DeletePlayground(ctx, data.ID.ValueString())
 	if err != nil {
@@ -116,6 +116,9 @@ func (r *PredictionEnvironmentResource) Schema(ctx context.Context, req resource
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -142,10 +145,12 @@ func (r *PredictionEnvironmentResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreatePredictionEnvironment")
 	createResp, err := r.provider.service.CreatePredictionEnvironment(ctx, buildPredictionEnvironmentRequest(data))
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Prediction Environment", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Prediction Environment", err)
 		return
 	}
 	data.ID = types.StringValue(createResp.ID)
@@ -161,6 +166,8 @@ func (r *PredictionEnvironmentResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -199,6 +206,8 @@ func (r *PredictionEnvironmentResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("UpdatePredictionEnvironment")
 	_, err := r.provider.service.UpdatePredictionEnvironment(ctx, data.ID.ValueString(), buildPredictionEnvironmentRequest(data))
 	if err != nil {
@@ -208,7 +217,7 @@ func (r *PredictionEnvironmentResource) Update(ctx context.Context, req resource
 				fmt.Sprintf("Prediction Environment with ID %s is not found. Removing from state.", data.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error updating Prediction Environment", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Prediction Environment", err)
 		}
 		return
 	}
@@ -224,18 +233,20 @@ func (r *PredictionEnvironmentResource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeletePredictionEnvironment")
 	// Retry with exponential backoff to handle Terraform's non-deterministic deletion order:
 	// the prediction environment may receive a 409 if deployments referencing it are still
 	// being torn down by concurrent resource deletions.
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 	deleteOperation := func() error {
 		err := r.provider.service.DeletePredictionEnvironment(ctx, data.ID.ValueString())
 		if err != nil {
 			if errors.Is(err, &client.NotFoundError{}) {
 				return nil // Already deleted - success
 			}
-			if strings.Contains(err.Error(), "409 Conflict") || strings.Contains(err.Error(), "existing deployments") {
+			if errors.Is(err, &client.ConflictError{}) || strings.Contains(err.Error(), "existing deployments") {
 				return err // Transient - retry until deployments are gone
 			}
 			return backoff.Permanent(err)
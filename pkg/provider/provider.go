@@ -3,22 +3,34 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"sync"
+	"time"
 
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure Provider satisfies various provider interfaces.
 var _ provider.Provider = &Provider{}
 var _ provider.ProviderWithFunctions = &Provider{}
+var _ provider.ProviderWithEphemeralResources = &Provider{}
 
 // NewService overrides the client method for testing.
 var NewService = client.NewService
@@ -35,6 +47,18 @@ type Provider struct {
 	// Key: custom model ID, Value: true if pending deletion
 	pendingCustomModelDeletions sync.Map
 
+	// defaultUseCaseIDs and defaultTags are applied to every resource that supports
+	// use_case_ids and tags, see provider_defaults.go.
+	defaultUseCaseIDs []string
+	defaultTags       []client.Tag
+
+	// hashCache reuses the hashes of unchanged local files across plans and applies,
+	// see hash_cache.go. It is nil when hash_cache is disabled.
+	hashCache *artifactsource.HashCache
+
+	// uploadLimits tune the Files API uploads of source directories, see upload_limits.go.
+	uploadLimits artifactsource.Limits
+
 	// configured is set to true at the end of the Configure method.
 	// This can be used in Resource and DataSource implementations to verify
 	// that the provider was previously configured.
@@ -47,9 +71,30 @@ type Provider struct {
 
 // ProviderModel describes the provider data model.
 type ProviderModel struct {
-	Endpoint     types.String `tfsdk:"endpoint"`
-	ApiKey       types.String `tfsdk:"apikey"`
-	TraceContext types.String `tfsdk:"tracecontext"`
+	Endpoint            types.String `tfsdk:"endpoint"`
+	ApiKey              types.String `tfsdk:"apikey"`
+	TraceContext        types.String `tfsdk:"tracecontext"`
+	ConfigPath          types.String `tfsdk:"config_path"`
+	Profile             types.String `tfsdk:"profile"`
+	MaxRetries          types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin        types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax        types.String `tfsdk:"retry_wait_max"`
+	RetryOnStatus       types.List   `tfsdk:"retry_on_status"`
+	CACertFile          types.String `tfsdk:"ca_cert_file"`
+	CACertPEM           types.String `tfsdk:"ca_cert_pem"`
+	ClientCert          types.String `tfsdk:"client_cert"`
+	ClientKey           types.String `tfsdk:"client_key"`
+	ProxyURL            types.String `tfsdk:"proxy_url"`
+	InsecureSkipVerify  types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeout      types.String `tfsdk:"request_timeout"`
+	DefaultUseCaseIDs   types.List   `tfsdk:"default_use_case_ids"`
+	DefaultTags         types.Set    `tfsdk:"default_tags"`
+	HashCache           types.Bool   `tfsdk:"hash_cache"`
+	HashCachePath       types.String `tfsdk:"hash_cache_path"`
+	UploadConcurrency   types.Int64  `tfsdk:"upload_concurrency"`
+	StageUploadMaxFiles types.Int64  `tfsdk:"stage_upload_max_files"`
+	StageUploadMaxBytes types.Int64  `tfsdk:"stage_upload_max_bytes"`
+	ZipUploadTimeout    types.String `tfsdk:"zip_upload_timeout"`
 }
 
 func (p *Provider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -75,6 +120,165 @@ func (p *Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"config_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the DataRobot SDK/CLI config file. Defaults to the `DATAROBOT_CONFIG_FILE` environment variable, " +
+					"then to `~/.config/datarobot/drconfig.yaml`, which is skipped if it does not exist. " +
+					"The `endpoint`, `token` and `trace_context` of the file are used for settings that are neither configured here nor set by their environment variable.",
+				Optional: true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Name of the profile to use from the `profiles` map of the config file, e.g. `staging`. " +
+					"Defaults to the `DATAROBOT_PROFILE` environment variable. If unset, the top-level settings of the config file are used. " +
+					"A profile does not inherit the top-level settings.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of times a failed DataRobot API request is retried. Defaults to `4`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.StringAttribute{
+				MarkdownDescription: "The minimum time to wait before retrying a failed request, as a Go duration string (e.g. `500ms`, `2s`). Defaults to `1s`.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationStringValidator{},
+				},
+			},
+			"retry_wait_max": schema.StringAttribute{
+				MarkdownDescription: "The maximum time to wait before retrying a failed request, as a Go duration string. The wait doubles on every retry up to this value, unless the response has a `Retry-After` header, which is always honored. Defaults to `30s`.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationStringValidator{},
+				},
+			},
+			"retry_on_status": schema.ListAttribute{
+				MarkdownDescription: "The HTTP response statuses that are retried. Defaults to `[429, 500, 502, 503, 504]`. " +
+					"GET requests are retried on every listed status. Other requests may already have changed data on the server, " +
+					"so they are only retried on a listed `429` or on a listed `503` with a `Retry-After` header, which DataRobot returns before processing a request.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a PEM encoded CA bundle to trust in addition to the system certificates, e.g. the internal CA of an on-premise installation.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA bundle to trust in addition to the system certificates. Conflicts with `ca_cert_file`.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS, e.g. `file(\"client.crt\")`. Requires `client_key`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of `client_cert`. Requires `client_cert`.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy for DataRobot API requests, e.g. `http://proxy.example.com:3128`. Defaults to the `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip the verification of the DataRobot API certificate. This makes requests vulnerable to interception, so prefer `ca_cert_file` or `ca_cert_pem`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"default_use_case_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the Use Cases to add every resource that supports `use_case_ids` to, in addition to the Use Cases of the resource. " +
+					"The merged list is shown in the computed `use_case_ids_all` attribute of the resource.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "The maximum time a DataRobot API request may take, including its retries, as a Go duration string (e.g. `2m`). " +
+					"Uploads and downloads through the Files API use the greater of this value and their default of `10m`. Defaults to no timeout.",
+				Optional: true,
+				Validators: []validator.String{
+					durationStringValidator{},
+				},
+			},
+			"hash_cache": schema.BoolAttribute{
+				MarkdownDescription: "Whether to cache the SHA-256 hashes of the local files of `folder_path`, `files` and `source.dir` between runs, " +
+					"so that plans of large directories only read the files that changed. A file is hashed again when its size, modification time or inode changes. " +
+					"The cache is stored in `hash_cache_path`. Defaults to `false`.",
+				Optional: true,
+			},
+			"hash_cache_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the file hash cache. Setting it enables `hash_cache`. " +
+					"Defaults to `" + hashCacheFileName + "` in the Terraform data directory (`.terraform`, or `TF_DATA_DIR` when set).",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"upload_concurrency": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of files uploaded to the Files API at a time from the `source.dir` of `datarobot_artifact` and the `dir` of `datarobot_files_catalog`, e.g. `32` on a fast network or `2` on a slow one. " +
+					"While the Files API answers uploads with `429`, the number is halved and the throttled files are uploaded again; it grows back as uploads succeed. " +
+					"Defaults to the `" + UploadConcurrencyEnvVar + "` environment variable, then to `4`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxUploadConcurrency),
+				},
+			},
+			"stage_upload_max_files": schema.Int64Attribute{
+				MarkdownDescription: "Uploads of `source.dir` and `dir` with at most this many files, and at most `stage_upload_max_bytes`, are sent file by file; larger uploads are sent as a single zip archive that the Files API extracts. " +
+					"Defaults to the `" + StageUploadMaxFilesEnvVar + "` environment variable, then to `20`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"stage_upload_max_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Uploads of `source.dir` and `dir` with at most this many bytes, and at most `stage_upload_max_files`, are sent file by file; larger uploads are sent as a single zip archive. " +
+					"Defaults to the `" + StageUploadMaxBytesEnvVar + "` environment variable, then to `52428800` (50 MiB).",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"zip_upload_timeout": schema.StringAttribute{
+				MarkdownDescription: "The maximum time to wait for the Files API to extract an uploaded zip archive, as a Go duration string (e.g. `30m`). " +
+					"Defaults to the `" + ZipUploadTimeoutEnvVar + "` environment variable, then to `10m`.",
+				Optional: true,
+				Validators: []validator.String{
+					durationStringValidator{},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SetNestedBlock{
+				MarkdownDescription: "Tags to assign to every resource that supports `tags`. A tag of the resource overrides the default tag with the same name. " +
+					"The merged set is shown in the computed `tags_all` attribute of the resource.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the tag.",
+						},
+						"value": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The value of the tag.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -88,34 +292,29 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		return
 	}
 
-	// Check if the endpoint and token are set in the environment or in the configuration
-	var endpoint string
-	if !IsKnown(data.Endpoint) {
-		endpoint = os.Getenv(DataRobotEndpointEnvVar)
-	} else {
-		endpoint = data.Endpoint.ValueString()
-	}
-
-	var apiKey string
-	if !IsKnown(data.ApiKey) {
-		apiKey = os.Getenv(DataRobotApiKeyEnvVar)
-	} else {
-		apiKey = data.ApiKey.ValueString()
+	// Settings are read from the configuration, then the environment, then the config file
+	configProfile, configFilePath, err := loadConfigProfile(data.ConfigPath.ValueString(), data.Profile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to load DataRobot config file", err.Error())
+		return
 	}
 
+	endpoint, endpointSource := resolveProviderSetting(data.Endpoint, DataRobotEndpointEnvVar, configProfile.Endpoint)
+	apiKey, apiKeySource := resolveProviderSetting(data.ApiKey, DataRobotApiKeyEnvVar, configProfile.Token)
 	if apiKey == "" {
 		resp.Diagnostics.AddError(
 			"Unable to find Api Key",
 			"Api Key cannot be an empty string")
 		return
 	}
+	traceContext, traceContextSource := resolveProviderSetting(data.TraceContext, DataRobotTraceContextEnvVar, configProfile.TraceContext)
 
-	var traceContext string
-	if !IsKnown(data.TraceContext) {
-		traceContext = os.Getenv(DataRobotTraceContextEnvVar)
-	} else {
-		traceContext = data.TraceContext.ValueString()
-	}
+	tflog.Debug(ctx, "Resolved DataRobot provider settings", map[string]interface{}{
+		"endpoint_source":     endpointSource,
+		"apikey_source":       apiKeySource,
+		"tracecontext_source": traceContextSource,
+		"config_file":         configFilePath,
+	})
 
 	// Create a new client configuration
 	cfg := client.NewConfiguration(apiKey)
@@ -137,24 +336,109 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		}
 	}
 
-	// retryablehttp gives us automatic retries with exponential backoff.
-	httpClient := retryablehttp.NewClient()
-	// The TF framework will pick up the default global logger.
-	// HTTP requests are logged at DEBUG level.
-	httpClient.Logger = &leveledTFLogger{baseCtx: ctx}
-	httpClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
-	httpClient.CheckRetry = retryGetRequestsOnly
-	cfg.HTTPClient = httpClient.StandardClient()
+	httpClient, diags := newHTTPClient(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cfg.HTTPClient = httpClient
+
+	p.defaultUseCaseIDs = nil
+	if IsKnown(data.DefaultUseCaseIDs) {
+		resp.Diagnostics.Append(data.DefaultUseCaseIDs.ElementsAs(ctx, &p.defaultUseCaseIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	p.defaultTags = convertSetTagsToClientTags(data.DefaultTags)
+
+	var hashCacheDiags diag.Diagnostics
+	p.hashCache, hashCacheDiags = openHashCache(data)
+	resp.Diagnostics.Append(hashCacheDiags...)
+
+	var uploadLimitsDiags diag.Diagnostics
+	p.uploadLimits, uploadLimitsDiags = resolveUploadLimits(data)
+	resp.Diagnostics.Append(uploadLimitsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Example client configuration for data sources and resources
 	cl := client.NewClient(cfg)
 	p.service = NewService(cl)
 	resp.DataSourceData = p
 	resp.ResourceData = p
+	resp.EphemeralResourceData = p
 
 	p.configured = true
 }
 
+// newHTTPClient returns the HTTP client for DataRobot API requests, configured with the
+// retry, proxy, TLS and timeout settings of the provider.
+func newHTTPClient(ctx context.Context, data ProviderModel) (*http.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// retryablehttp gives us automatic retries with exponential backoff.
+	httpClient := retryablehttp.NewClient()
+	// The TF framework will pick up the default global logger.
+	// HTTP requests are logged at DEBUG level.
+	logger := &leveledTFLogger{baseCtx: ctx}
+	httpClient.Logger = logger
+	httpClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	httpClient.RetryMax = defaultMaxRetries
+	if IsKnown(data.MaxRetries) {
+		httpClient.RetryMax = int(data.MaxRetries.ValueInt64())
+	}
+	// the values were already validated by durationStringValidator
+	httpClient.RetryWaitMin = defaultRetryWaitMin
+	if IsKnown(data.RetryWaitMin) {
+		httpClient.RetryWaitMin, _ = time.ParseDuration(data.RetryWaitMin.ValueString())
+	}
+	httpClient.RetryWaitMax = defaultRetryWaitMax
+	if IsKnown(data.RetryWaitMax) {
+		httpClient.RetryWaitMax, _ = time.ParseDuration(data.RetryWaitMax.ValueString())
+	}
+	if httpClient.RetryWaitMin > httpClient.RetryWaitMax {
+		diags.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid retry wait",
+			fmt.Sprintf("retry_wait_min (%s) must not be greater than retry_wait_max (%s)", httpClient.RetryWaitMin, httpClient.RetryWaitMax))
+		return nil, diags
+	}
+	retryOnStatus := defaultRetryOnStatus
+	if IsKnown(data.RetryOnStatus) {
+		var statuses []int64
+		diags.Append(data.RetryOnStatus.ElementsAs(ctx, &statuses, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		retryOnStatus = make([]int, len(statuses))
+		for i, status := range statuses {
+			retryOnStatus[i] = int(status)
+		}
+	}
+	httpClient.CheckRetry = newRetryPolicy(retryOnStatus, logger).CheckRetry
+	httpClient.Backoff = retryBackoff
+
+	transport, ok := httpClient.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		diags.AddError(
+			"Unexpected HTTP transport",
+			fmt.Sprintf("Expected %T, got: %T. Please report this issue to the provider developers.", &http.Transport{}, httpClient.HTTPClient.Transport))
+		return nil, diags
+	}
+	diags.Append(configureTransport(transport, data)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	standardClient := httpClient.StandardClient()
+	if IsKnown(data.RequestTimeout) {
+		standardClient.Timeout, _ = time.ParseDuration(data.RequestTimeout.ValueString())
+	}
+	return standardClient, diags
+}
+
 func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewUseCaseResource,
@@ -179,6 +463,7 @@ func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
 		NewPredictionEnvironmentResource,
 		NewDeploymentResource,
 		NewDeploymentRetrainingPolicyResource,
+		NewDeploymentChallengerResource,
 		NewQAApplicationResource,
 		NewCustomApplicationResource,
 		NewCustomApplicationFromEnvironmentResource,
@@ -200,6 +485,7 @@ func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
 		NewUserMCPResourceMetadataResource,
 		NewMemorySpaceResource,
 		NewArtifactResource,
+		NewFilesCatalogResource,
 		NewWorkloadResource,
 		NewQuotaResource,
 	}
@@ -211,11 +497,31 @@ func (p *Provider) DataSources(ctx context.Context) []func() datasource.DataSour
 		NewExecutionEnvironmentDataSource,
 		NewArtifactDataSource,
 		NewArtifactsDataSource,
+		NewFilesCatalogDataSource,
+		NewFilesCatalogDownloadDataSource,
+		NewDeploymentDataSource,
+		NewDeploymentsDataSource,
+		NewDeploymentChallengersDataSource,
+		NewWorkloadLogsDataSource,
+	}
+}
+
+func (p *Provider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewApiTokenEphemeralResource,
+		NewAppOAuthTokenEphemeralResource,
+		NewCredentialSecretEphemeralResource,
 	}
 }
 
 func (p *Provider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewParseMemoryFunction,
+		NewNextVersionLabelFunction,
+		NewDirectoryHashFunction,
+		NewCronScheduleFunction,
+		NewRuntimeParametersFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tagObjectType is the element type of the tags and tags_all attributes.
+var tagObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":  types.StringType,
+		"value": types.StringType,
+	},
+}
+
+// useCaseIDsAll merges the use case IDs of a resource with the provider's default_use_case_ids,
+// for the computed use_case_ids_all attribute. It is unknown while the provider is not configured.
+func (p *Provider) useCaseIDsAll(useCaseIDs []types.String) types.List {
+	if p == nil {
+		return types.ListUnknown(types.StringType)
+	}
+
+	seen := make(map[string]bool)
+	elements := make([]attr.Value, 0, len(useCaseIDs)+len(p.defaultUseCaseIDs))
+	for _, useCaseID := range useCaseIDs {
+		if IsKnown(useCaseID) {
+			if seen[useCaseID.ValueString()] {
+				continue
+			}
+			seen[useCaseID.ValueString()] = true
+		}
+		elements = append(elements, useCaseID)
+	}
+	for _, useCaseID := range p.defaultUseCaseIDs {
+		if !seen[useCaseID] {
+			seen[useCaseID] = true
+			elements = append(elements, types.StringValue(useCaseID))
+		}
+	}
+
+	if len(elements) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// effectiveUseCaseIDs returns the use case IDs to link an entity to: use_case_ids_all,
+// or use_case_ids for a state written before use_case_ids_all existed.
+func effectiveUseCaseIDs(useCaseIDsAll types.List, useCaseIDs []types.String) []types.String {
+	if !IsKnown(useCaseIDsAll) {
+		return useCaseIDs
+	}
+	result := make([]types.String, 0, len(useCaseIDsAll.Elements()))
+	for _, element := range useCaseIDsAll.Elements() {
+		if useCaseID, ok := element.(types.String); ok {
+			result = append(result, useCaseID)
+		}
+	}
+	return result
+}
+
+// tagsAll merges the tags of a resource with the provider's default_tags, for the computed
+// tags_all attribute. A tag of the resource overrides the default tag with the same name.
+// tags must have been normalized with normalizeTagsSet.
+func (p *Provider) tagsAll(tags types.Set) types.Set {
+	if p == nil || tags.IsUnknown() {
+		return types.SetUnknown(tagObjectType)
+	}
+
+	names := make(map[string]bool)
+	elements := make([]attr.Value, 0, len(tags.Elements())+len(p.defaultTags))
+	for _, element := range tags.Elements() {
+		if tag, ok := element.(types.Object); ok {
+			if name, ok := tag.Attributes()["name"].(types.String); ok {
+				names[name.ValueString()] = true
+			}
+		}
+		elements = append(elements, element)
+	}
+	for _, tag := range p.defaultTags {
+		if names[tag.Name] {
+			continue
+		}
+		elements = append(elements, types.ObjectValueMust(tagObjectType.AttrTypes, map[string]attr.Value{
+			"name":  types.StringValue(tag.Name),
+			"value": types.StringValue(tag.Value),
+		}))
+	}
+
+	if len(elements) == 0 {
+		return types.SetNull(tagObjectType)
+	}
+	return types.SetValueMust(tagObjectType, elements)
+}
+
+// tagsFromAPI returns the tags and tags_all attributes for the tags read from the API.
+// tags excludes the provider's default_tags, unless they are in configuredTags.
+func (p *Provider) tagsFromAPI(apiTags []client.Tag, configuredTags types.Set, diags *diag.Diagnostics) (tags types.Set, tagsAll types.Set) {
+	tags, tagsAll = types.SetNull(tagObjectType), types.SetNull(tagObjectType)
+	if len(apiTags) == 0 {
+		return
+	}
+	tagsAll = initializeTagsFromModel(apiTags, diags)
+	if resourceTags := p.withoutDefaultTags(apiTags, configuredTags); len(resourceTags) > 0 {
+		tags = initializeTagsFromModel(resourceTags, diags)
+	}
+	return
+}
+
+// withoutDefaultTags returns the tags read from the API without the provider's default_tags,
+// so that they can be compared with the tags of the configuration. A default tag that is also
+// in configuredTags is kept.
+func (p *Provider) withoutDefaultTags(tags []client.Tag, configuredTags types.Set) []client.Tag {
+	if p == nil || len(p.defaultTags) == 0 {
+		return tags
+	}
+
+	configured := make(map[client.Tag]bool)
+	for _, tag := range convertSetTagsToClientTags(configuredTags) {
+		configured[tag] = true
+	}
+	defaults := make(map[client.Tag]bool)
+	for _, tag := range p.defaultTags {
+		defaults[tag] = true
+	}
+
+	result := make([]client.Tag, 0, len(tags))
+	for _, tag := range tags {
+		if defaults[tag] && !configured[tag] {
+			continue
+		}
+		result = append(result, tag)
+	}
+	return result
+}
+
+// modifyPlanUseCaseIDsAll sets use_case_ids_all in the plan of a resource from its use_case_ids.
+func (p *Provider) modifyPlanUseCaseIDsAll(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Resource is being destroyed
+		return
+	}
+
+	var useCaseIDs types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("use_case_ids"), &useCaseIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	useCaseIDsAll := types.ListUnknown(types.StringType)
+	if !useCaseIDs.IsUnknown() {
+		useCaseIDsAll = p.useCaseIDsAll(effectiveUseCaseIDs(useCaseIDs, nil))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("use_case_ids_all"), useCaseIDsAll)...)
+}
+
+// modifyPlanTagsAll sets tags_all in the plan of a resource from its tags.
+func (p *Provider) modifyPlanTagsAll(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Resource is being destroyed
+		return
+	}
+
+	var tags types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll := types.SetUnknown(tagObjectType)
+	if !tags.IsUnknown() {
+		tags, diags := normalizeTagsSet(ctx, tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tagsAll = p.tagsAll(tags)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
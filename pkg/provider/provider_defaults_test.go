@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func newTestTagsSet(t *testing.T, tags ...client.Tag) types.Set {
+	t.Helper()
+	var diags diag.Diagnostics
+	set := initializeTagsFromModel(tags, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	return set
+}
+
+func TestUseCaseIDsAll(t *testing.T) {
+	t.Parallel()
+
+	p := &Provider{defaultUseCaseIDs: []string{"team", "shared"}}
+
+	useCaseIDsAll := p.useCaseIDsAll([]types.String{types.StringValue("shared"), types.StringValue("project"), types.StringUnknown()})
+	expected := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("shared"),
+		types.StringValue("project"),
+		types.StringUnknown(),
+		types.StringValue("team"),
+	})
+	if !useCaseIDsAll.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, useCaseIDsAll)
+	}
+
+	if got := (&Provider{}).useCaseIDsAll(nil); !got.IsNull() {
+		t.Errorf("expected null without use cases, got %s", got)
+	}
+	if got := (*Provider)(nil).useCaseIDsAll(nil); !got.IsUnknown() {
+		t.Errorf("expected unknown without configured provider, got %s", got)
+	}
+}
+
+func TestEffectiveUseCaseIDs(t *testing.T) {
+	t.Parallel()
+
+	useCaseIDs := []types.String{types.StringValue("project")}
+	if got := effectiveUseCaseIDs(types.ListNull(types.StringType), useCaseIDs); len(got) != 1 || got[0].ValueString() != "project" {
+		t.Errorf("expected use_case_ids for a state without use_case_ids_all, got %v", got)
+	}
+
+	useCaseIDsAll := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("project"), types.StringValue("team")})
+	if got := effectiveUseCaseIDs(useCaseIDsAll, useCaseIDs); len(got) != 2 || got[1].ValueString() != "team" {
+		t.Errorf("expected use_case_ids_all, got %v", got)
+	}
+}
+
+func TestTagsAll(t *testing.T) {
+	t.Parallel()
+
+	p := &Provider{defaultTags: []client.Tag{{Name: "cost-center", Value: "1234"}, {Name: "team", Value: "platform"}}}
+
+	tags := newTestTagsSet(t, client.Tag{Name: "team", Value: "ml"})
+	expected := newTestTagsSet(t, client.Tag{Name: "team", Value: "ml"}, client.Tag{Name: "cost-center", Value: "1234"})
+	if got := p.tagsAll(tags); !got.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+
+	nullTags, _ := normalizeTagsSet(context.Background(), types.SetNull(tagObjectType))
+	expected = newTestTagsSet(t, p.defaultTags...)
+	if got := p.tagsAll(nullTags); !got.Equal(expected) {
+		t.Errorf("expected the default tags, got %s", got)
+	}
+
+	if got := (&Provider{}).tagsAll(nullTags); !got.IsNull() {
+		t.Errorf("expected null without tags, got %s", got)
+	}
+	if got := p.tagsAll(types.SetUnknown(tagObjectType)); !got.IsUnknown() {
+		t.Errorf("expected unknown for unknown tags, got %s", got)
+	}
+}
+
+func TestTagsFromAPI(t *testing.T) {
+	t.Parallel()
+
+	p := &Provider{defaultTags: []client.Tag{{Name: "cost-center", Value: "1234"}, {Name: "env", Value: "prod"}}}
+	apiTags := []client.Tag{{Name: "cost-center", Value: "1234"}, {Name: "env", Value: "prod"}, {Name: "team", Value: "ml"}}
+
+	var diags diag.Diagnostics
+	configured := newTestTagsSet(t, client.Tag{Name: "env", Value: "prod"}, client.Tag{Name: "team", Value: "ml"})
+	tags, tagsAll := p.tagsFromAPI(apiTags, configured, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	if !tags.Equal(configured) {
+		t.Errorf("expected tags %s, got %s", configured, tags)
+	}
+	if expected := newTestTagsSet(t, apiTags...); !tagsAll.Equal(expected) {
+		t.Errorf("expected tags_all %s, got %s", expected, tagsAll)
+	}
+
+	tags, _ = p.tagsFromAPI(p.defaultTags, types.SetNull(tagObjectType), &diags)
+	if !tags.IsNull() {
+		t.Errorf("expected null tags when only default tags are assigned, got %s", tags)
+	}
+}
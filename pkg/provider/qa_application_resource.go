@@ -102,6 +102,9 @@ func (r *QAApplicationResource) Schema(ctx context.Context, req resource.SchemaR
 				MarkdownDescription: "Whether auto stopping is allowed for the Q&A Application.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -128,12 +131,14 @@ func (r *QAApplicationResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateQAApplication")
 	createResp, err := r.provider.service.CreateQAApplication(ctx, &client.CreateQAApplicationRequest{
 		DeploymentID: data.DeploymentID.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Application", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Application", err)
 		return
 	}
 
@@ -165,8 +170,7 @@ func (r *QAApplicationResource) Create(ctx context.Context, req resource.CreateR
 				fmt.Sprintf("Application with ID %s is not found. Removing from state.", createResp.ID))
 			resp.State.RemoveResource(ctx)
 		} else {
-			errMessage := checkApplicationNameAlreadyExists(err, data.Name.ValueString())
-			resp.Diagnostics.AddError("Error adding details to Q&A Application", errMessage)
+			addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error adding details to Q&A Application", err, data.Name.ValueString(), "Application")
 		}
 		return
 	}
@@ -199,6 +203,8 @@ func (r *QAApplicationResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -269,6 +275,8 @@ func (r *QAApplicationResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state QAApplicationResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -313,8 +321,7 @@ func (r *QAApplicationResource) Update(ctx context.Context, req resource.UpdateR
 				fmt.Sprintf("Application with ID %s is not found. Removing from state.", plan.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			errMessage := checkApplicationNameAlreadyExists(err, plan.Name.ValueString())
-			resp.Diagnostics.AddError("Error updating Application", errMessage)
+			addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Application", err, plan.Name.ValueString(), "Application")
 		}
 		return
 	}
@@ -344,6 +351,8 @@ func (r *QAApplicationResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteApplication")
 	err := r.provider.service.DeleteApplication(ctx, data.ID.ValueString())
 	if err != nil {
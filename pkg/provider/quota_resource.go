@@ -90,6 +90,9 @@ func (r *QuotaResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -114,6 +117,8 @@ func (r *QuotaResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateQuota")
 	quota, err := r.provider.service.CreateQuota(ctx, &client.CreateQuotaRequest{
 		ResourceType: plan.ResourceType.ValueString(),
@@ -121,7 +126,7 @@ func (r *QuotaResource) Create(ctx context.Context, req resource.CreateRequest,
 		DefaultRules: quotaRulesFromModel(plan.DefaultRules),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Quota", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Quota", err)
 		return
 	}
 
@@ -136,6 +141,8 @@ func (r *QuotaResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ResourceID.IsNull() || data.ResourceID.IsUnknown() || data.ResourceID.ValueString() == "" {
 		return
 	}
@@ -168,6 +175,8 @@ func (r *QuotaResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state QuotaResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -180,7 +189,7 @@ func (r *QuotaResource) Update(ctx context.Context, req resource.UpdateRequest,
 		DefaultRules: quotaRulesFromModel(plan.DefaultRules),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Quota", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Quota", err)
 		return
 	}
 
@@ -195,6 +204,8 @@ func (r *QuotaResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteQuota")
 	if err := r.provider.service.DeleteQuota(ctx, data.ID.ValueString()); err != nil {
 		if !errors.Is(err, &client.NotFoundError{}) {
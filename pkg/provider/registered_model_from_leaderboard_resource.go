@@ -17,6 +17,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RegisteredModelFromLeaderboardResource{}
 var _ resource.ResourceWithImportState = &RegisteredModelFromLeaderboardResource{}
+var _ resource.ResourceWithModifyPlan = &RegisteredModelFromLeaderboardResource{}
 
 func NewRegisteredModelFromLeaderboardResource() resource.Resource {
 	return &RegisteredModelFromLeaderboardResource{}
@@ -82,6 +83,14 @@ func (r *RegisteredModelFromLeaderboardResource) Schema(ctx context.Context, req
 				MarkdownDescription: "The list of Use Case IDs to add the Registered Model version to.",
 				ElementType:         types.StringType,
 			},
+			"use_case_ids_all": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of Use Case IDs the Registered Model version is added to: `use_case_ids` and the provider's `default_use_case_ids`.",
+				ElementType:         types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -109,6 +118,8 @@ func (r *RegisteredModelFromLeaderboardResource) Create(ctx context.Context, req
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateRegisteredModelFromLeaderboard")
 	registeredModelVersion, err := r.provider.service.CreateRegisteredModelFromLeaderboard(ctx, &client.CreateRegisteredModelFromLeaderboardRequest{
 		ModelID:                       data.ModelID.ValueString(),
@@ -118,8 +129,7 @@ func (r *RegisteredModelFromLeaderboardResource) Create(ctx context.Context, req
 		DistributionPredictionModelID: StringValuePointerOptional(data.DistributionPredictionModelID),
 	})
 	if err != nil {
-		errMessage := checkNameAlreadyExists(err, data.Name.ValueString(), "Registered Model")
-		resp.Diagnostics.AddError("Error creating Registered Model", errMessage)
+		addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Registered Model", err, data.Name.ValueString(), "Registered Model")
 		return
 	}
 	data.ID = types.StringValue(registeredModelVersion.RegisteredModelID)
@@ -133,7 +143,7 @@ func (r *RegisteredModelFromLeaderboardResource) Create(ctx context.Context, req
 				Description: data.Description.ValueString(),
 			})
 		if err != nil {
-			resp.Diagnostics.AddError("Error adding description to Registered Model", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error adding description to Registered Model", err)
 			return
 		}
 	}
@@ -144,7 +154,7 @@ func (r *RegisteredModelFromLeaderboardResource) Create(ctx context.Context, req
 			Name: data.VersionName.ValueString(),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error adding name to Registered Model Version", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error adding name to Registered Model Version", err)
 			return
 		}
 	}
@@ -156,7 +166,7 @@ func (r *RegisteredModelFromLeaderboardResource) Create(ctx context.Context, req
 		return
 	}
 
-	for _, useCaseID := range data.UseCaseIDs {
+	for _, useCaseID := range effectiveUseCaseIDs(data.UseCaseIDsAll, data.UseCaseIDs) {
 		traceAPICall("AddRegisteredModelVersionToUseCase")
 		if err = addEntityToUseCase(
 			ctx,
@@ -165,7 +175,7 @@ func (r *RegisteredModelFromLeaderboardResource) Create(ctx context.Context, req
 			"registeredModelVersion",
 			registeredModelVersion.ID,
 		); err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error adding Registered Model version to Use Case %s", useCaseID), err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error adding Registered Model version to Use Case %s", useCaseID), err)
 			return
 		}
 	}
@@ -181,6 +191,8 @@ func (r *RegisteredModelFromLeaderboardResource) Read(ctx context.Context, req r
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -226,6 +238,8 @@ func (r *RegisteredModelFromLeaderboardResource) Update(ctx context.Context, req
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state RegisteredModelFromLeaderboardResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -249,8 +263,7 @@ func (r *RegisteredModelFromLeaderboardResource) Update(ctx context.Context, req
 				fmt.Sprintf("Registered Model with ID %s is not found. Removing from state.", plan.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			errMessage := checkNameAlreadyExists(err, plan.Name.ValueString(), "Registered Model")
-			resp.Diagnostics.AddError("Error updating Registered Model", errMessage)
+			addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Registered Model", err, plan.Name.ValueString(), "Registered Model")
 		}
 		return
 	}
@@ -267,7 +280,7 @@ func (r *RegisteredModelFromLeaderboardResource) Update(ctx context.Context, req
 			DistributionPredictionModelID: StringValuePointerOptional(plan.DistributionPredictionModelID),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating Registered Model version", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Registered Model version", err)
 			return
 		}
 		plan.VersionID = types.StringValue(registeredModelVersion.ID)
@@ -278,7 +291,7 @@ func (r *RegisteredModelFromLeaderboardResource) Update(ctx context.Context, req
 		if registeredModelVersion, err = r.provider.service.UpdateRegisteredModelVersion(ctx, plan.ID.ValueString(), plan.VersionID.ValueString(), &client.UpdateRegisteredModelVersionRequest{
 			Name: plan.VersionName.ValueString(),
 		}); err != nil {
-			resp.Diagnostics.AddError("Error updating Registered Model Version", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Registered Model Version", err)
 			return
 		}
 	}
@@ -291,7 +304,7 @@ func (r *RegisteredModelFromLeaderboardResource) Update(ctx context.Context, req
 	}
 
 	// check if we created a new version
-	existingUseCaseIDs := state.UseCaseIDs
+	existingUseCaseIDs := effectiveUseCaseIDs(state.UseCaseIDsAll, state.UseCaseIDs)
 	if state.VersionID.ValueString() != plan.VersionID.ValueString() {
 		existingUseCaseIDs = []types.String{}
 	}
@@ -302,9 +315,9 @@ func (r *RegisteredModelFromLeaderboardResource) Update(ctx context.Context, req
 		"registeredModelVersion",
 		plan.VersionID.ValueString(),
 		existingUseCaseIDs,
-		plan.UseCaseIDs,
+		effectiveUseCaseIDs(plan.UseCaseIDsAll, plan.UseCaseIDs),
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating Use Cases for Registered Model version", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Use Cases for Registered Model version", err)
 		return
 	}
 
@@ -319,6 +332,8 @@ func (r *RegisteredModelFromLeaderboardResource) Delete(ctx context.Context, req
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteRegisteredModel")
 	err := r.provider.service.DeleteRegisteredModel(ctx, data.ID.ValueString())
 	if err != nil {
@@ -333,6 +348,10 @@ func (r *RegisteredModelFromLeaderboardResource) ImportState(ctx context.Context
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *RegisteredModelFromLeaderboardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.provider.modifyPlanUseCaseIDsAll(ctx, req, resp)
+}
+
 func (r *RegisteredModelFromLeaderboardResource) shouldCreateNewVersion(state RegisteredModelFromLeaderboardResourceModel, plan RegisteredModelFromLeaderboardResourceModel) bool {
 	return (IsKnown(plan.PredictionThreshold) && plan.PredictionThreshold.ValueFloat64() != state.PredictionThreshold.ValueFloat64()) ||
 		(IsKnown(plan.ComputeAllTsIntervals) && plan.ComputeAllTsIntervals.ValueBool() != state.ComputeAllTsIntervals.ValueBool()) ||
@@ -6,7 +6,6 @@ import (
 	"fmt"
 
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RegisteredModelResource{}
 var _ resource.ResourceWithImportState = &RegisteredModelResource{}
+var _ resource.ResourceWithModifyPlan = &RegisteredModelResource{}
 
 func NewRegisteredModelResource() resource.Resource {
 	return &RegisteredModelResource{}
@@ -75,6 +75,11 @@ func (r *RegisteredModelResource) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "The list of Use Case IDs to add the Registered Model version to.",
 				ElementType:         types.StringType,
 			},
+			"use_case_ids_all": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of Use Case IDs the Registered Model version is added to: `use_case_ids` and the provider's `default_use_case_ids`.",
+				ElementType:         types.StringType,
+			},
 			"tags": schema.SetNestedAttribute{
 				Optional:            true,
 				MarkdownDescription: "The list of tags to assign to the Registered Model version.",
@@ -91,6 +96,26 @@ func (r *RegisteredModelResource) Schema(ctx context.Context, req resource.Schem
 					},
 				},
 			},
+			"tags_all": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The tags assigned to the Registered Model version: `tags` and the provider's `default_tags`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the tag.",
+						},
+						"value": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The value of the tag.",
+						},
+					},
+				},
+			},
+			"deletion_protection": deletionProtectionAttribute("Registered Model"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -118,6 +143,8 @@ func (r *RegisteredModelResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	// Normalize Tags to ensure it has the correct type
 	var diags diag.Diagnostics
 	data.Tags, diags = normalizeTagsSet(ctx, data.Tags)
@@ -130,7 +157,7 @@ func (r *RegisteredModelResource) Create(ctx context.Context, req resource.Creat
 		CustomModelVersionID: data.CustomModelVersionId.ValueString(),
 		Name:                 getVersionName(data, 1),
 		RegisteredModelName:  data.Name.ValueString(),
-		Tags:                 convertSetTagsToClientTags(data.Tags),
+		Tags:                 convertSetTagsToClientTags(data.TagsAll),
 	}
 
 	if err := r.populatePromptFromCustomModel(ctx, createRegisteredModelRequest, data.CustomModelVersionId.ValueString()); err != nil {
@@ -141,8 +168,7 @@ func (r *RegisteredModelResource) Create(ctx context.Context, req resource.Creat
 	traceAPICall("CreateRegisteredModel")
 	registeredModelVersion, err := r.provider.service.CreateRegisteredModelFromCustomModelVersion(ctx, createRegisteredModelRequest)
 	if err != nil {
-		errMessage := checkNameAlreadyExists(err, data.Name.ValueString(), "Registered Model")
-		resp.Diagnostics.AddError("Error creating Registered Model", errMessage)
+		addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Registered Model", err, data.Name.ValueString(), "Registered Model")
 		return
 	}
 	data.ID = types.StringValue(registeredModelVersion.RegisteredModelID)
@@ -150,18 +176,9 @@ func (r *RegisteredModelResource) Create(ctx context.Context, req resource.Creat
 	data.VersionName = types.StringValue(registeredModelVersion.Name)
 
 	// Set tags from API response to ensure consistency with Read method
-	if len(registeredModelVersion.Tags) > 0 {
-		data.Tags = initializeTagsFromModel(registeredModelVersion.Tags, &resp.Diagnostics)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	} else {
-		data.Tags = types.SetNull(types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"name":  types.StringType,
-				"value": types.StringType,
-			},
-		})
+	data.Tags, data.TagsAll = r.provider.tagsFromAPI(registeredModelVersion.Tags, data.Tags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if IsKnown(data.Description) {
@@ -179,7 +196,7 @@ func (r *RegisteredModelResource) Create(ctx context.Context, req resource.Creat
 					fmt.Sprintf("Registered Model with ID %s is not found. Removing from state.", registeredModelVersion.RegisteredModelID))
 				resp.State.RemoveResource(ctx)
 			} else {
-				resp.Diagnostics.AddError("Error updating Registered Model", err.Error())
+				addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Registered Model", err)
 			}
 			return
 		}
@@ -191,7 +208,7 @@ func (r *RegisteredModelResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	for _, useCaseID := range data.UseCaseIDs {
+	for _, useCaseID := range effectiveUseCaseIDs(data.UseCaseIDsAll, data.UseCaseIDs) {
 		traceAPICall("AddRegisteredModelVersionToUseCase")
 		if err = addEntityToUseCase(
 			ctx,
@@ -200,7 +217,7 @@ func (r *RegisteredModelResource) Create(ctx context.Context, req resource.Creat
 			"registeredModelVersion",
 			registeredModelVersion.ID,
 		); err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("Error adding Registered Model version to Use Case %s", useCaseID), err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error adding Registered Model version to Use Case %s", useCaseID), err)
 			return
 		}
 	}
@@ -216,6 +233,8 @@ func (r *RegisteredModelResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	// Normalize Tags to ensure it has the correct type
 	var diags diag.Diagnostics
 	data.Tags, diags = normalizeTagsSet(ctx, data.Tags)
@@ -257,18 +276,9 @@ func (r *RegisteredModelResource) Read(ctx context.Context, req resource.ReadReq
 	data.VersionID = types.StringValue(latestRegisteredModelVersion.ID)
 	data.VersionName = types.StringValue(latestRegisteredModelVersion.Name)
 
-	if len(latestRegisteredModelVersion.Tags) > 0 {
-		data.Tags = initializeTagsFromModel(latestRegisteredModelVersion.Tags, &resp.Diagnostics)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	} else {
-		data.Tags = types.SetNull(types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"name":  types.StringType,
-				"value": types.StringType,
-			},
-		})
+	data.Tags, data.TagsAll = r.provider.tagsFromAPI(latestRegisteredModelVersion.Tags, data.Tags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -282,6 +292,8 @@ func (r *RegisteredModelResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	// Normalize Tags from plan to ensure it has the correct type
 	var diags diag.Diagnostics
 	plan.Tags, diags = normalizeTagsSet(ctx, plan.Tags)
@@ -320,8 +332,7 @@ func (r *RegisteredModelResource) Update(ctx context.Context, req resource.Updat
 				fmt.Sprintf("Registered Model with ID %s is not found. Removing from state.", plan.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			errMessage := checkNameAlreadyExists(err, plan.Name.ValueString(), "Registered Model")
-			resp.Diagnostics.AddError("Error updating Registered Model", errMessage)
+			addNameAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Registered Model", err, plan.Name.ValueString(), "Registered Model")
 		}
 		return
 	}
@@ -335,16 +346,17 @@ func (r *RegisteredModelResource) Update(ctx context.Context, req resource.Updat
 			Name: versionName,
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Registered Model Version", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Registered Model Version", err)
 			return
 		}
 	}
 
 	if state.CustomModelVersionId.ValueString() != plan.CustomModelVersionId.ValueString() ||
-		!plan.Tags.Equal(state.Tags) {
-		registeredModelVersion, err := r.createNewRegisteredModelVersion(ctx, plan.ID.ValueString(), plan.CustomModelVersionId.ValueString(), versionName, plan.Tags)
+		!plan.Tags.Equal(state.Tags) ||
+		!plan.TagsAll.Equal(state.TagsAll) {
+		registeredModelVersion, err := r.createNewRegisteredModelVersion(ctx, plan.ID.ValueString(), plan.CustomModelVersionId.ValueString(), versionName, plan.TagsAll)
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating Registered Model Version", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Registered Model Version", err)
 			return
 		}
 		plan.VersionID = types.StringValue(registeredModelVersion.ID)
@@ -352,7 +364,7 @@ func (r *RegisteredModelResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// check if we created a new version
-	existingUseCaseIDs := state.UseCaseIDs
+	existingUseCaseIDs := effectiveUseCaseIDs(state.UseCaseIDsAll, state.UseCaseIDs)
 	if state.VersionID.ValueString() != plan.VersionID.ValueString() {
 		existingUseCaseIDs = []types.String{}
 	}
@@ -363,9 +375,9 @@ func (r *RegisteredModelResource) Update(ctx context.Context, req resource.Updat
 		"registeredModelVersion",
 		plan.VersionID.ValueString(),
 		existingUseCaseIDs,
-		plan.UseCaseIDs,
+		effectiveUseCaseIDs(plan.UseCaseIDsAll, plan.UseCaseIDs),
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating Use Cases for Registered Model version", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Use Cases for Registered Model version", err)
 		return
 	}
 
@@ -380,6 +392,12 @@ func (r *RegisteredModelResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	if checkDeletionProtection(data.DeletionProtection, "Registered Model", data.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	registeredModelID := data.ID.ValueString()
 	traceAPICall("DeleteRegisteredModel")
 	err := r.provider.service.DeleteRegisteredModel(ctx, registeredModelID)
@@ -395,6 +413,12 @@ func (r *RegisteredModelResource) ImportState(ctx context.Context, req resource.
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *RegisteredModelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDeletionProtection(ctx, req, resp, "Registered Model")
+	r.provider.modifyPlanUseCaseIDsAll(ctx, req, resp)
+	r.provider.modifyPlanTagsAll(ctx, req, resp)
+}
+
 func getVersionName(plan RegisteredModelResourceModel, versionNum int) string {
 	if IsKnown(plan.VersionName) {
 		return plan.VersionName.ValueString()
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RemoteRepositoryResource{}
 var _ resource.ResourceWithImportState = &RemoteRepositoryResource{}
+var _ resource.ResourceWithConfigValidators = &RemoteRepositoryResource{}
 
 func NewRemoteRepositoryResource() resource.Resource {
 	return &RemoteRepositoryResource{}
@@ -65,6 +67,8 @@ func (r *RemoteRepositoryResource) Schema(ctx context.Context, req resource.Sche
 				MarkdownDescription: "The personal access token for the Remote Repository.",
 				Optional:            true,
 			},
+			"personal_access_token_wo":         writeOnlyAttribute("personal_access_token"),
+			"personal_access_token_wo_version": writeOnlyVersionAttribute("personal_access_token", false),
 
 			// S3 remote repository specific attributes
 			"aws_access_key_id": schema.StringAttribute{
@@ -75,11 +79,16 @@ func (r *RemoteRepositoryResource) Schema(ctx context.Context, req resource.Sche
 				MarkdownDescription: "The AWS secret access key for the Remote Repository.",
 				Optional:            true,
 			},
+			"aws_secret_access_key_wo":         writeOnlyAttribute("aws_secret_access_key"),
+			"aws_secret_access_key_wo_version": writeOnlyVersionAttribute("aws_secret_access_key", false),
 			"aws_session_token": schema.StringAttribute{
 				MarkdownDescription: "The AWS session token for the Remote Repository.",
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -106,31 +115,37 @@ func (r *RemoteRepositoryResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
+	personalAccessTokenWO := writeOnlyValue(ctx, req.Config, "personal_access_token_wo", &resp.Diagnostics)
+	awsSecretAccessKeyWO := writeOnlyValue(ctx, req.Config, "aws_secret_access_key_wo", &resp.Diagnostics)
+
 	var credentialID string
-	if IsKnown(data.PersonalAccessToken) {
+	if IsKnown(data.PersonalAccessToken) || IsKnown(personalAccessTokenWO) {
 		traceAPICall("CreateCredential")
 		credential, err := r.provider.service.CreateCredential(ctx, &client.CredentialRequest{
 			Name:           fmt.Sprintf("%s_%d", data.Name.ValueString(), time.Now().UnixNano()),
-			Token:          data.PersonalAccessToken.ValueString(),
+			Token:          secretValue(data.PersonalAccessToken, personalAccessTokenWO),
 			RefreshToken:   "dummy",
 			CredentialType: "oauth",
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating personal access token Credential", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating personal access token Credential", err)
 			return
 		}
 		credentialID = credential.ID
-	} else if data.SourceType.ValueString() == "s3" && IsKnown(data.AWSAccessKeyID) && IsKnown(data.AWSSecretAccessKey) {
+	} else if data.SourceType.ValueString() == "s3" && IsKnown(data.AWSAccessKeyID) &&
+		(IsKnown(data.AWSSecretAccessKey) || IsKnown(awsSecretAccessKeyWO)) {
 		traceAPICall("CreateCredential")
 		credential, err := r.provider.service.CreateCredential(ctx, &client.CredentialRequest{
 			Name:               fmt.Sprintf("%s_%s_%d", data.Name.ValueString(), data.Location.ValueString(), time.Now().UnixNano()),
 			CredentialType:     "s3",
 			AWSAccessKeyID:     data.AWSAccessKeyID.ValueString(),
-			AWSSecretAccessKey: data.AWSSecretAccessKey.ValueString(),
+			AWSSecretAccessKey: secretValue(data.AWSSecretAccessKey, awsSecretAccessKeyWO),
 			AWSSessionToken:    data.AWSSessionToken.ValueString(),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating S3 Credential", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating S3 Credential", err)
 			return
 		}
 		credentialID = credential.ID
@@ -145,7 +160,7 @@ func (r *RemoteRepositoryResource) Create(ctx context.Context, req resource.Crea
 		CredentialID: credentialID,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Remote Repository", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Remote Repository", err)
 		return
 	}
 	data.ID = types.StringValue(createResp.ID)
@@ -161,6 +176,8 @@ func (r *RemoteRepositoryResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -198,6 +215,8 @@ func (r *RemoteRepositoryResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state RemoteRepositoryResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -205,6 +224,9 @@ func (r *RemoteRepositoryResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	personalAccessTokenWO := writeOnlyValue(ctx, req.Config, "personal_access_token_wo", &resp.Diagnostics)
+	awsSecretAccessKeyWO := writeOnlyValue(ctx, req.Config, "aws_secret_access_key_wo", &resp.Diagnostics)
+
 	traceAPICall("UpdateRemoteRepository")
 	remoteRepository, err := r.provider.service.UpdateRemoteRepository(ctx,
 		plan.ID.ValueString(),
@@ -220,36 +242,38 @@ func (r *RemoteRepositoryResource) Update(ctx context.Context, req resource.Upda
 				fmt.Sprintf("Remote Repository with ID %s is not found. Removing from state.", plan.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error updating Remote Repository", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Remote Repository", err)
 		}
 		return
 	}
 
-	if state.PersonalAccessToken.ValueString() != plan.PersonalAccessToken.ValueString() {
+	if state.PersonalAccessToken.ValueString() != plan.PersonalAccessToken.ValueString() ||
+		state.PersonalAccessTokenWOVersion != plan.PersonalAccessTokenWOVersion {
 		traceAPICall("UpdateCredential")
 		_, err = r.provider.service.UpdateCredential(ctx, remoteRepository.CredentialID, &client.CredentialRequest{
 			Name:         fmt.Sprintf("%s_%d", remoteRepository.Name, time.Now().UnixNano()),
-			Token:        plan.PersonalAccessToken.ValueString(),
+			Token:        secretValue(plan.PersonalAccessToken, personalAccessTokenWO),
 			RefreshToken: "dummy",
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating personal access token Credential", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating personal access token Credential", err)
 			return
 		}
 	}
 
 	if state.AWSAccessKeyID.ValueString() != plan.AWSAccessKeyID.ValueString() ||
 		state.AWSSecretAccessKey.ValueString() != plan.AWSSecretAccessKey.ValueString() ||
+		state.AWSSecretAccessKeyWOVersion != plan.AWSSecretAccessKeyWOVersion ||
 		state.AWSSessionToken.ValueString() != plan.AWSSessionToken.ValueString() {
 		traceAPICall("UpdateCredential")
 		_, err = r.provider.service.UpdateCredential(ctx, remoteRepository.CredentialID, &client.CredentialRequest{
 			Name:               fmt.Sprintf("%s_%s_%d", remoteRepository.Name, remoteRepository.Location, time.Now().UnixNano()),
 			AWSAccessKeyID:     plan.AWSAccessKeyID.ValueString(),
-			AWSSecretAccessKey: plan.AWSSecretAccessKey.ValueString(),
+			AWSSecretAccessKey: secretValue(plan.AWSSecretAccessKey, awsSecretAccessKeyWO),
 			AWSSessionToken:    plan.AWSSessionToken.ValueString(),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating S3 Credential", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating S3 Credential", err)
 			return
 		}
 	}
@@ -265,6 +289,8 @@ func (r *RemoteRepositoryResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("GetRemoteRepository")
 	remoteRepository, err := r.provider.service.GetRemoteRepository(ctx, data.ID.ValueString())
 	if err != nil {
@@ -296,3 +322,16 @@ func (r *RemoteRepositoryResource) Delete(ctx context.Context, req resource.Dele
 func (r *RemoteRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r RemoteRepositoryResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("personal_access_token"),
+			path.MatchRoot("personal_access_token_wo"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("aws_secret_access_key"),
+			path.MatchRoot("aws_secret_access_key_wo"),
+		),
+	}
+}
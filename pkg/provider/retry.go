@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	defaultMaxRetries   = 4
+	defaultRetryWaitMin = time.Second
+	defaultRetryWaitMax = 30 * time.Second
+)
+
+// defaultRetryOnStatus are the response statuses retried when retry_on_status is not set.
+var defaultRetryOnStatus = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// retryPolicy implements the retryable-http CheckRetry type.
+//
+// Recoverable connection errors are always retried. GET requests are also retried
+// on any of the retryOnStatus statuses. Any other method may already have mutated data on the server even if
+// the response wasn't successful, so it is only retried when that is provably safe:
+//   - on 429 Too Many Requests, which the API returns before processing the request;
+//   - on 503 Service Unavailable with a Retry-After header, which the API returns
+//     when it refuses to process the request.
+//
+// Application code should handle any other retries where appropriate.
+type retryPolicy struct {
+	retryOnStatus map[int]bool
+	logger        retryablehttp.LeveledLogger
+}
+
+func newRetryPolicy(retryOnStatus []int, logger retryablehttp.LeveledLogger) *retryPolicy {
+	policy := &retryPolicy{
+		retryOnStatus: make(map[int]bool, len(retryOnStatus)),
+		logger:        logger,
+	}
+	for _, status := range retryOnStatus {
+		policy.retryOnStatus[status] = true
+	}
+	return policy
+}
+
+func (p *retryPolicy) CheckRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	// do not retry on context.Canceled or context.DeadlineExceeded
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	if err != nil {
+		// retryablehttp decides which connection errors are recoverable
+		shouldRetry, _ := retryablehttp.DefaultRetryPolicy(ctx, nil, err)
+		return p.retryIf(resp, err, shouldRetry, "connection error")
+	}
+
+	if resp == nil || !p.retryOnStatus[resp.StatusCode] {
+		return false, nil
+	}
+
+	if isIdempotentRequest(resp.Request) {
+		return p.retryIf(resp, nil, true, "idempotent request")
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return p.retryIf(resp, nil, true, "rate limited before processing")
+	case http.StatusServiceUnavailable:
+		_, hasRetryAfter := parseRetryAfter(resp)
+		return p.retryIf(resp, nil, hasRetryAfter, "unavailable before processing")
+	}
+	return false, nil
+}
+
+// retryIf logs the retry decision for a retryable failure and returns it.
+func (p *retryPolicy) retryIf(resp *http.Response, err error, retry bool, reason string) (bool, error) {
+	if !retry {
+		return false, nil
+	}
+
+	keysAndValues := []interface{}{"reason", reason}
+	if resp != nil {
+		keysAndValues = append(keysAndValues, "status", resp.StatusCode)
+		if resp.Request != nil {
+			keysAndValues = append(keysAndValues, "method", resp.Request.Method, "url", resp.Request.URL.Redacted())
+		}
+	}
+	if err != nil {
+		keysAndValues = append(keysAndValues, "error", err.Error())
+	}
+	p.logger.Info("retrying DataRobot API request", keysAndValues...)
+	return true, nil
+}
+
+// retryBackoff implements the retryable-http Backoff type. It waits for the
+// Retry-After header of the response when the server sends one, and backs off
+// exponentially between min and max otherwise.
+func retryBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if wait, ok := parseRetryAfter(resp); ok {
+		return wait
+	}
+	return retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+}
+
+// parseRetryAfter returns the delay requested by the Retry-After header of resp,
+// given either in seconds or as an HTTP date.
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(header, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if retryTime, err := http.ParseTime(header); err == nil {
+		return max(time.Until(retryTime), 0), true
+	}
+	return 0, false
+}
+
+// isIdempotentRequest reports whether req can be repeated on any retryable status.
+// No DataRobot API endpoint accepts an idempotency key, so only GET requests can.
+func isIdempotentRequest(req *http.Request) bool {
+	if req == nil {
+		return false
+	}
+	return req.Method == http.MethodGet
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func TestRetryPolicyCheckRetry(t *testing.T) {
+	t.Parallel()
+
+	newResponse := func(method string, status int, header http.Header) *http.Response {
+		req, _ := http.NewRequest(method, "https://app.datarobot.com/api/v2/deployments/", nil)
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{StatusCode: status, Header: header, Request: req}
+	}
+
+	tests := []struct {
+		name     string
+		resp     *http.Response
+		err      error
+		expected bool
+	}{
+		{"GET 500", newResponse(http.MethodGet, http.StatusInternalServerError, nil), nil, true},
+		{"GET 404", newResponse(http.MethodGet, http.StatusNotFound, nil), nil, false},
+		{"GET 501 not in retry_on_status", newResponse(http.MethodGet, http.StatusNotImplemented, nil), nil, false},
+		{"POST 429", newResponse(http.MethodPost, http.StatusTooManyRequests, nil), nil, true},
+		{"PATCH 503 with Retry-After", newResponse(http.MethodPatch, http.StatusServiceUnavailable, http.Header{"Retry-After": {"5"}}), nil, true},
+		{"PATCH 503 without Retry-After", newResponse(http.MethodPatch, http.StatusServiceUnavailable, nil), nil, false},
+		{"POST 500", newResponse(http.MethodPost, http.StatusInternalServerError, nil), nil, false},
+		{"connection error", nil, errors.New("connection reset by peer"), true},
+	}
+
+	policy := newRetryPolicy(defaultRetryOnStatus, &leveledTFLogger{baseCtx: context.Background()})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retry, err := policy.CheckRetry(context.Background(), tt.resp, tt.err)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if retry != tt.expected {
+				t.Errorf("expected retry %t, got %t", tt.expected, retry)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyRetryOnStatus(t *testing.T) {
+	t.Parallel()
+
+	req, _ := http.NewRequest(http.MethodPost, "https://app.datarobot.com/api/v2/deployments/", nil)
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}, Request: req}
+
+	policy := newRetryPolicy([]int{http.StatusBadGateway}, &leveledTFLogger{baseCtx: context.Background()})
+	if retry, _ := policy.CheckRetry(context.Background(), resp, nil); retry {
+		t.Error("expected a 429 to not be retried when it is not in retry_on_status")
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"42"}}}
+	if wait := retryBackoff(time.Second, 30*time.Second, 0, resp); wait != 42*time.Second {
+		t.Errorf("expected the Retry-After delay of 42s, got %s", wait)
+	}
+
+	resp = &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{"Retry-After": {"3"}}}
+	if wait := retryBackoff(time.Second, 30*time.Second, 0, resp); wait != 3*time.Second {
+		t.Errorf("expected the Retry-After delay of 3s for any status, got %s", wait)
+	}
+
+	resp = &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}}
+	if wait := retryBackoff(time.Second, 30*time.Second, 2, resp); wait != 4*time.Second {
+		t.Errorf("expected an exponential backoff of 4s, got %s", wait)
+	}
+}
+
+func TestRetryPolicyRetriesRateLimitedPost(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	httpClient := retryablehttp.NewClient()
+	logger := &leveledTFLogger{baseCtx: context.Background()}
+	httpClient.Logger = logger
+	httpClient.CheckRetry = newRetryPolicy(defaultRetryOnStatus, logger).CheckRetry
+	httpClient.Backoff = retryBackoff
+
+	resp, err := httpClient.StandardClient().Post(server.URL, "application/json", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated || attempts.Load() != 2 {
+		t.Errorf("expected the POST to succeed on the second attempt, got status %d after %d attempts", resp.StatusCode, attempts.Load())
+	}
+}
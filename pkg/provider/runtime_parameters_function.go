@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &RuntimeParametersFunction{}
+
+func NewRuntimeParametersFunction() function.Function {
+	return &RuntimeParametersFunction{}
+}
+
+// RuntimeParametersFunction builds a runtime_parameter_values list from a map.
+type RuntimeParametersFunction struct{}
+
+func (f *RuntimeParametersFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "runtime_parameters"
+}
+
+func (f *RuntimeParametersFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a runtime_parameter_values list from a map",
+		MarkdownDescription: "Builds the `runtime_parameter_values` list of custom models, custom jobs, applications and deployments from a map " +
+			"of runtime parameter names to values, sorted by name. The type of each parameter is inferred from its value: " +
+			"a bool is `boolean`, a number is `numeric` and a string is `string`. For `credential` and `deployment` parameters, " +
+			"or to override the inferred type, use an object with `type` and `value` attributes, e.g. " +
+			"`{ OPENAI_API_KEY = { type = \"credential\", value = datarobot_api_token_credential.openai.id } }`.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "values",
+				MarkdownDescription: "A map or object of runtime parameter names to values.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"key":   types.StringType,
+					"type":  types.StringType,
+					"value": types.StringType,
+				},
+			},
+		},
+	}
+}
+
+func (f *RuntimeParametersFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var values types.Dynamic
+
+	resp.Error = req.Arguments.Get(ctx, &values)
+	if resp.Error != nil {
+		return
+	}
+
+	var elements map[string]attr.Value
+	switch v := values.UnderlyingValue().(type) {
+	case basetypes.ObjectValue:
+		elements = v.Attributes()
+	case basetypes.MapValue:
+		elements = v.Elements()
+	default:
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Expected a map or object of runtime parameter values, got %s", values.UnderlyingValue().Type(ctx)))
+		return
+	}
+
+	keys := make([]string, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parameters := make([]RuntimeParameterValue, 0, len(keys))
+	for _, key := range keys {
+		paramType, paramValue, err := runtimeParameterTypeAndValue(elements[key])
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid value for runtime parameter %q: %s", key, err))
+			return
+		}
+		parameters = append(parameters, RuntimeParameterValue{
+			Key:   types.StringValue(key),
+			Type:  types.StringValue(paramType),
+			Value: types.StringValue(paramValue),
+		})
+	}
+
+	resp.Error = resp.Result.Set(ctx, parameters)
+}
+
+// runtimeParameterTypeAndValue returns the runtime parameter type and string value of a single map element.
+func runtimeParameterTypeAndValue(value attr.Value) (paramType, paramValue string, err error) {
+	if dynamicValue, ok := value.(basetypes.DynamicValue); ok {
+		value = dynamicValue.UnderlyingValue()
+	}
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		err = fmt.Errorf("value must be known and not null")
+		return
+	}
+
+	var attributes map[string]attr.Value
+	switch v := value.(type) {
+	case basetypes.StringValue:
+		return "string", v.ValueString(), nil
+	case basetypes.BoolValue:
+		return "boolean", strconv.FormatBool(v.ValueBool()), nil
+	case basetypes.NumberValue:
+		number, _ := v.ValueBigFloat().Float64()
+		// matches how the resources read numeric values back from the API
+		return "numeric", fmt.Sprintf("%v", number), nil
+	case basetypes.ObjectValue:
+		attributes = v.Attributes()
+	case basetypes.MapValue:
+		attributes = v.Elements()
+	default:
+		err = fmt.Errorf("unsupported value type %s", value.Type(context.Background()))
+		return
+	}
+
+	typeValue, hasType := attributes["type"]
+	valueValue, hasValue := attributes["value"]
+	if !hasType || !hasValue || len(attributes) != 2 {
+		err = fmt.Errorf("an object value must have exactly the attributes \"type\" and \"value\"")
+		return
+	}
+
+	var inferredType string
+	if inferredType, paramValue, err = runtimeParameterTypeAndValue(valueValue); err != nil {
+		return
+	}
+	if inferredType != "string" && inferredType != "boolean" && inferredType != "numeric" {
+		err = fmt.Errorf("\"value\" must be a string, number or bool")
+		return
+	}
+
+	typeString, ok := typeValue.(basetypes.StringValue)
+	if !ok || !IsKnown(typeString) {
+		err = fmt.Errorf("\"type\" must be a known string")
+		return
+	}
+	paramType = typeString.ValueString()
+	if !slices.Contains(runtimeParameterTypes, paramType) {
+		err = fmt.Errorf("\"type\" must be one of %v, got %q", runtimeParameterTypes, paramType)
+	}
+	return
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRuntimeParametersFunction(t *testing.T) {
+	t.Parallel()
+
+	credential := types.ObjectValueMust(
+		map[string]attr.Type{"type": types.StringType, "value": types.StringType},
+		map[string]attr.Value{"type": types.StringValue("credential"), "value": types.StringValue("credential-id")},
+	)
+	values := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"TEMPERATURE":    types.NumberType,
+			"STREAMING":      types.BoolType,
+			"MODEL":          types.StringType,
+			"OPENAI_API_KEY": credential.Type(t.Context()),
+		},
+		map[string]attr.Value{
+			"TEMPERATURE":    types.NumberValue(big.NewFloat(0.5)),
+			"STREAMING":      types.BoolValue(true),
+			"MODEL":          types.StringValue("gpt-4o"),
+			"OPENAI_API_KEY": credential,
+		},
+	))
+
+	result, funcErr := runFunction(t, NewRuntimeParametersFunction(), values)
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+
+	var parameters []RuntimeParameterValue
+	if diags := result.(types.List).ElementsAs(t.Context(), &parameters, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+
+	expected := [][3]string{
+		{"MODEL", "string", "gpt-4o"},
+		{"OPENAI_API_KEY", "credential", "credential-id"},
+		{"STREAMING", "boolean", "true"},
+		{"TEMPERATURE", "numeric", "0.5"},
+	}
+	if len(parameters) != len(expected) {
+		t.Fatalf("expected %d parameters, got %d", len(expected), len(parameters))
+	}
+	for i, parameter := range parameters {
+		actual := [3]string{parameter.Key.ValueString(), parameter.Type.ValueString(), parameter.Value.ValueString()}
+		if actual != expected[i] {
+			t.Errorf("expected %v, got %v", expected[i], actual)
+		}
+	}
+}
+
+func TestRuntimeParametersFunctionInvalid(t *testing.T) {
+	t.Parallel()
+
+	invalidType := types.DynamicValue(types.MapValueMust(
+		types.ObjectType{AttrTypes: map[string]attr.Type{"type": types.StringType, "value": types.StringType}},
+		map[string]attr.Value{
+			"PARAM": types.ObjectValueMust(
+				map[string]attr.Type{"type": types.StringType, "value": types.StringType},
+				map[string]attr.Value{"type": types.StringValue("secret"), "value": types.StringValue("x")},
+			),
+		},
+	))
+	if _, funcErr := runFunction(t, NewRuntimeParametersFunction(), invalidType); funcErr == nil {
+		t.Error("expected an error for an unknown runtime parameter type")
+	}
+
+	if _, funcErr := runFunction(t, NewRuntimeParametersFunction(), types.DynamicValue(types.StringValue("x"))); funcErr == nil {
+		t.Error("expected an error for a value that is not a map or object")
+	}
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// resourceTimeoutsBlock returns the standard `timeouts { create, read, update, delete }`
+// block shared by every resource. Values bound the provider's waits for long-running
+// DataRobot operations; unset values fall back to DATAROBOT_TIMEOUT_MINUTES.
+func resourceTimeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// withCreateTimeout threads the configured create timeout into ctx for the waiters.
+// An unset timeout leaves ctx unchanged so the waiters keep their env defaults.
+func withCreateTimeout(ctx context.Context, t timeouts.Value, diags *diag.Diagnostics) context.Context {
+	timeout, timeoutDiags := t.Create(ctx, 0)
+	diags.Append(timeoutDiags...)
+	return client.WithOperationTimeout(ctx, timeout)
+}
+
+// withReadTimeout threads the configured read timeout into ctx for the waiters.
+// An unset timeout leaves ctx unchanged so the waiters keep their env defaults.
+func withReadTimeout(ctx context.Context, t timeouts.Value, diags *diag.Diagnostics) context.Context {
+	timeout, timeoutDiags := t.Read(ctx, 0)
+	diags.Append(timeoutDiags...)
+	return client.WithOperationTimeout(ctx, timeout)
+}
+
+// withUpdateTimeout threads the configured update timeout into ctx for the waiters.
+// An unset timeout leaves ctx unchanged so the waiters keep their env defaults.
+func withUpdateTimeout(ctx context.Context, t timeouts.Value, diags *diag.Diagnostics) context.Context {
+	timeout, timeoutDiags := t.Update(ctx, 0)
+	diags.Append(timeoutDiags...)
+	return client.WithOperationTimeout(ctx, timeout)
+}
+
+// withDeleteTimeout threads the configured delete timeout into ctx for the waiters.
+// An unset timeout leaves ctx unchanged so the waiters keep their env defaults.
+func withDeleteTimeout(ctx context.Context, t timeouts.Value, diags *diag.Diagnostics) context.Context {
+	timeout, timeoutDiags := t.Delete(ctx, 0)
+	diags.Append(timeoutDiags...)
+	return client.WithOperationTimeout(ctx, timeout)
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testTimeoutsAttrTypes = map[string]attr.Type{
+	"create": types.StringType,
+	"read":   types.StringType,
+	"update": types.StringType,
+	"delete": types.StringType,
+}
+
+// testNullTimeouts is the value Terraform sends when the timeouts block is omitted.
+func testNullTimeouts() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(testTimeoutsAttrTypes)}
+}
+
+// fillTestTimeouts replaces a zero-value timeouts block (which the framework
+// cannot convert) with a null one, so hand-built models can be written to a
+// plan or state.
+func fillTestTimeouts(t *timeouts.Value) {
+	if len(t.AttributeTypes(context.Background())) == 0 {
+		*t = testNullTimeouts()
+	}
+}
+
+func testTimeouts(t *testing.T, values map[string]string) timeouts.Value {
+	t.Helper()
+
+	attrs := make(map[string]attr.Value, len(testTimeoutsAttrTypes))
+	for name := range testTimeoutsAttrTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = types.StringValue(v)
+		} else {
+			attrs[name] = types.StringNull()
+		}
+	}
+	obj, diags := types.ObjectValue(testTimeoutsAttrTypes, attrs)
+	if diags.HasError() {
+		t.Fatalf("building timeouts: %v", diags)
+	}
+	return timeouts.Value{Object: obj}
+}
+
+func TestWithOperationTimeouts(t *testing.T) {
+	ctx := context.Background()
+	value := testTimeouts(t, map[string]string{"create": "90m", "delete": "30s"})
+
+	tests := []struct {
+		name string
+		with func(context.Context, timeouts.Value, *diag.Diagnostics) context.Context
+		want time.Duration
+		set  bool
+	}{
+		{name: "create", with: withCreateTimeout, want: 90 * time.Minute, set: true},
+		{name: "read unset", with: withReadTimeout},
+		{name: "update unset", with: withUpdateTimeout},
+		{name: "delete", with: withDeleteTimeout, want: 30 * time.Second, set: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got, ok := client.OperationTimeout(tt.with(ctx, value, &diags))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if ok != tt.set || got != tt.want {
+				t.Fatalf("OperationTimeout = (%s, %v), want (%s, %v)", got, ok, tt.want, tt.set)
+			}
+		})
+	}
+
+	t.Run("null block", func(t *testing.T) {
+		var diags diag.Diagnostics
+		if _, ok := client.OperationTimeout(withCreateTimeout(ctx, testNullTimeouts(), &diags)); ok {
+			t.Fatal("expected no timeout for a null block")
+		}
+	})
+}
+
+func TestGetExponentialBackoffMaxElapsedTime(t *testing.T) {
+	t.Run("context timeout wins over env var", func(t *testing.T) {
+		t.Setenv(TimeoutMinutesEnvVar, "5")
+		ctx := client.WithOperationTimeout(context.Background(), 90*time.Minute)
+
+		b := getExponentialBackoff(ctx).(*backoff.ExponentialBackOff)
+		if b.MaxElapsedTime != 90*time.Minute {
+			t.Fatalf("MaxElapsedTime = %s, want 90m", b.MaxElapsedTime)
+		}
+	})
+
+	t.Run("falls back to env var", func(t *testing.T) {
+		t.Setenv(TimeoutMinutesEnvVar, "5")
+
+		b := getExponentialBackoff(context.Background()).(*backoff.ExponentialBackOff)
+		if b.MaxElapsedTime != 5*time.Minute {
+			t.Fatalf("MaxElapsedTime = %s, want 5m", b.MaxElapsedTime)
+		}
+	})
+
+	t.Run("falls back to default", func(t *testing.T) {
+		t.Setenv(TimeoutMinutesEnvVar, "")
+
+		b := getExponentialBackoff(context.Background()).(*backoff.ExponentialBackOff)
+		if b.MaxElapsedTime != defaultTimeoutMinutes*time.Minute {
+			t.Fatalf("MaxElapsedTime = %s, want %dm", b.MaxElapsedTime, defaultTimeoutMinutes)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// configureTransport applies the proxy and TLS settings of the provider configuration to
+// transport. The transport is shared by the DataRobot API, API gateway and Files API clients.
+func configureTransport(transport *http.Transport, data ProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if IsKnown(data.ProxyURL) {
+		proxyURL, err := url.Parse(data.ProxyURL.ValueString())
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			diags.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid proxy URL",
+				fmt.Sprintf("proxy_url must be an absolute URL such as http://proxy.example.com:3128, got %q", data.ProxyURL.ValueString()))
+			return diags
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if transport.TLSClientConfig != nil {
+		tlsConfig = transport.TLSClientConfig.Clone()
+	}
+
+	var caCertPEM []byte
+	caCertPath := path.Root("ca_cert_pem")
+	if IsKnown(data.CACertFile) {
+		caCertPath = path.Root("ca_cert_file")
+		var err error
+		if caCertPEM, err = os.ReadFile(data.CACertFile.ValueString()); err != nil {
+			diags.AddAttributeError(caCertPath, "Unable to read CA certificate file", err.Error())
+			return diags
+		}
+	} else if IsKnown(data.CACertPEM) {
+		caCertPEM = []byte(data.CACertPEM.ValueString())
+	}
+	if caCertPEM != nil {
+		// the CA bundle is trusted in addition to the system certificates
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caCertPEM) {
+			diags.AddAttributeError(caCertPath, "Invalid CA certificate", "No PEM encoded certificate was found.")
+			return diags
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if IsKnown(data.ClientCert) && IsKnown(data.ClientKey) {
+		certificate, err := tls.X509KeyPair([]byte(data.ClientCert.ValueString()), []byte(data.ClientKey.ValueString()))
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_cert"), "Invalid client certificate", err.Error())
+			return diags
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if data.InsecureSkipVerify.ValueBool() {
+		diags.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS certificate verification is disabled",
+			"The provider does not verify the certificate of the DataRobot API, so the API key and all requests can be intercepted. "+
+				"Use ca_cert_file or ca_cert_pem to trust an internal CA instead.")
+		tlsConfig.InsecureSkipVerify = true //nolint:gosec // explicitly requested by the provider configuration
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return diags
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func newTLSTestServer(t *testing.T, clientAuth tls.ClientAuthType) (*httptest.Server, string) {
+	t.Helper()
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: clientAuth, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	return server, string(caCertPEM)
+}
+
+func newTestClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func newTestProviderModel() ProviderModel {
+	return ProviderModel{
+		MaxRetries:         types.Int64Value(0),
+		RetryOnStatus:      types.ListNull(types.Int64Type),
+		CACertFile:         types.StringNull(),
+		CACertPEM:          types.StringNull(),
+		ClientCert:         types.StringNull(),
+		ClientKey:          types.StringNull(),
+		ProxyURL:           types.StringNull(),
+		InsecureSkipVerify: types.BoolNull(),
+		RequestTimeout:     types.StringNull(),
+	}
+}
+
+func TestNewHTTPClientCACert(t *testing.T) {
+	t.Parallel()
+
+	server, caCertPEM := newTLSTestServer(t, tls.NoClientCert)
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caCertFile, []byte(caCertPEM), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	untrusted, diags := newHTTPClient(context.Background(), newTestProviderModel())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	if _, err := untrusted.Get(server.URL); err == nil {
+		t.Error("expected the server certificate to be untrusted")
+	}
+
+	for name, configure := range map[string]func(*ProviderModel){
+		"ca_cert_pem":  func(data *ProviderModel) { data.CACertPEM = types.StringValue(caCertPEM) },
+		"ca_cert_file": func(data *ProviderModel) { data.CACertFile = types.StringValue(caCertFile) },
+	} {
+		data := newTestProviderModel()
+		configure(&data)
+		httpClient, diags := newHTTPClient(context.Background(), data)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %+v", name, diags)
+		}
+		resp, err := httpClient.Get(server.URL)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		_ = resp.Body.Close()
+	}
+}
+
+func TestNewHTTPClientClientCert(t *testing.T) {
+	t.Parallel()
+
+	server, caCertPEM := newTLSTestServer(t, tls.RequireAnyClientCert)
+	clientCert, clientKey := newTestClientCertificate(t)
+
+	data := newTestProviderModel()
+	data.CACertPEM = types.StringValue(caCertPEM)
+	withoutCert, _ := newHTTPClient(context.Background(), data)
+	if _, err := withoutCert.Get(server.URL); err == nil {
+		t.Error("expected the server to require a client certificate")
+	}
+
+	data.ClientCert = types.StringValue(clientCert)
+	data.ClientKey = types.StringValue(clientKey)
+	httpClient, diags := newHTTPClient(context.Background(), data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	resp, err := httpClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	data.ClientKey = types.StringValue("not a key")
+	if _, diags = newHTTPClient(context.Background(), data); !diags.HasError() {
+		t.Error("expected an invalid client key to be rejected")
+	}
+}
+
+func TestNewHTTPClientInsecureSkipVerify(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newTLSTestServer(t, tls.NoClientCert)
+
+	data := newTestProviderModel()
+	data.InsecureSkipVerify = types.BoolValue(true)
+	httpClient, diags := newHTTPClient(context.Background(), data)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %+v", diags)
+	}
+	resp, err := httpClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+}
+
+func TestConfigureTransportProxy(t *testing.T) {
+	t.Parallel()
+
+	data := newTestProviderModel()
+	data.ProxyURL = types.StringValue("http://proxy.example.com:3128")
+	transport := &http.Transport{}
+	if diags := configureTransport(transport, data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://app.datarobot.com/api/v2/version/", nil)
+	proxyURL, err := transport.Proxy(req)
+	if err != nil || proxyURL.String() != "http://proxy.example.com:3128" {
+		t.Errorf("unexpected proxy %v: %v", proxyURL, err)
+	}
+
+	data.ProxyURL = types.StringValue("proxy.example.com")
+	if diags := configureTransport(&http.Transport{}, data); !diags.HasError() {
+		t.Error("expected a proxy URL without scheme to be rejected")
+	}
+}
+
+func TestNewHTTPClientRequestTimeout(t *testing.T) {
+	t.Parallel()
+
+	data := newTestProviderModel()
+	data.RequestTimeout = types.StringValue("2m")
+	httpClient, diags := newHTTPClient(context.Background(), data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	if httpClient.Timeout != 2*time.Minute {
+		t.Errorf("expected timeout 2m, got %s", httpClient.Timeout)
+	}
+}
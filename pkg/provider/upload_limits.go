@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxUploadConcurrency caps upload_concurrency, so that a typo cannot open thousands
+// of connections to the Files API.
+const maxUploadConcurrency = 64
+
+// resolveUploadLimits returns the upload tunables configured by the provider attributes,
+// then by their environment variables. Settings that are set by neither are left zero,
+// so that the defaults of artifactsource apply.
+func resolveUploadLimits(data ProviderModel) (artifactsource.Limits, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var limits artifactsource.Limits
+
+	concurrency, err := resolveInt64Setting(data.UploadConcurrency, UploadConcurrencyEnvVar, 1, maxUploadConcurrency)
+	if err != nil {
+		diags.AddError("Invalid upload concurrency", err.Error())
+	}
+	limits.UploadConcurrency = int(concurrency)
+
+	maxFiles, err := resolveInt64Setting(data.StageUploadMaxFiles, StageUploadMaxFilesEnvVar, 1, 0)
+	if err != nil {
+		diags.AddError("Invalid stage upload file threshold", err.Error())
+	}
+	limits.StageVsZipFileThreshold = int(maxFiles)
+
+	limits.StageVsZipBytesThreshold, err = resolveInt64Setting(data.StageUploadMaxBytes, StageUploadMaxBytesEnvVar, 1, 0)
+	if err != nil {
+		diags.AddError("Invalid stage upload byte threshold", err.Error())
+	}
+
+	// the attribute was already validated by durationStringValidator
+	if IsKnown(data.ZipUploadTimeout) {
+		limits.ZipPollTimeout, _ = time.ParseDuration(data.ZipUploadTimeout.ValueString())
+	} else if value := os.Getenv(ZipUploadTimeoutEnvVar); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err == nil && timeout <= 0 {
+			err = fmt.Errorf("duration must be positive, got %q", value)
+		}
+		if err != nil {
+			diags.AddError("Invalid zip upload timeout", fmt.Sprintf("%s: %s", ZipUploadTimeoutEnvVar, err))
+		}
+		limits.ZipPollTimeout = timeout
+	}
+
+	return limits, diags
+}
+
+// resolveInt64Setting returns the value of attribute, or else of envVar, or 0 when neither
+// is set. Environment values must be at least minValue and, when maxValue is positive, at
+// most maxValue; attribute values were already validated by the schema.
+func resolveInt64Setting(attribute types.Int64, envVar string, minValue, maxValue int64) (int64, error) {
+	if IsKnown(attribute) {
+		return attribute.ValueInt64(), nil
+	}
+	value := os.Getenv(envVar)
+	if value == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %q is not an integer", envVar, value)
+	}
+	if n < minValue || (maxValue > 0 && n > maxValue) {
+		if maxValue > 0 {
+			return 0, fmt.Errorf("%s: must be between %d and %d, got %d", envVar, minValue, maxValue, n)
+		}
+		return 0, fmt.Errorf("%s: must be at least %d, got %d", envVar, minValue, n)
+	}
+	return n, nil
+}
+
+// artifactUploadLimits are the upload tunables of the provider, zero when the provider
+// is not configured yet.
+func (p *Provider) artifactUploadLimits() artifactsource.Limits {
+	if p == nil {
+		return artifactsource.Limits{}
+	}
+	return p.uploadLimits
+}
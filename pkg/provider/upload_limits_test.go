@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolveUploadLimits(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		limits, diags := resolveUploadLimits(ProviderModel{})
+		if diags.HasError() || limits != (artifactsource.Limits{}) {
+			t.Fatalf("limits = %+v, diags = %v; want zero limits", limits, diags)
+		}
+	})
+
+	t.Run("environment variables", func(t *testing.T) {
+		t.Setenv(UploadConcurrencyEnvVar, "32")
+		t.Setenv(StageUploadMaxFilesEnvVar, "100")
+		t.Setenv(StageUploadMaxBytesEnvVar, "1048576")
+		t.Setenv(ZipUploadTimeoutEnvVar, "30m")
+
+		limits, diags := resolveUploadLimits(ProviderModel{})
+		want := artifactsource.Limits{
+			UploadConcurrency:        32,
+			StageVsZipFileThreshold:  100,
+			StageVsZipBytesThreshold: 1048576,
+			ZipPollTimeout:           30 * time.Minute,
+		}
+		if diags.HasError() || limits != want {
+			t.Fatalf("limits = %+v, diags = %v; want %+v", limits, diags, want)
+		}
+	})
+
+	t.Run("attributes override environment variables", func(t *testing.T) {
+		t.Setenv(UploadConcurrencyEnvVar, "32")
+		t.Setenv(ZipUploadTimeoutEnvVar, "30m")
+
+		limits, diags := resolveUploadLimits(ProviderModel{
+			UploadConcurrency: types.Int64Value(2),
+			ZipUploadTimeout:  types.StringValue("1h"),
+		})
+		if diags.HasError() || limits.UploadConcurrency != 2 || limits.ZipPollTimeout != time.Hour {
+			t.Fatalf("limits = %+v, diags = %v; want the attribute values", limits, diags)
+		}
+	})
+
+	t.Run("invalid environment variables", func(t *testing.T) {
+		t.Setenv(UploadConcurrencyEnvVar, "1000")
+		t.Setenv(StageUploadMaxFilesEnvVar, "many")
+		t.Setenv(StageUploadMaxBytesEnvVar, "0")
+		t.Setenv(ZipUploadTimeoutEnvVar, "-1m")
+
+		_, diags := resolveUploadLimits(ProviderModel{})
+		if diags.ErrorsCount() != 4 {
+			t.Fatalf("diags = %v, want 4 errors", diags)
+		}
+	})
+}
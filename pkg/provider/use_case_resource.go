@@ -53,6 +53,9 @@ func (r *UseCaseResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -79,13 +82,15 @@ func (r *UseCaseResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateUseCase")
 	createResp, err := r.provider.service.CreateUseCase(ctx, &client.UseCaseRequest{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Use Case", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Use Case", err)
 		return
 	}
 	data.ID = types.StringValue(createResp.ID)
@@ -101,6 +106,8 @@ func (r *UseCaseResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -136,6 +143,8 @@ func (r *UseCaseResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("UpdateUseCase")
 	_, err := r.provider.service.UpdateUseCase(ctx,
 		data.ID.ValueString(),
@@ -169,6 +178,8 @@ func (r *UseCaseResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteUseCase")
 	err := r.provider.service.DeleteUseCase(ctx, data.ID.ValueString())
 	if err != nil {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -53,6 +55,18 @@ func NoOpUpdate(ctx context.Context, req resource.UpdateRequest, resp *resource.
 		traceAPICall(traceMsg)
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, reflect.ValueOf(statePtr).Elem().Interface())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// timeouts is the only attribute a user can change without replacement, so
+	// carry the planned value over instead of echoing the prior one.
+	var planTimeouts timeouts.Value
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &planTimeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), planTimeouts)...)
 }
 
 // NoOpDelete loads state from req and logs a trace message. It does not set resp state (Terraform clears it after Delete).
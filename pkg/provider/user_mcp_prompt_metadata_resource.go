@@ -85,6 +85,9 @@ func (r *UserMCPPromptMetadataResource) Schema(ctx context.Context, req resource
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -110,6 +113,8 @@ func (r *UserMCPPromptMetadataResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateUserMCPPromptMetadata")
 	request_payload := &client.UserMCPPromptMetadataRequest{
 		Name: data.Name.ValueString(),
@@ -120,7 +125,7 @@ func (r *UserMCPPromptMetadataResource) Create(ctx context.Context, req resource
 	createResp, err := r.provider.service.CreateUserMCPPromptMetadata(ctx, mcp_server_version_id, request_payload)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating User MCP prompt metadata", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating User MCP prompt metadata", err)
 		return
 	}
 	data.ID = types.StringValue(createResp.ID)
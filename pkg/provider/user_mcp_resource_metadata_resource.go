@@ -92,6 +92,9 @@ func (r *UserMCPResourceMetadataResource) Schema(ctx context.Context, req resour
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -117,6 +120,8 @@ func (r *UserMCPResourceMetadataResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateUserMCPResourceMetadata")
 	request_payload := &client.UserMCPResourceMetadataRequest{
 		Name: data.Name.ValueString(),
@@ -128,7 +133,7 @@ func (r *UserMCPResourceMetadataResource) Create(ctx context.Context, req resour
 	createResp, err := r.provider.service.CreateUserMCPResourceMetadata(ctx, mcp_server_version_id, request_payload)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating User MCP resource metadata", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating User MCP resource metadata", err)
 		return
 	}
 	data.ID = types.StringValue(createResp.ID)
@@ -85,6 +85,9 @@ func (r *UserMCPToolMetadataResource) Schema(ctx context.Context, req resource.S
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -110,6 +113,8 @@ func (r *UserMCPToolMetadataResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateUserMCPToolMetadata")
 	request_payload := &client.UserMCPToolMetadataRequest{
 		Name: data.Name.ValueString(),
@@ -120,7 +125,7 @@ func (r *UserMCPToolMetadataResource) Create(ctx context.Context, req resource.C
 	createResp, err := r.provider.service.CreateUserMCPToolMetadata(ctx, mcp_server_version_id, request_payload)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating User MCP tool metadata", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating User MCP tool metadata", err)
 		return
 	}
 	data.ID = types.StringValue(createResp.ID)
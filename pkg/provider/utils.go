@@ -9,7 +9,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -20,10 +19,12 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/artifactsource"
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -46,19 +47,6 @@ func IsKnown[T Knowable](t T) bool {
 	return !t.IsUnknown() && !t.IsNull()
 }
 
-// retryGetRequests implements the retryable-http CheckRetry type.
-func retryGetRequestsOnly(ctx context.Context, resp *http.Response, err error) (bool, error) {
-	if resp != nil && resp.Request != nil && resp.Request.Method != http.MethodGet {
-		// We don't want to blindly retry anything that isn't a GET method
-		// because it's possible that a different method type mutated data on
-		// the server even if the response wasn't successful. Application code
-		// should handle any retries where appropriate.
-		return false, nil
-	}
-
-	return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
-}
-
 // leveledTFLogger implements the retryablehttp.LeveledLogger interface by adapting tflog methods.
 type leveledTFLogger struct {
 	baseCtx context.Context
@@ -149,11 +137,19 @@ func setStringValueIfKnown(target *string, source basetypes.StringValue) {
 	}
 }
 
-func getExponentialBackoff() backoff.BackOff {
+// getExponentialBackoff returns the backoff used by long-running waiters. The
+// elapsed-time budget comes from the resource's timeouts block when one was
+// threaded into ctx, and from DATAROBOT_TIMEOUT_MINUTES otherwise.
+func getExponentialBackoff(ctx context.Context) backoff.BackOff {
 	expBackoff := backoff.NewExponentialBackOff()
 	expBackoff.InitialInterval = 1 * time.Second
 	expBackoff.MaxInterval = 10 * time.Second
 
+	if timeout, ok := client.OperationTimeout(ctx); ok {
+		expBackoff.MaxElapsedTime = timeout
+		return expBackoff
+	}
+
 	timeout, err := strconv.Atoi(os.Getenv(TimeoutMinutesEnvVar))
 	if err != nil || timeout <= 0 {
 		timeout = defaultTimeoutMinutes
@@ -184,7 +180,7 @@ func waitForModelReplacementTaskToComplete(ctx context.Context, s client.Service
 }
 
 func waitForTaskStatusToCompleteGeneric(ctx context.Context, s client.Service, id string, isGenAI bool, proceedOnStuckInitialized bool) error {
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	startTime := time.Now()
 
@@ -200,7 +196,7 @@ func waitForTaskStatusToCompleteGeneric(ctx context.Context, s client.Service, i
 		}
 
 		if err != nil {
-			if err.Error() == "request was redirected" { // the task was completed, so the request got redirected
+			if errors.Is(err, &client.RedirectedError{}) { // the task was completed, so the request got redirected
 				return nil
 			}
 			return backoff.Permanent(err)
@@ -243,7 +239,7 @@ func waitForTaskStatusToCompleteGeneric(ctx context.Context, s client.Service, i
 }
 
 func waitForDatasetToBeReady(ctx context.Context, service client.Service, datasetId string) (*client.Dataset, error) {
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	operation := func() error {
 		traceAPICall("GetDataset")
@@ -272,7 +268,7 @@ func waitForDatasetToBeReady(ctx context.Context, service client.Service, datase
 }
 
 func waitForApplicationToBeReady(ctx context.Context, service client.Service, id string) (*client.Application, error) {
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	operation := func() error {
 		traceAPICall("GetCustomApplication")
@@ -302,7 +298,7 @@ func waitForApplicationToBeReady(ctx context.Context, service client.Service, id
 }
 
 func waitForRegisteredModelVersionToBeReady(ctx context.Context, service client.Service, registeredModelId string, versionId string) error {
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	operation := func() error {
 		ready, err := service.IsRegisteredModelVersionReady(ctx, registeredModelId, versionId)
@@ -323,26 +319,14 @@ func waitForRegisteredModelVersionToBeReady(ctx context.Context, service client.
 	return nil
 }
 
-func checkCredentialNameAlreadyExists(err error, name string) string {
-	return checkNameAlreadyExists(err, name, "Credential")
-}
-
-func checkApplicationNameAlreadyExists(err error, name string) string {
-	return checkNameAlreadyExists(err, name, "Application")
-}
-
-func checkNameAlreadyExists(err error, name string, resourceType string) string {
-	errMessage := err.Error()
-	if strings.Contains(errMessage, "already in use") ||
-		strings.Contains(errMessage, "already exist") ||
-		strings.Contains(errMessage, "is already used") {
-		errMessage = fmt.Sprintf("%s name must be unique, and name '%s' is already in use", resourceType, name)
+func isNewRuntimeParametersAttrNotSupportedError(err error) bool {
+	var validationErr *client.ValidationError
+	if errors.As(err, &validationErr) {
+		if strings.Contains(validationErr.FieldErrors["runtimeParameters"], "not allowed key") {
+			return true
+		}
 	}
 
-	return errMessage
-}
-
-func isNewRuntimeParametersAttrNotSupportedError(err error) bool {
 	msg := err.Error()
 	return strings.Contains(msg, "runtimeParameters is not allowed key") ||
 		strings.Contains(msg, "requires the RUNTIME_PARAMETERS_IMPROVEMENTS feature to be enabled")
@@ -731,46 +715,19 @@ func listValueFromRuntimParameters(ctx context.Context, runtimeParameterValues [
 func prepareLocalFiles(folderPath types.String, files types.Dynamic) (localFiles []client.FileInfo, err error) {
 	localFiles = make([]client.FileInfo, 0)
 
-	if IsKnown(folderPath) {
-		folder := folderPath.ValueString()
-		if err = WalkSymlinkSafe(folder, func(path string, info os.FileInfo, innerErr error) error {
-			if innerErr != nil {
-				return innerErr
-			}
-			if info.IsDir() {
-				return nil
-			}
-
-			pathInModel := strings.TrimPrefix(path, folder)
-			pathInModel = strings.TrimPrefix(pathInModel, string(filepath.Separator))
-			fileInfo, innerErr := getFileInfo(path, pathInModel)
-			if innerErr != nil {
-				return innerErr
-			}
-			localFiles = append(localFiles, fileInfo)
-
-			return nil
-		}); err != nil {
-			return
-		}
+	sources, err := localFileSources(folderPath, files)
+	if err != nil {
+		return
 	}
 
-	if IsKnown(files) && files.UnderlyingValue() != nil && IsKnown(files.UnderlyingValue()) {
-		var fileTuples []FileTuple
-		fileTuples, err = formatFiles(files)
+	for _, source := range sources {
+		var fileInfo client.FileInfo
+		fileInfo, err = getFileInfo(source.LocalPath, source.PathInModel)
 		if err != nil {
 			return
 		}
 
-		for _, file := range fileTuples {
-			var fileInfo client.FileInfo
-			fileInfo, err = getFileInfo(file.LocalPath, file.PathInModel)
-			if err != nil {
-				return
-			}
-
-			localFiles = append(localFiles, fileInfo)
-		}
+		localFiles = append(localFiles, fileInfo)
 	}
 
 	return
@@ -874,7 +831,14 @@ func getFileInfo(localPath, pathInModel string) (fileInfo client.FileInfo, err e
 	return
 }
 
-func computeFolderHash(folderPath types.String) (hash types.String, err error) {
+func computeFolderHash(ctx context.Context, folderPath types.String, cache *artifactsource.HashCache) (hash types.String, err error) {
+	return computeFolderHashIgnoring(ctx, folderPath, nil, cache)
+}
+
+// computeFolderHashIgnoring is computeFolderHash over the files not excluded by ignore.
+// A nil ignore hashes every file, so the result matches computeFolderHash.
+// The hashes of unchanged files are read from cache, which may be nil.
+func computeFolderHashIgnoring(ctx context.Context, folderPath types.String, ignore artifactsource.IgnoreFunc, cache *artifactsource.HashCache) (hash types.String, err error) {
 	hash = types.StringNull()
 	if IsKnown(folderPath) {
 		hashValue := ""
@@ -884,6 +848,16 @@ func computeFolderHash(folderPath types.String) (hash types.String, err error) {
 			if innerErr != nil {
 				return innerErr
 			}
+			if ignore != nil {
+				if rel, relErr := filepath.Rel(folder, path); relErr == nil && rel != "." {
+					if ignore(filepath.ToSlash(rel), info.IsDir()) {
+						if info.IsDir() {
+							return filepath.SkipDir
+						}
+						return nil
+					}
+				}
+			}
 			if info.IsDir() {
 				return nil
 			}
@@ -896,13 +870,12 @@ func computeFolderHash(folderPath types.String) (hash types.String, err error) {
 		// sort files to ensure consistent hash
 		sort.Strings(filesInFolder)
 
-		for _, file := range filesInFolder {
-			var fileHash string
-			if fileHash, err = computeFileHash(file); err != nil {
-				return
-			}
-			hashValue += fileHash
+		var fileHashes []string
+		if fileHashes, err = cache.HashFiles(filesInFolder); err != nil {
+			return
 		}
+		saveHashCache(ctx, cache)
+		hashValue = strings.Join(fileHashes, "")
 
 		// calculate hash of all file hashes
 		hash = types.StringValue(computeHash([]byte(hashValue)))
@@ -1585,3 +1558,30 @@ func scopeLevelToTerraformString(scopeLevel client.ScopeLevel) types.String {
 	}
 	return types.StringValue(string(scopeLevel))
 }
+
+type durationStringValidator struct{}
+
+var _ validator.String = durationStringValidator{}
+
+func (durationStringValidator) Description(_ context.Context) string {
+	return "Validates a positive duration string (e.g. \"30s\", \"15m\", \"2h\")."
+}
+
+func (durationStringValidator) MarkdownDescription(_ context.Context) string {
+	return "Validates a positive duration string (e.g. `\"30s\"`, `\"15m\"`, `\"2h\"`)."
+}
+
+func (durationStringValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", err.Error())
+		return
+	}
+	if d <= 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration",
+			fmt.Sprintf("duration must be positive, got %q", req.ConfigValue.ValueString()))
+	}
+}
@@ -306,6 +306,13 @@ func TestIsNewRuntimeParametersAttrNotSupportedError(t *testing.T) {
 			err:      errors.New("400 Bad Request: runtimeParameters is not allowed key in this context"),
 			expected: true,
 		},
+		{
+			name: "runtimeParameters field error",
+			err: &client.ValidationError{APIError: &client.APIError{
+				FieldErrors: map[string]string{"runtimeParameters": "runtimeParameters is not allowed key"},
+			}},
+			expected: true,
+		},
 	}
 
 	for _, tt := range tests {
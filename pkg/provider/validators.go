@@ -594,15 +594,17 @@ func GuardStagesValidators() []validator.List {
 	}
 }
 
+var runtimeParameterTypes = []string{
+	"boolean",
+	"credential",
+	"deployment",
+	"numeric",
+	"string",
+}
+
 func RuntimeParameterTypeValidators() []validator.String {
 	return []validator.String{
-		stringvalidator.OneOf(
-			"boolean",
-			"credential",
-			"deployment",
-			"numeric",
-			"string",
-		),
+		stringvalidator.OneOf(runtimeParameterTypes...),
 	}
 }
 
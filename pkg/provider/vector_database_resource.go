@@ -38,6 +38,7 @@ var defaultSeparators = []string{"\n\n", "\n", " ", ""}
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &VectorDatabaseResource{}
 var _ resource.ResourceWithImportState = &VectorDatabaseResource{}
+var _ resource.ResourceWithModifyPlan = &VectorDatabaseResource{}
 
 func NewVectorDatabaseResource() resource.Resource {
 	return &VectorDatabaseResource{}
@@ -184,6 +185,10 @@ func (r *VectorDatabaseResource) Schema(ctx context.Context, req resource.Schema
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionAttribute("Vector Database"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -211,6 +216,8 @@ func (r *VectorDatabaseResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	var datasetID string
 	if IsKnown(data.DatasetID) {
 		datasetID = data.DatasetID.ValueString()
@@ -248,7 +255,7 @@ func (r *VectorDatabaseResource) Create(ctx context.Context, req resource.Create
 		ChunkingParameters: buildChunkingParametersRequest(data.ChunkingParameters),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating VectorDatabase", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating VectorDatabase", err)
 		return
 	}
 	loadVectorDatabaseToTerraformState(vectorDatabase, &data)
@@ -269,6 +276,8 @@ func (r *VectorDatabaseResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -301,6 +310,8 @@ func (r *VectorDatabaseResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	var state VectorDatabaseResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -323,7 +334,7 @@ func (r *VectorDatabaseResource) Update(ctx context.Context, req resource.Update
 			ChunkingParameters:     buildChunkingParametersRequest(plan.ChunkingParameters),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating VectorDatabase version", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating VectorDatabase version", err)
 			return
 		}
 
@@ -346,7 +357,7 @@ func (r *VectorDatabaseResource) Update(ctx context.Context, req resource.Update
 					fmt.Sprintf("VectorDatabase with ID %s is not found. Removing from state.", state.ID.ValueString()))
 				resp.State.RemoveResource(ctx)
 			} else {
-				resp.Diagnostics.AddError("Error updating VectorDatabase", err.Error())
+				addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating VectorDatabase", err)
 			}
 			return
 		}
@@ -364,6 +375,12 @@ func (r *VectorDatabaseResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	if checkDeletionProtection(data.DeletionProtection, "Vector Database", data.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteVectorDatabase")
 	err := r.provider.service.DeleteVectorDatabase(ctx, data.ID.ValueString())
 	if err != nil {
@@ -378,8 +395,12 @@ func (r *VectorDatabaseResource) ImportState(ctx context.Context, req resource.I
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *VectorDatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDeletionProtection(ctx, req, resp, "Vector Database", path.Root("use_case_id"))
+}
+
 func (r *VectorDatabaseResource) waitForVectorDatabaseToBeReady(ctx context.Context, vectorDatabaseId string) error {
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	operation := func() error {
 		traceAPICall("IsVectorDatabaseReady")
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &WorkloadLogsDataSource{}
+
+func NewWorkloadLogsDataSource() datasource.DataSource {
+	return &WorkloadLogsDataSource{}
+}
+
+type WorkloadLogsDataSource struct {
+	provider *Provider
+}
+
+func (d *WorkloadLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workload_logs"
+}
+
+func (d *WorkloadLogsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The latest container logs and lifecycle events of a Workload, e.g. to inspect a failed replacement.",
+
+		Attributes: map[string]schema.Attribute{
+			"workload_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the Workload.",
+			},
+			"container": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the container to return the logs of. Defaults to all containers of the Workload.",
+			},
+			"tail_lines": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: "The number of log lines and events to return. " +
+					"Defaults to the `DATAROBOT_WORKLOAD_LOGS_TAIL_LINES` environment variable, or 30.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"logs": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The latest log lines of the containers, oldest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The time of the log line.",
+						},
+						"container": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the container that wrote the log line.",
+						},
+						"level": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The level of the log line, e.g. `info` or `error`.",
+						},
+						"message": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The message of the log line.",
+						},
+					},
+				},
+			},
+			"events": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The latest lifecycle events of the Workload, e.g. image pulls, restarts and failed health checks, oldest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The time of the event.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the event, e.g. `Normal` or `Warning`.",
+						},
+						"reason": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The reason of the event, e.g. `BackOff`.",
+						},
+						"message": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The message of the event.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WorkloadLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	if d.provider, ok = req.ProviderData.(*Provider); !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected %T, got: %T. Please report this issue to the provider developers.", Provider{}, req.ProviderData),
+		)
+	}
+}
+
+func (d *WorkloadLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config WorkloadLogsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workloadID := config.WorkloadID.ValueString()
+	limit := int(config.TailLines.ValueInt64())
+
+	traceAPICall("ListWorkloadLogs")
+	logs, err := d.provider.service.ListWorkloadLogs(ctx, workloadID, &client.ListWorkloadLogsRequest{
+		Container: config.Container.ValueString(),
+		Limit:     limit,
+	})
+	if err != nil {
+		addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error listing logs of Workload %s", workloadID), err)
+		return
+	}
+
+	traceAPICall("ListWorkloadEvents")
+	events, err := d.provider.service.ListWorkloadEvents(ctx, workloadID, &client.ListWorkloadEventsRequest{
+		Limit: limit,
+	})
+	if err != nil {
+		addAPIErrorDiagnostic(&resp.Diagnostics, fmt.Sprintf("Error listing events of Workload %s", workloadID), err)
+		return
+	}
+
+	config.Logs = make([]WorkloadLogEntryModel, 0, len(logs))
+	for _, entry := range logs {
+		config.Logs = append(config.Logs, WorkloadLogEntryModel{
+			Timestamp: types.StringValue(entry.Timestamp),
+			Container: types.StringValue(entry.Container),
+			Level:     types.StringValue(entry.Level),
+			Message:   types.StringValue(entry.Message),
+		})
+	}
+
+	config.Events = make([]WorkloadEventModel, 0, len(events))
+	for _, event := range events {
+		config.Events = append(config.Events, WorkloadEventModel{
+			Timestamp: types.StringValue(event.Timestamp),
+			Type:      types.StringValue(event.Type),
+			Reason:    types.StringValue(event.Reason),
+			Message:   types.StringValue(event.Message),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
+	mock_client "github.com/datarobot-community/terraform-provider-datarobot/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestIntegrationWorkloadLogsDataSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_client.NewMockService(ctrl)
+	defer HookGlobal(&NewService, func(c *client.Client) client.Service {
+		return mockService
+	})()
+
+	mockAPIKey(t)
+
+	workloadID := uuid.NewString()
+
+	mockService.EXPECT().
+		ListWorkloadLogs(gomock.Any(), workloadID, &client.ListWorkloadLogsRequest{Container: "main", Limit: 10}).
+		Return([]client.WorkloadLogEntry{
+			{Timestamp: "2026-07-09T16:14:50Z", Container: "main", Level: "error", Message: "address already in use"},
+		}, nil).
+		AnyTimes()
+	mockService.EXPECT().
+		ListWorkloadEvents(gomock.Any(), workloadID, &client.ListWorkloadEventsRequest{Limit: 10}).
+		Return([]client.WorkloadEvent{
+			{Timestamp: "2026-07-09T16:14:40Z", Type: "Warning", Reason: "BackOff", Message: "back-off restarting failed container"},
+		}, nil).
+		AnyTimes()
+
+	dataSourceName := "data.datarobot_workload_logs.test"
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: workloadMockConfig(fmt.Sprintf(`
+data "datarobot_workload_logs" "test" {
+  workload_id = %q
+  container   = "main"
+  tail_lines  = 10
+}
+`, workloadID)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "logs.0.container", "main"),
+					resource.TestCheckResourceAttr(dataSourceName, "logs.0.message", "address already in use"),
+					resource.TestCheckResourceAttr(dataSourceName, "events.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.reason", "BackOff"),
+				),
+			},
+		},
+	})
+}
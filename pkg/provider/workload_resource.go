@@ -6,11 +6,14 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,6 +30,7 @@ import (
 var _ resource.Resource = &WorkloadResource{}
 var _ resource.ResourceWithImportState = &WorkloadResource{}
 var _ resource.ResourceWithValidateConfig = &WorkloadResource{}
+var _ resource.ResourceWithModifyPlan = &WorkloadResource{}
 
 func NewWorkloadResource() resource.Resource {
 	return &WorkloadResource{}
@@ -221,7 +225,7 @@ func (r *WorkloadResource) Schema(ctx context.Context, req resource.SchemaReques
 					},
 					"replacement_policy": schema.SingleNestedAttribute{
 						Optional:            true,
-						MarkdownDescription: "Replacement policy for in-place workload replacement (rolling strategy). Applied when `artifact_id` changes or when replacement policy settings change. Runtime-only changes use `PATCH /workloads/{id}/settings`, which does not accept custom replacement timing (WAPI uses platform defaults).",
+						MarkdownDescription: "Replacement policy for in-place workload replacement (rolling strategy, or canary strategy when `steps` is set). Applied when `artifact_id` changes or when `warmup_minutes`, `keep_old_version_minutes` or `steps` change. Runtime-only changes use `PATCH /workloads/{id}/settings`, which does not accept custom replacement timing (WAPI uses platform defaults).",
 						Attributes: map[string]schema.Attribute{
 							"warmup_minutes": schema.Int64Attribute{
 								Optional:            true,
@@ -237,10 +241,60 @@ func (r *WorkloadResource) Schema(ctx context.Context, req resource.SchemaReques
 									int64validator.AtLeast(0),
 								},
 							},
+							"steps": schema.ListNestedAttribute{
+								Optional: true,
+								MarkdownDescription: "Ordered traffic steps of a progressive rollout, e.g. 10, 50 and 100 percent. " +
+									"`traffic_percent` must increase from step to step and the last step must be 100. Maps to WAPI `config.steps` with the `canary` strategy.",
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"traffic_percent": schema.Int64Attribute{
+											Required:            true,
+											MarkdownDescription: "Percentage of the traffic that the new version receives in this step.",
+											Validators: []validator.Int64{
+												int64validator.Between(1, 100),
+											},
+										},
+										"pause_minutes": schema.Int64Attribute{
+											Optional:            true,
+											MarkdownDescription: "Duration in minutes to hold this step before the next one.",
+											Validators: []validator.Int64{
+												int64validator.AtLeast(0),
+											},
+										},
+									},
+								},
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+								},
+							},
+							"health_gate": schema.SingleNestedAttribute{
+								Optional: true,
+								MarkdownDescription: "Health gate checked on the workload status while a replacement is in progress. " +
+									"The gate trips when the workload errors or stops. Changing it does not start a replacement.",
+								Attributes: map[string]schema.Attribute{
+									"max_unhealthy_minutes": schema.Int64Attribute{
+										Optional: true,
+										MarkdownDescription: "Also trip the gate when the workload is not running for this many minutes, e.g. while stuck initializing. " +
+											"`0` trips it as soon as the workload is not running.",
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+								},
+							},
+							"rollback_on_failure": schema.BoolAttribute{
+								Optional: true,
+								MarkdownDescription: "Whether to cancel the replacement and return all traffic to the previous version when the replacement fails or `health_gate` trips. " +
+									"Changing it does not start a replacement.",
+							},
 						},
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionAttribute("Workload"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": resourceTimeoutsBlock(ctx),
 		},
 	}
 }
@@ -267,10 +321,12 @@ func (r *WorkloadResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx = withCreateTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("CreateWorkload")
 	workload, err := r.provider.service.CreateWorkload(ctx, workloadCreateRequest(data))
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Workload", err.Error())
+		addAPIErrorDiagnostic(&resp.Diagnostics, "Error creating Workload", err)
 		return
 	}
 
@@ -295,6 +351,8 @@ func (r *WorkloadResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx = withReadTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	if data.ID.IsNull() {
 		return
 	}
@@ -331,6 +389,8 @@ func (r *WorkloadResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx = withUpdateTimeout(ctx, plan.Timeouts, &resp.Diagnostics)
+
 	id := state.ID.ValueString()
 	planned := plan
 
@@ -342,7 +402,7 @@ func (r *WorkloadResource) Update(ctx context.Context, req resource.UpdateReques
 		traceAPICall("UpdateWorkloadMetadata")
 		workload, err := r.provider.service.UpdateWorkloadMetadata(ctx, id, workloadUpdateRequest(planned))
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating Workload", err.Error())
+			addAPIErrorDiagnostic(&resp.Diagnostics, "Error updating Workload", err)
 			return
 		}
 		loadWorkloadIntoModel(workload, &plan)
@@ -351,8 +411,13 @@ func (r *WorkloadResource) Update(ctx context.Context, req resource.UpdateReques
 	if artifactChanged || containerGroupsChanged || replacementPolicyChanged {
 		if err := r.triggerWorkloadReplacement(ctx, id, planned, artifactChanged, containerGroupsChanged, replacementPolicyChanged); err != nil {
 			var failedErr *client.ReplacementFailedError
-			if errors.As(err, &failedErr) {
-				resp.Diagnostics.AddError("Workload replacement failed", failedErr.Error())
+			if errors.As(err, &failedErr) && failedErr.RolledBack {
+				resp.Diagnostics.AddError("Workload replacement rolled back", workloadErrorMessageWithLogs(
+					failedErr.Error()+". All traffic was returned to the previous version of the Workload.",
+					failedErr.Logs, failedErr.LogsErr))
+			} else if errors.As(err, &failedErr) {
+				resp.Diagnostics.AddError("Workload replacement failed",
+					workloadErrorMessageWithLogs(failedErr.Error(), failedErr.Logs, failedErr.LogsErr))
 			} else {
 				resp.Diagnostics.AddError("Error replacing Workload", err.Error())
 			}
@@ -381,6 +446,12 @@ func (r *WorkloadResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if checkDeletionProtection(data.DeletionProtection, "Workload", data.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	ctx = withDeleteTimeout(ctx, data.Timeouts, &resp.Diagnostics)
+
 	traceAPICall("DeleteWorkload")
 	err := r.provider.service.DeleteWorkload(ctx, data.ID.ValueString())
 	if err != nil {
@@ -417,6 +488,10 @@ func (r *WorkloadResource) ImportState(ctx context.Context, req resource.ImportS
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func (r *WorkloadResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDeletionProtection(ctx, req, resp, "Workload")
+}
+
 func (r *WorkloadResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data WorkloadResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -433,6 +508,10 @@ func (r *WorkloadResource) ValidateConfig(ctx context.Context, req resource.Vali
 		return
 	}
 
+	if policy := data.Runtime.ReplacementPolicy; policy != nil {
+		validateWorkloadReplacementSteps(policy.Steps, &resp.Diagnostics)
+	}
+
 	for i, g := range data.Runtime.ContainerGroups {
 		replicaCountSet := !g.ReplicaCount.IsNull() &&
 			!g.ReplicaCount.IsUnknown() &&
@@ -510,7 +589,7 @@ func (r *WorkloadResource) ValidateConfig(ctx context.Context, req resource.Vali
 }
 
 func waitForWorkloadToBeRunning(ctx context.Context, s client.Service, id string, baseURL func() string) (*client.Workload, error) {
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	var running *client.Workload
 	operation := func() error {
@@ -538,7 +617,7 @@ func waitForWorkloadToBeRunning(ctx context.Context, s client.Service, id string
 }
 
 func waitForWorkloadToBeDeleted(ctx context.Context, s client.Service, id string) error {
-	expBackoff := getExponentialBackoff()
+	expBackoff := getExponentialBackoff(ctx)
 
 	operation := func() error {
 		traceAPICall("GetWorkload")
@@ -741,6 +820,51 @@ func restoreMemoryFormat(desired WorkloadContainerOverrideModel, data *WorkloadC
 	}
 }
 
+// validateWorkloadReplacementSteps checks that traffic_percent increases from step to step
+// and ends at 100.
+func validateWorkloadReplacementSteps(steps []WorkloadReplacementStepModel, diags *diag.Diagnostics) {
+	stepsPath := path.Root("runtime").AtName("replacement_policy").AtName("steps")
+	previous := int64(0)
+	for i, step := range steps {
+		if !IsKnown(step.TrafficPercent) {
+			return
+		}
+		percent := step.TrafficPercent.ValueInt64()
+		if percent <= previous {
+			diags.AddAttributeError(
+				stepsPath.AtListIndex(i).AtName("traffic_percent"),
+				"Invalid replacement steps",
+				fmt.Sprintf("traffic_percent must increase from step to step, got %d after %d.", percent, previous),
+			)
+			return
+		}
+		previous = percent
+	}
+	if len(steps) > 0 && previous != 100 {
+		diags.AddAttributeError(
+			stepsPath.AtListIndex(len(steps)-1).AtName("traffic_percent"),
+			"Invalid replacement steps",
+			fmt.Sprintf("The last step must send 100 percent of the traffic to the new version, got %d.", previous),
+		)
+	}
+}
+
+// workloadErrorMessageWithLogs appends the tail of the workload events and logs to a failure
+// message, like deploymentErrorMessageWithLogs does for deployments. logs may hold the part
+// that was retrieved when logErr is set.
+func workloadErrorMessageWithLogs(baseMessage, logs string, logErr error) string {
+	if logErr != nil {
+		baseMessage = fmt.Sprintf("%s (failed to retrieve workload logs: %s)", baseMessage, logErr)
+	}
+	if logs == "" {
+		return baseMessage
+	}
+	return fmt.Sprintf(
+		"%s\n%s\nWorkload events and logs:\n%s\n%s",
+		baseMessage, deploymentLogsSeparator, logs, deploymentLogsSeparator,
+	)
+}
+
 func workloadMetadataChanged(plan, state WorkloadResourceModel) bool {
 	return !plan.Name.Equal(state.Name) ||
 		!plan.Description.Equal(state.Description) ||
@@ -751,8 +875,10 @@ func workloadContainerGroupsChanged(plan, state WorkloadRuntimeModel) bool {
 	return !reflect.DeepEqual(plan.ContainerGroups, state.ContainerGroups)
 }
 
+// workloadReplacementPolicyChanged reports whether the replacement config sent to WAPI
+// changed. health_gate and rollback_on_failure are only applied by the provider.
 func workloadReplacementPolicyChanged(plan, state WorkloadRuntimeModel) bool {
-	return !reflect.DeepEqual(plan.ReplacementPolicy, state.ReplacementPolicy)
+	return !reflect.DeepEqual(replacementConfigFromPlan(plan.ReplacementPolicy), replacementConfigFromPlan(state.ReplacementPolicy))
 }
 
 func preserveWorkloadReplacementPolicy(prior WorkloadResourceModel, data *WorkloadResourceModel) {
@@ -770,9 +896,66 @@ func replacementConfigFromPlan(policy *WorkloadReplacementPolicyModel) client.Re
 	if !policy.KeepOldVersionMinutes.IsNull() && !policy.KeepOldVersionMinutes.IsUnknown() {
 		cfg.KeepOldVersionMinutes = policy.KeepOldVersionMinutes.ValueInt64()
 	}
+	for _, step := range policy.Steps {
+		cfg.Steps = append(cfg.Steps, client.ReplacementStep{
+			TrafficPercent: step.TrafficPercent.ValueInt64(),
+			PauseMinutes:   step.PauseMinutes.ValueInt64(),
+		})
+	}
 	return cfg
 }
 
+func replacementStrategyFromConfig(cfg client.ReplacementConfig) client.ReplacementStrategy {
+	if len(cfg.Steps) > 0 {
+		return client.ReplacementStrategyCanary
+	}
+	return client.ReplacementStrategyRolling
+}
+
+// workloadReplacementWaitOptions returns the health gate and rollback of the replacement
+// policy for WaitForWorkloadReplacement.
+func workloadReplacementWaitOptions(policy *WorkloadReplacementPolicyModel) *client.WaitForWorkloadReplacementOptions {
+	if policy == nil {
+		return nil
+	}
+	return &client.WaitForWorkloadReplacementOptions{
+		HealthGate: workloadHealthGate(policy.HealthGate, time.Now),
+		Rollback:   policy.RollbackOnFailure.ValueBool(),
+	}
+}
+
+// workloadHealthGate returns a gate that trips when the workload errors or stops, and when
+// it is not running for max_unhealthy_minutes. It returns nil without a health_gate.
+func workloadHealthGate(gate *WorkloadHealthGateModel, now func() time.Time) func(*client.Workload) error {
+	if gate == nil {
+		return nil
+	}
+
+	var unhealthySince time.Time
+	return func(workload *client.Workload) error {
+		switch workload.Status {
+		case client.ProtonStatusRunning:
+			unhealthySince = time.Time{}
+			return nil
+		case client.ProtonStatusErrored, client.ProtonStatusStopping, client.ProtonStatusStopped:
+			return fmt.Errorf("workload is %s", workload.Status)
+		}
+
+		if unhealthySince.IsZero() {
+			unhealthySince = now()
+		}
+		if !IsKnown(gate.MaxUnhealthyMinutes) {
+			return nil
+		}
+		maxUnhealthy := time.Duration(gate.MaxUnhealthyMinutes.ValueInt64()) * time.Minute
+		if now().Sub(unhealthySince) >= maxUnhealthy {
+			return fmt.Errorf("workload has not been running for %d minutes (status: %s)",
+				gate.MaxUnhealthyMinutes.ValueInt64(), workload.Status)
+		}
+		return nil
+	}
+}
+
 func (r *WorkloadResource) triggerWorkloadReplacement(
 	ctx context.Context,
 	workloadID string,
@@ -783,10 +966,11 @@ func (r *WorkloadResource) triggerWorkloadReplacement(
 
 	if useReplacementAPI {
 		traceAPICall("StartWorkloadReplacement")
+		config := replacementConfigFromPlan(plan.Runtime.ReplacementPolicy)
 		req := &client.StartReplacementRequest{
 			ArtifactID: plan.ArtifactID.ValueString(),
-			Strategy:   client.ReplacementStrategyRolling,
-			Config:     replacementConfigFromPlan(plan.Runtime.ReplacementPolicy),
+			Strategy:   replacementStrategyFromConfig(config),
+			Config:     config,
 		}
 		if containerGroupsChanged {
 			runtime := workloadRuntimeToClient(plan.Runtime)
@@ -805,7 +989,7 @@ func (r *WorkloadResource) triggerWorkloadReplacement(
 	}
 
 	traceAPICall("WaitForWorkloadReplacement")
-	_, err := r.provider.service.WaitForWorkloadReplacement(ctx, workloadID, nil)
+	_, err := r.provider.service.WaitForWorkloadReplacement(ctx, workloadID, workloadReplacementWaitOptions(plan.Runtime.ReplacementPolicy))
 	return err
 }
 
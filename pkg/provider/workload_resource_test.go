@@ -2,14 +2,19 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/datarobot-community/terraform-provider-datarobot/internal/client"
 	mock_client "github.com/datarobot-community/terraform-provider-datarobot/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -683,6 +688,183 @@ func TestIntegrationWorkloadReplacementPollFailure(t *testing.T) {
 	})
 }
 
+func TestIntegrationWorkloadCanaryReplacementRollsBack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_client.NewMockService(ctrl)
+	defer HookGlobal(&NewService, func(c *client.Client) client.Service {
+		return mockService
+	})()
+
+	mockAPIKey(t)
+
+	id := uuid.NewString()
+	artifactID1 := uuid.NewString()
+	artifactID2 := uuid.NewString()
+	name := "workload-" + uuid.NewString()[:8]
+	replicaCount := int64(1)
+	endpoint := "https://workloads.example.com/" + id
+
+	workload1 := workloadFixture(id, artifactID1, name, "", client.WorkloadImportanceLow, &replicaCount, &endpoint)
+	canarySteps := `
+      { traffic_percent = 10, pause_minutes = 5 },
+      { traffic_percent = 50, pause_minutes = 10 },
+      { traffic_percent = 100 },
+    `
+
+	mockService.EXPECT().CreateWorkload(gomock.Any(), gomock.Any()).Return(workload1, nil)
+	mockService.EXPECT().GetWorkload(gomock.Any(), id).Return(workload1, nil)
+	mockService.EXPECT().GetWorkload(gomock.Any(), id).Return(workload1, nil)
+	mockService.EXPECT().GetWorkload(gomock.Any(), id).Return(workload1, nil)
+
+	replacement := workloadReplacementFixture(id)
+	mockService.EXPECT().StartWorkloadReplacement(gomock.Any(), id, startCanaryReplacementMatcher{
+		artifactID: artifactID2,
+		steps: []client.ReplacementStep{
+			{TrafficPercent: 10, PauseMinutes: 5},
+			{TrafficPercent: 50, PauseMinutes: 10},
+			{TrafficPercent: 100},
+		},
+	}).Return(replacement, nil)
+	mockService.EXPECT().WaitForWorkloadReplacement(gomock.Any(), id, rollbackWaitOptionsMatcher{}).Return(nil, &client.ReplacementFailedError{
+		Message:    "health gate tripped: workload is errored",
+		HealthGate: true,
+		RolledBack: true,
+	})
+
+	mockService.EXPECT().DeleteWorkload(gomock.Any(), id).Return(nil)
+	mockService.EXPECT().GetWorkload(gomock.Any(), id).Return(nil, client.NewNotFoundError("workload"))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: workloadConfigWithCanarySteps(name, artifactID1, canarySteps),
+			},
+			{
+				Config:      workloadConfigWithCanarySteps(name, artifactID2, canarySteps),
+				ExpectError: regexp.MustCompile("Workload replacement rolled back"),
+			},
+		},
+	})
+}
+
+func TestWorkloadInvalidReplacementSteps(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mock_client.NewMockService(ctrl)
+	defer HookGlobal(&NewService, func(c *client.Client) client.Service {
+		return mockService
+	})()
+
+	mockAPIKey(t)
+
+	artifactID := uuid.NewString()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      workloadConfigWithCanarySteps("steps-test", artifactID, "{ traffic_percent = 50 }, { traffic_percent = 10 }, { traffic_percent = 100 }"),
+				ExpectError: regexp.MustCompile("traffic_percent must increase from step to step"),
+			},
+			{
+				Config:      workloadConfigWithCanarySteps("steps-test", artifactID, "{ traffic_percent = 10 }, { traffic_percent = 50 }"),
+				ExpectError: regexp.MustCompile("The last step must send 100 percent"),
+			},
+		},
+	})
+}
+
+func TestWorkloadReplacementPolicyChangedIgnoresProviderSettings(t *testing.T) {
+	state := WorkloadRuntimeModel{ReplacementPolicy: &WorkloadReplacementPolicyModel{
+		WarmupMinutes: types.Int64Value(5),
+	}}
+	plan := WorkloadRuntimeModel{ReplacementPolicy: &WorkloadReplacementPolicyModel{
+		WarmupMinutes:     types.Int64Value(5),
+		HealthGate:        &WorkloadHealthGateModel{MaxUnhealthyMinutes: types.Int64Value(3)},
+		RollbackOnFailure: types.BoolValue(true),
+	}}
+	if workloadReplacementPolicyChanged(plan, state) {
+		t.Fatal("health_gate and rollback_on_failure must not start a replacement")
+	}
+
+	plan.ReplacementPolicy.Steps = []WorkloadReplacementStepModel{
+		{TrafficPercent: types.Int64Value(100), PauseMinutes: types.Int64Null()},
+	}
+	if !workloadReplacementPolicyChanged(plan, state) {
+		t.Fatal("steps must start a replacement")
+	}
+}
+
+func TestWorkloadErrorMessageWithLogs(t *testing.T) {
+	msg := workloadErrorMessageWithLogs("candidate crashed", "Logs:\n[2026-07-09T16:14:50Z] main ERROR: address already in use", nil)
+	for _, want := range []string{"candidate crashed", deploymentLogsSeparator, "main ERROR: address already in use"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("expected message to contain %q, got:\n%s", want, msg)
+		}
+	}
+
+	msg = workloadErrorMessageWithLogs("candidate crashed", "", errors.New("boom"))
+	if msg != "candidate crashed (failed to retrieve workload logs: boom)" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+
+	msg = workloadErrorMessageWithLogs("candidate crashed", "Logs:\n[2026-07-09T16:14:50Z] main ERROR: address already in use", errors.New("events: boom"))
+	for _, want := range []string{"failed to retrieve workload logs: events: boom", "main ERROR: address already in use"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("expected message to contain %q, got:\n%s", want, msg)
+		}
+	}
+
+	if msg = workloadErrorMessageWithLogs("candidate crashed", "", nil); msg != "candidate crashed" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+}
+
+func TestWorkloadHealthGate(t *testing.T) {
+	if workloadHealthGate(nil, time.Now) != nil {
+		t.Fatal("expected no gate without health_gate")
+	}
+
+	now := time.Now()
+	clock := func() time.Time { return now }
+	workload := func(status client.ProtonStatus) *client.Workload {
+		return &client.Workload{Status: status}
+	}
+
+	gate := workloadHealthGate(&WorkloadHealthGateModel{MaxUnhealthyMinutes: types.Int64Null()}, clock)
+	if err := gate(workload(client.ProtonStatusInitializing)); err != nil {
+		t.Fatalf("unexpected error without max_unhealthy_minutes: %v", err)
+	}
+	if err := gate(workload(client.ProtonStatusErrored)); err == nil {
+		t.Fatal("expected an errored workload to trip the gate")
+	}
+
+	gate = workloadHealthGate(&WorkloadHealthGateModel{MaxUnhealthyMinutes: types.Int64Value(5)}, clock)
+	if err := gate(workload(client.ProtonStatusInitializing)); err != nil {
+		t.Fatalf("unexpected error at the start of the unhealthy period: %v", err)
+	}
+	now = now.Add(4 * time.Minute)
+	if err := gate(workload(client.ProtonStatusRunning)); err != nil {
+		t.Fatalf("unexpected error for a running workload: %v", err)
+	}
+	// running again resets the unhealthy period
+	if err := gate(workload(client.ProtonStatusInitializing)); err != nil {
+		t.Fatalf("unexpected error after the workload recovered: %v", err)
+	}
+	now = now.Add(5 * time.Minute)
+	if err := gate(workload(client.ProtonStatusInitializing)); err == nil {
+		t.Fatal("expected the gate to trip after max_unhealthy_minutes")
+	}
+}
+
 func TestIntegrationWorkloadReplaceOnReplicaCountChange(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1141,6 +1323,36 @@ func (m startReplacementMatcher) Matches(x any) bool {
 	return true
 }
 
+type startCanaryReplacementMatcher struct {
+	artifactID string
+	steps      []client.ReplacementStep
+}
+
+func (m startCanaryReplacementMatcher) Matches(x any) bool {
+	req, ok := x.(*client.StartReplacementRequest)
+	if !ok || req == nil {
+		return false
+	}
+	return req.ArtifactID == m.artifactID &&
+		req.Strategy == client.ReplacementStrategyCanary &&
+		reflect.DeepEqual(req.Config.Steps, m.steps)
+}
+
+func (m startCanaryReplacementMatcher) String() string {
+	return fmt.Sprintf("StartReplacementRequest{artifactId=%q strategy=canary steps=%+v}", m.artifactID, m.steps)
+}
+
+type rollbackWaitOptionsMatcher struct{}
+
+func (m rollbackWaitOptionsMatcher) Matches(x any) bool {
+	opts, ok := x.(*client.WaitForWorkloadReplacementOptions)
+	return ok && opts != nil && opts.Rollback && opts.HealthGate != nil
+}
+
+func (m rollbackWaitOptionsMatcher) String() string {
+	return "WaitForWorkloadReplacementOptions{Rollback=true HealthGate=set}"
+}
+
 func (m startReplacementMatcher) String() string {
 	return fmt.Sprintf(
 		"StartReplacementRequest{artifactId=%q strategy=%q warmup=%d keepOld=%d}",
@@ -1244,6 +1456,31 @@ resource "datarobot_workload" "test" {
 `, name, artifactID, warmupMinutes, keepOldVersionMinutes))
 }
 
+func workloadConfigWithCanarySteps(name, artifactID, steps string) string {
+	return workloadMockConfig(fmt.Sprintf(`
+resource "datarobot_workload" "test" {
+  name        = %q
+  importance  = "low"
+  artifact_id = %q
+  runtime = {
+    container_groups = [
+      {
+        replica_count    = 1
+        resource_bundles = ["cpu.small"]
+      }
+    ]
+    replacement_policy = {
+      steps               = [%s]
+      rollback_on_failure = true
+      health_gate = {
+        max_unhealthy_minutes = 5
+      }
+    }
+  }
+}
+`, name, artifactID, steps))
+}
+
 func workloadConfigWithReplicasAndResources(name, description, importance, artifactID string, replicaCount int64, resourceBundleID string) string {
 	desc := ""
 	if description != "" {
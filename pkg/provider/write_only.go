@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// writeOnlyAttribute returns the schema of the write-only variant of a secret attribute.
+// Write-only values are never persisted to the plan or state, so they require Terraform 1.11 or later.
+func writeOnlyAttribute(secret string, validators ...validator.String) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf(
+			"Write-only variant of `%[1]s` that is never stored in the Terraform state. Requires Terraform 1.11 or later. "+
+				"Change `%[1]s_wo_version` to send a new value.", secret),
+		Optional:   true,
+		Sensitive:  true,
+		WriteOnly:  true,
+		Validators: validators,
+	}
+}
+
+// writeOnlyVersionAttribute returns the schema of the version trigger of a write-only attribute.
+// Terraform cannot detect changes of write-only values, so the version is used to signal them.
+func writeOnlyVersionAttribute(secret string, requiresReplace bool) schema.Int64Attribute {
+	attribute := schema.Int64Attribute{
+		MarkdownDescription: fmt.Sprintf(
+			"The version of `%[1]s_wo`. Change it to apply a new value of `%[1]s_wo`.", secret),
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot(secret + "_wo")),
+		},
+	}
+	if requiresReplace {
+		attribute.PlanModifiers = []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		}
+	}
+	return attribute
+}
+
+// writeOnlyValue reads a write-only attribute from the configuration,
+// as the framework always nullifies it in the plan and state.
+func writeOnlyValue(ctx context.Context, config tfsdk.Config, name string, diags *diag.Diagnostics) types.String {
+	var value types.String
+	diags.Append(config.GetAttribute(ctx, path.Root(name), &value)...)
+	return value
+}
+
+// secretValue returns the secret from its regular attribute, falling back to its write-only variant.
+func secretValue(value, writeOnly types.String) string {
+	if IsKnown(value) {
+		return value.ValueString()
+	}
+	return writeOnly.ValueString()
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWriteOnlyResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	for name, newResource := range map[string]func() fwresource.Resource{
+		"password":                NewBasicCredentialResource,
+		"aws_secret_access_key":   NewAwsCredentialResource,
+		"azure_connection_string": NewAzureCredentialResource,
+		"gcp_key":                 NewGoogleCloudCredentialResource,
+		"api_token":               NewApiTokenCredentialResource,
+		"client_secret":           NewAppOAuthResource,
+		"personal_access_token":   NewRemoteRepositoryResource,
+		"secret_token":            NewNotificationChannelResource,
+	} {
+		schemaResponse := &fwresource.SchemaResponse{}
+		newResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+		if schemaResponse.Diagnostics.HasError() {
+			t.Fatalf("%s: Schema method diagnostics: %+v", name, schemaResponse.Diagnostics)
+		}
+
+		if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+			t.Fatalf("%s: Schema validation diagnostics: %+v", name, diagnostics)
+		}
+
+		attribute, ok := schemaResponse.Schema.Attributes[name+"_wo"]
+		if !ok || !attribute.IsWriteOnly() || !attribute.IsSensitive() {
+			t.Errorf("%s: expected a sensitive write-only %s_wo attribute", name, name)
+		}
+		if _, ok := schemaResponse.Schema.Attributes[name+"_wo_version"]; !ok {
+			t.Errorf("%s: expected a %s_wo_version attribute", name, name)
+		}
+	}
+}
+
+func TestSecretValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		value     types.String
+		writeOnly types.String
+		expected  string
+	}{
+		{"regular", types.StringValue("secret"), types.StringNull(), "secret"},
+		{"write-only", types.StringNull(), types.StringValue("wo-secret"), "wo-secret"},
+		{"neither", types.StringNull(), types.StringNull(), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := secretValue(tt.value, tt.writeOnly); actual != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, actual)
+			}
+		})
+	}
+}